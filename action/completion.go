@@ -0,0 +1,82 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	// 推送动作完成的时间戳, 所有入队的消息都被处理后设置
+	StatCompletedAt = "completed_at"
+
+	appsKeyPrefix = "push_action_apps"
+)
+
+// completeScript sets the completed time of action once all the enqueued messages are processed,
+// every message ends up with exactly one of the succeeded, failed, cancelled, expired, capped and duplicated stats,
+// a failed message is only counted once it will not be retried any more.
+// It returns 1 only for the call which completes the action.
+var completeScript = redis.NewScript(`
+local v = redis.call('HMGET', KEYS[1], 'completed_at', 'enqueued', 'succeeded', 'failed', 'cancelled', 'expired', 'capped', 'duplicated')
+if v[1] then
+    return 0
+end
+local enqueued = tonumber(v[2] or '0')
+if enqueued <= 0 then
+    return 0
+end
+local processed = 0
+for i = 3, #v do
+    processed = processed + tonumber(v[i] or '0')
+end
+if processed < enqueued then
+    return 0
+end
+redis.call('HSET', KEYS[1], 'completed_at', ARGV[1])
+return 1
+`)
+
+func getAppsKey(actionId string) string {
+	return fmt.Sprintf("%s:%s", appsKeyPrefix, actionId)
+}
+
+// MarkCompleted marks the action as completed if all the enqueued messages are processed,
+// true is returned only once for an action when it becomes completed.
+func MarkCompleted(ctx context.Context, client *redis.Client, actionId string) (bool, error) {
+	if len(actionId) <= 0 {
+		return false, ActionIdIsEmpty
+	}
+	n, err := completeScript.Run(ctx, client, []string{getStatsKey(actionId)}, time.Now().Unix()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// AddApps records the apps which the messages of action are sent to
+func AddApps(ctx context.Context, client *redis.Client, actionId string, appIds ...string) error {
+	if len(actionId) <= 0 {
+		return ActionIdIsEmpty
+	}
+	if len(appIds) <= 0 {
+		return nil
+	}
+	members := make([]interface{}, len(appIds))
+	for i := range appIds {
+		members[i] = appIds[i]
+	}
+	key := getAppsKey(actionId)
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, keyTTL)
+		return nil
+	})
+	return err
+}
+
+// GetApps returns the apps which the messages of action are sent to
+func GetApps(ctx context.Context, client *redis.Client, actionId string) ([]string, error) {
+	return client.SMembers(ctx, getAppsKey(actionId)).Result()
+}
@@ -0,0 +1,99 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+type State string
+
+const (
+	StateRunning   State = "running"
+	StatePaused    State = "paused"
+	StateCancelled State = "cancelled"
+
+	stateKeyPrefix = "push_action_state"
+	// the state and stats of an action are kept for this duration since the last update
+	keyTTL = 7 * 24 * time.Hour
+)
+
+var (
+	ActionIdIsEmpty        = errors.New("action id is empty")
+	InvalidStateTransition = errors.New("invalid action state transition")
+)
+
+// transitionScript sets the state of action to ARGV[1] only if the current state is one of ARGV[3:],
+// an action without state is treated as running. It returns the state of the action after the transition
+// and whether the transition happened.
+var transitionScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current == false then
+    current = 'running'
+end
+for i = 3, #ARGV do
+    if ARGV[i] == current then
+        redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+        return {ARGV[1], 1}
+    end
+end
+return {current, 0}
+`)
+
+var allowedTransitions = map[State][]State{
+	StateRunning:   {StatePaused},
+	StatePaused:    {StateRunning},
+	StateCancelled: {StateRunning, StatePaused},
+}
+
+func getStateKey(actionId string) string {
+	return fmt.Sprintf("%s:%s", stateKeyPrefix, actionId)
+}
+
+// GetState returns the state of action, the action is running if it has never been paused or cancelled
+func GetState(ctx context.Context, client *redis.Client, actionId string) (State, error) {
+	if len(actionId) <= 0 {
+		return StateRunning, nil
+	}
+	state, err := client.Get(ctx, getStateKey(actionId)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return StateRunning, nil
+	case err != nil:
+		return "", err
+	default:
+		return State(state), nil
+	}
+}
+
+// Transition changes the state of action to the target state, a cancelled action can not be resumed or paused again.
+// The current state is returned with error InvalidStateTransition if the transition is not allowed.
+func Transition(ctx context.Context, client *redis.Client, actionId string, target State) (State, error) {
+	if len(actionId) <= 0 {
+		return "", ActionIdIsEmpty
+	}
+	from, ok := allowedTransitions[target]
+	if !ok {
+		return "", fmt.Errorf("unknown action state %s", target)
+	}
+
+	args := []interface{}{string(target), keyTTL.Milliseconds()}
+	for _, state := range from {
+		args = append(args, string(state))
+	}
+	res, err := transitionScript.Run(ctx, client, []string{getStateKey(actionId)}, args...).Slice()
+	if err != nil {
+		return "", err
+	}
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected action state transition script result: %v", res)
+	}
+
+	state, _ := res[0].(string)
+	if transited, _ := res[1].(int64); transited != 1 {
+		return State(state), InvalidStateTransition
+	}
+	return State(state), nil
+}
@@ -0,0 +1,70 @@
+package action
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newTestRedis(t *testing.T) *redis.Client {
+	m := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func TestTransition(t *testing.T) {
+	client := newTestRedis(t)
+	ctx := context.Background()
+
+	// the action is running if it has never been paused or cancelled
+	state, err := GetState(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.Equal(t, StateRunning, state)
+	_, err = Transition(ctx, client, "action", StateRunning)
+	assert.ErrorIs(t, err, InvalidStateTransition)
+
+	state, err = Transition(ctx, client, "action", StatePaused)
+	assert.NoError(t, err)
+	assert.Equal(t, StatePaused, state)
+	state, err = Transition(ctx, client, "action", StatePaused)
+	assert.ErrorIs(t, err, InvalidStateTransition)
+	assert.Equal(t, StatePaused, state)
+
+	state, err = Transition(ctx, client, "action", StateRunning)
+	assert.NoError(t, err)
+	assert.Equal(t, StateRunning, state)
+
+	state, err = Transition(ctx, client, "action", StateCancelled)
+	assert.NoError(t, err)
+	assert.Equal(t, StateCancelled, state)
+	// a cancelled action can not be resumed or paused again
+	for _, target := range []State{StateRunning, StatePaused, StateCancelled} {
+		state, err = Transition(ctx, client, "action", target)
+		assert.ErrorIs(t, err, InvalidStateTransition)
+		assert.Equal(t, StateCancelled, state)
+	}
+	state, err = GetState(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.Equal(t, StateCancelled, state)
+
+	_, err = Transition(ctx, client, "", StatePaused)
+	assert.ErrorIs(t, err, ActionIdIsEmpty)
+	_, err = Transition(ctx, client, "action", "unknown")
+	assert.Error(t, err)
+}
+
+func TestAddApps(t *testing.T) {
+	client := newTestRedis(t)
+	ctx := context.Background()
+
+	assert.NoError(t, AddApps(ctx, client, "action", "app"))
+	assert.NoError(t, AddApps(ctx, client, "action", "app", "other"))
+	apps, err := GetApps(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app", "other"}, apps)
+}
@@ -0,0 +1,113 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"strings"
+)
+
+const (
+	// 成功入队的消息数
+	StatEnqueued = "enqueued"
+	// 推送成功的消息数
+	StatSucceeded = "succeeded"
+	// 推送失败的消息数
+	StatFailed = "failed"
+	// 由于推送动作被取消而未发送的消息数
+	StatCancelled = "cancelled"
+	// 由于消息过期而被丢弃的消息数
+	StatExpired = "expired"
+	// 由于用户在周期内收到的推送数超出频率限制而未发送的消息数
+	StatCapped = "capped"
+	// 由于同一推送动作已向该 token 发送过消息而被丢弃的消息数, 例如请求中包含重复的 token
+	StatDuplicated = "duplicated"
+	// 由于用户处于免打扰时段而被推迟发送的次数
+	StatDeferred = "deferred"
+	// 客户端上报的通知被打开的次数
+	StatOpened = "opened"
+	// 客户端上报的通知被忽略的次数
+	StatDismissed = "dismissed"
+
+	statsKeyPrefix = "push_action_stats"
+	// the stats of variant are stored in the same hash with the field variant:{name}:{stat}
+	variantStatPrefix = "variant:"
+)
+
+func getStatsKey(actionId string) string {
+	return fmt.Sprintf("%s:%s", statsKeyPrefix, actionId)
+}
+
+// VariantStat returns the field name of the stat of variant
+func VariantStat(variant, stat string) string {
+	return variantStatPrefix + variant + ":" + stat
+}
+
+// IncrStat increases the counter of the action by n
+func IncrStat(ctx context.Context, client *redis.Client, actionId, stat string, n int64) error {
+	if len(actionId) <= 0 {
+		return ActionIdIsEmpty
+	}
+	key := getStatsKey(actionId)
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, stat, n)
+		pipe.Expire(ctx, key, keyTTL)
+		return nil
+	})
+	return err
+}
+
+// IncrVariantStat increases both the counter of the action and the counter of the variant by n
+func IncrVariantStat(ctx context.Context, client *redis.Client, actionId, variant, stat string, n int64) error {
+	if len(actionId) <= 0 {
+		return ActionIdIsEmpty
+	}
+	key := getStatsKey(actionId)
+	_, err := client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, stat, n)
+		pipe.HIncrBy(ctx, key, VariantStat(variant, stat), n)
+		pipe.Expire(ctx, key, keyTTL)
+		return nil
+	})
+	return err
+}
+
+// SplitVariantStats splits the stats returned by GetStats into the stats of action and the stats of each variant
+func SplitVariantStats(stats map[string]int64) (map[string]int64, map[string]map[string]int64) {
+	actionStats := make(map[string]int64, len(stats))
+	var variantStats map[string]map[string]int64
+	for k, v := range stats {
+		i := strings.LastIndex(k, ":")
+		if !strings.HasPrefix(k, variantStatPrefix) || i < len(variantStatPrefix) {
+			actionStats[k] = v
+			continue
+		}
+		if variantStats == nil {
+			variantStats = make(map[string]map[string]int64)
+		}
+		variant := k[len(variantStatPrefix):i]
+		if variantStats[variant] == nil {
+			variantStats[variant] = make(map[string]int64)
+		}
+		variantStats[variant][k[i+1:]] = v
+	}
+	return actionStats, variantStats
+}
+
+// GetStats returns all the counters of the action
+func GetStats(ctx context.Context, client *redis.Client, actionId string) (map[string]int64, error) {
+	values, err := client.HGetAll(ctx, getStatsKey(actionId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]int64, len(values))
+	for k, v := range values {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of action stat %s: %w", k, err)
+		}
+		stats[k] = n
+	}
+	return stats, nil
+}
@@ -2,7 +2,9 @@ package api
 
 import (
 	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/cache"
 	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/db"
 	"github.com/shitamachi/push-service/ent"
 	"github.com/shitamachi/push-service/log"
 	"github.com/shitamachi/redisqueue/v2"
@@ -11,7 +13,8 @@ import (
 )
 
 type AppContext struct {
-	Config      *config.AppConfig
+	// the config may be reloaded, get the current one by Config.Get
+	Config      *config.Store
 	Logger      *zap.Logger
 	RedisClient *redis.Client
 	Db          *ent.Client
@@ -19,7 +22,7 @@ type AppContext struct {
 	Producer    *redisqueue.Producer
 }
 
-func NewAppContext(config *config.AppConfig, logger *zap.Logger, redisClient *redis.Client, db *ent.Client, consumer *redisqueue.Consumer, producer *redisqueue.Producer) *AppContext {
+func NewAppContext(config *config.Store, logger *zap.Logger, redisClient *redis.Client, db *ent.Client, consumer *redisqueue.Consumer, producer *redisqueue.Producer) *AppContext {
 	return &AppContext{Config: config, Logger: logger, RedisClient: redisClient, Db: db, Consumer: consumer, Producer: producer}
 }
 
@@ -38,9 +41,16 @@ func (a AppContext) Err() error {
 func (a AppContext) Value(key any) any {
 	switch key.(type) {
 	case config.SetConfigToContextKey:
-		return a.Config
+		if a.Config == nil {
+			return (*config.AppConfig)(nil)
+		}
+		return a.Config.Get()
 	case log.SetLoggerToContextKey:
 		return a.Logger
+	case cache.SetRedisToContextKey:
+		return a.RedisClient
+	case db.SetDBToContextKey:
+		return a.Db
 	default:
 		return nil
 	}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/shitamachi/push-service/audit"
+	"go.uber.org/zap"
+)
+
+// Audit marks the request as an audited operation, the returned entry is filled by the handler
+// and the audit log is written with the response after the handler returns
+func (ctx *Context) Audit(operation string) *audit.Entry {
+	if ctx.audit == nil {
+		ctx.audit = &audit.Entry{Operation: operation}
+	}
+	return ctx.audit
+}
+
+// AuditEntry returns the audit entry of request, nil is returned if the request is not audited
+func (ctx *Context) AuditEntry() *audit.Entry {
+	return ctx.audit
+}
+
+func (ctx *Context) writeAudit(response *Response) {
+	if ctx.audit == nil {
+		return
+	}
+	err := audit.Write(ctx.AppContext, ctx.Caller, ctx.Req, ctx.FullPath, ctx.audit, response.HttpCode, response.Message)
+	if err != nil {
+		ctx.Logger.Error("writeAudit: failed to write audit log",
+			zap.String("operation", ctx.audit.Operation),
+			zap.String("action_id", ctx.audit.ActionId),
+			zap.Int("status_code", response.HttpCode),
+			zap.Error(err),
+		)
+	}
+}
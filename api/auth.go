@@ -0,0 +1,82 @@
+package api
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/shitamachi/push-service/auth"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// authenticate authenticates the request with its API key or signature and checks the permission,
+// the user JWT is accepted only if allowUser is true. The response options are returned when the request is rejected.
+func (ctx *Context) authenticate(permission auth.Permission, allowUser bool) (*auth.Caller, ResponseOptions) {
+	var (
+		caller *auth.Caller
+		err    error
+	)
+	if token := auth.GetKeyFromRequest(ctx.Req); allowUser && !auth.IsSignedRequest(ctx.Req) && auth.IsUserToken(token) {
+		caller, err = auth.AuthenticateUser(ctx, token)
+	} else {
+		caller, err = auth.AuthenticateRequest(ctx, ctx.Req)
+	}
+	switch {
+	case auth.IsUnauthorized(err):
+		return nil, Error(http.StatusUnauthorized, err.Error())
+	case err != nil:
+		ctx.Logger.Error("authenticate: failed to authenticate api key", zap.Error(err))
+		return nil, Error(http.StatusInternalServerError, "failed to authenticate api key")
+	case caller.IsUser():
+		// the users can only call the routes which allow them, the handlers check the user themselves
+	case !caller.HasPermission(permission):
+		ctx.Logger.Warn("authenticate: permission denied",
+			zap.Int("api_key_id", caller.KeyId),
+			zap.String("api_key_name", caller.Name),
+			zap.String("permission", permission),
+			zap.String("path", ctx.Req.URL.Path),
+		)
+		return nil, Error(http.StatusForbidden, "api key does not have the "+permission+" permission")
+	}
+	return caller, nil
+}
+
+// RequirePermission returns the gin middleware which authenticates the request,
+// it is used for the routes which are not wrapped by WrapperGinHandleFunc
+func (ctx *Context) RequirePermission(permission auth.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reqCtx := &Context{
+			AppContext: ctx.AppContext,
+			Writer:     c.Writer,
+			Req:        c.Request,
+		}
+		if _, resp := reqCtx.authenticate(permission, false); resp != nil {
+			response := NewResponse(resp)
+			bytes, _ := json.Marshal(response)
+			c.Data(response.HttpCode, "application/json; charset=utf-8", bytes)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// AuthorizeApps checks whether the caller can access the apps,
+// the response options are returned when the caller can not access any of them
+func (ctx *Context) AuthorizeApps(appIds ...string) ResponseOptions {
+	if ctx.Caller == nil || ctx.Caller.CanAccessApps(appIds...) {
+		return nil
+	}
+	if len(appIds) <= 0 {
+		return Error(http.StatusForbidden, "app ids must be specified for the api key which is scoped to apps")
+	}
+	return Error(http.StatusForbidden, "api key can not access the apps")
+}
+
+// AuthorizeUser checks whether the user of mobile clients is the same as the user in request,
+// the callers which are API keys can access all the users
+func (ctx *Context) AuthorizeUser(userId string) ResponseOptions {
+	if ctx.Caller == nil || !ctx.Caller.IsUser() || ctx.Caller.UserId == userId {
+		return nil
+	}
+	return Error(http.StatusForbidden, "user token can not access the user")
+}
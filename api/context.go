@@ -3,6 +3,11 @@ package api
 import (
 	"encoding/json"
 	"github.com/gin-gonic/gin"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/auth"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/tracing"
+	"go.uber.org/zap"
 	"io"
 	"net/http"
 	"time"
@@ -12,6 +17,14 @@ type Context struct {
 	*AppContext
 	Writer http.ResponseWriter
 	Req    *http.Request
+	// 路由中的路径参数
+	Params gin.Params
+	// 请求使用的 API key
+	Caller *auth.Caller
+	// 路由的路径, 例如 /v1/actions/:action_id
+	FullPath string
+	// audit is set by the handler of audited operation
+	audit *audit.Entry
 }
 
 type ResponseData interface{}
@@ -29,6 +42,8 @@ type ResponseEntry struct {
 
 type Response struct {
 	HttpCode int `json:"-"`
+	// the handler has written the response body by itself, e.g. the exported file
+	written bool
 	ResponseEntry
 }
 
@@ -68,6 +83,17 @@ func Data(data ResponseData) ResponseOption {
 	})
 }
 
+// Written marks the response as written by the handler, only the audit log is written after the handler returns
+func Written(code int) []ResponseOption {
+	return []ResponseOption{
+		HttpCode(code),
+		Status(code),
+		optionFunc(func(r *Response) {
+			r.written = true
+		}),
+	}
+}
+
 func HandleFunc(pattern string, f func(ctx *Context) []ResponseOption) {
 	http.DefaultServeMux.HandleFunc(pattern, func(writer http.ResponseWriter, request *http.Request) {
 		responseOptions := f(&Context{
@@ -88,14 +114,51 @@ func HandleFunc(pattern string, f func(ctx *Context) []ResponseOption) {
 	})
 }
 
-func (ctx *Context) WrapperGinHandleFunc(f func(ctx *Context) ResponseOptions) gin.HandlerFunc {
+// WrapperGinHandleFunc wraps the handler as gin handler, the request must be authenticated with
+// an API key which has the permission before calling the handler
+func (ctx *Context) WrapperGinHandleFunc(permission auth.Permission, f func(ctx *Context) ResponseOptions) gin.HandlerFunc {
+	return ctx.wrapperGinHandleFunc(permission, false, f)
+}
+
+// WrapperGinHandleFuncForUser is the same as WrapperGinHandleFunc except that the request can also be
+// authenticated with the user JWT of mobile clients, the handler must check the user with AuthorizeUser
+func (ctx *Context) WrapperGinHandleFuncForUser(permission auth.Permission, f func(ctx *Context) ResponseOptions) gin.HandlerFunc {
+	return ctx.wrapperGinHandleFunc(permission, true, f)
+}
+
+func (ctx *Context) wrapperGinHandleFunc(permission auth.Permission, allowUser bool, f func(ctx *Context) ResponseOptions) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		responseOptions := f(&Context{
+		spanCtx, span := tracing.StartServerSpan(c.Request, c.FullPath())
+		defer span.End()
+
+		reqCtx := &Context{
 			AppContext: ctx.AppContext,
 			Writer:     c.Writer,
-			Req:        c.Request,
-		})
+			Req:        c.Request.WithContext(spanCtx),
+			Params:     c.Params,
+			FullPath:   c.FullPath(),
+		}
+		// every log of the request is attributed to the request id, and to the api key once authenticated
+		if requestId := log.GetRequestId(spanCtx); len(requestId) > 0 {
+			reqCtx.WithLogFields(zap.String("request_id", requestId))
+		}
+		caller, responseOptions := reqCtx.authenticate(permission, allowUser)
+		if caller != nil {
+			fields := []zap.Field{zap.Int("api_key_id", caller.KeyId), zap.String("api_key_name", caller.Name)}
+			if caller.IsUser() {
+				fields = append(fields, zap.String("user_id", caller.UserId))
+			}
+			reqCtx.WithLogFields(fields...)
+			reqCtx.Caller = caller
+			span.SetAttributes(tracing.ApiKeyIdKey.Int(caller.KeyId))
+			responseOptions = f(reqCtx)
+		}
 		response := NewResponse(responseOptions)
+		tracing.SetServerSpanStatus(span, response.HttpCode)
+		reqCtx.writeAudit(response)
+		if response.written {
+			return
+		}
 
 		bytes, err := json.Marshal(response)
 		if err != nil {
@@ -152,6 +215,10 @@ func (ctx *Context) GetBody() ([]byte, error) {
 	return io.ReadAll(ctx.Req.Body)
 }
 
+func (ctx *Context) Param(key string) string {
+	return ctx.Params.ByName(key)
+}
+
 func (ctx *Context) Deadline() (deadline time.Time, ok bool) {
 	if ctx.Req == nil || ctx.Req.Context() == nil {
 		return
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shitamachi/push-service/log"
+	"go.uber.org/zap"
+)
+
+const (
+	RequestIdHeader = "X-Request-ID"
+	// the incoming request id longer than it is replaced, so that the logs can not be flooded by the caller
+	maxRequestIdLength = 128
+)
+
+// RequestId is the middleware which propagates the request id of caller or assigns a new one,
+// the id is responded in the header and stored in the context of request
+func RequestId() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestId := c.GetHeader(RequestIdHeader)
+		if !isValidRequestId(requestId) {
+			requestId = uuid.NewString()
+		}
+		c.Header(RequestIdHeader, requestId)
+		c.Request = c.Request.WithContext(log.SetRequestIdToContext(c.Request.Context(), requestId))
+		c.Next()
+	}
+}
+
+// isValidRequestId checks the request id only consists of the printable ascii characters
+func isValidRequestId(requestId string) bool {
+	if len(requestId) <= 0 || len(requestId) > maxRequestIdLength {
+		return false
+	}
+	for i := 0; i < len(requestId); i++ {
+		if requestId[i] < 0x21 || requestId[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// WithLogFields attaches the fields to the logger of request once they are known, e.g. the action id
+func (ctx *Context) WithLogFields(fields ...zap.Field) {
+	appCtx := *ctx.AppContext
+	appCtx.Logger = appCtx.Logger.With(fields...)
+	ctx.AppContext = &appCtx
+}
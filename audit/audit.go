@@ -0,0 +1,148 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/shitamachi/push-service/auth"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/models"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	OperationPushBatch     = "push.batch"
+	OperationPushBroadcast = "push.broadcast"
+
+	OperationActionCancel = "action.cancel"
+	OperationActionPause  = "action.pause"
+	OperationActionResume = "action.resume"
+
+	OperationAudienceCreate        = "audience.create"
+	OperationAudienceDelete        = "audience.delete"
+	OperationAudienceUploadMembers = "audience.upload_members"
+
+	OperationApiKeyCreate              = "api_key.create"
+	OperationApiKeyRevoke              = "api_key.revoke"
+	OperationApiKeyRotateSigningSecret = "api_key.rotate_signing_secret"
+
+	OperationWebhookCreate = "webhook.create"
+	OperationWebhookUpdate = "webhook.update"
+	OperationWebhookDelete = "webhook.delete"
+
+	OperationAppCreate = "app.create"
+	OperationAppUpdate = "app.update"
+	OperationAppDelete = "app.delete"
+
+	OperationConfigReload = "config.reload"
+)
+
+const (
+	maxMessageSummaryLength = 1024
+	maxTargetLength         = 255
+	maxResultLength         = 255
+)
+
+// Entry is the audited details of operation, it is filled by the handler and written after the request is handled
+type Entry struct {
+	Operation string
+	// the id of the changed entity, e.g. the id of api key or webhook subscription
+	Target       string
+	ActionId     string
+	AppIds       []string
+	AudienceSize int64
+	// see SummarizeMessage
+	MessageSummary string
+}
+
+// Write appends the audit log of request
+func Write(ctx context.Context, caller *auth.Caller, r *http.Request, endpoint string, entry *Entry, statusCode int, result string) error {
+	create := newCreate(ctx, entry, statusCode, result).
+		SetMethod(r.Method).
+		SetEndpoint(endpoint).
+		SetRemoteAddr(r.RemoteAddr)
+	if caller != nil {
+		create.
+			SetCallerKeyID(caller.KeyId).
+			SetCallerName(caller.Name).
+			SetCallerUserID(caller.UserId)
+	} else {
+		create.SetCallerName("anonymous")
+	}
+	return create.Exec(ctx)
+}
+
+// WriteConfigReload appends the audit log of config reload, it is used as the config.AuditFunc of config store.
+// The endpoint is the path of config file and the changed settings are the target.
+func WriteConfigReload(ctx context.Context, path string, changed []string, reloadErr error) error {
+	statusCode, result := http.StatusOK, "config is reloaded"
+	if reloadErr != nil {
+		statusCode, result = http.StatusInternalServerError, reloadErr.Error()
+	}
+	entry := &Entry{
+		Operation: OperationConfigReload,
+		Target:    strings.Join(changed, ","),
+	}
+	return newCreate(ctx, entry, statusCode, result).
+		SetMethod("RELOAD").
+		SetEndpoint(path).
+		SetCallerName("system").
+		Exec(ctx)
+}
+
+func newCreate(ctx context.Context, entry *Entry, statusCode int, result string) *ent.AuditLogCreate {
+	return db.GetFromContext(ctx).AuditLog.Create().
+		SetOperation(entry.Operation).
+		SetTarget(truncate(entry.Target, maxTargetLength)).
+		SetActionID(entry.ActionId).
+		SetAppIds(entry.AppIds).
+		SetAudienceSize(entry.AudienceSize).
+		SetMessageSummary(truncate(entry.MessageSummary, maxMessageSummaryLength)).
+		SetStatusCode(statusCode).
+		SetResult(truncate(result, maxResultLength))
+}
+
+// SummarizeMessage returns the summary of pushed message, the body and data are omitted
+func SummarizeMessage(message *models.PushMessage, variants []models.MessageVariant) string {
+	parts := make([]string, 0, len(variants)+1)
+	if message != nil {
+		parts = append(parts, summarizeBaseMessage("", &message.BaseMessage))
+	}
+	for _, variant := range variants {
+		if variant.Message != nil {
+			parts = append(parts, summarizeBaseMessage(variant.Name, &variant.Message.BaseMessage))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func summarizeBaseMessage(variant string, m *models.BaseMessage) string {
+	var b strings.Builder
+	if len(variant) > 0 {
+		fmt.Fprintf(&b, "variant=%s ", variant)
+	}
+	fmt.Fprintf(&b, "title=%q", m.Title)
+	if len(m.Category) > 0 {
+		fmt.Fprintf(&b, " category=%s", m.Category)
+	}
+	if len(m.Priority) > 0 {
+		fmt.Fprintf(&b, " priority=%s", m.Priority)
+	}
+	if len(m.TemplateId) > 0 {
+		fmt.Fprintf(&b, " template_id=%s", m.TemplateId)
+	}
+	return b.String()
+}
+
+// truncate truncates s to at most n bytes without breaking the multibyte characters
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
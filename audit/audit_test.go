@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"github.com/shitamachi/push-service/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 5))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	// "推" is 3 bytes, it must not be cut in half
+	assert.Equal(t, "a", truncate("a推送", 3))
+	assert.Equal(t, "a推", truncate("a推送", 4))
+}
+
+func TestSummarizeMessage(t *testing.T) {
+	message := &models.PushMessage{}
+	message.Title = "hello"
+	message.Body = "secret body"
+	message.Category = "promotion"
+
+	variant := &models.PushMessage{}
+	variant.Title = "hi"
+	summary := SummarizeMessage(message, []models.MessageVariant{{Name: "b", Message: variant}})
+
+	assert.Equal(t, `title="hello" category=promotion; variant=b title="hi"`, summary)
+	assert.NotContains(t, summary, "secret body")
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/apikey"
+	"github.com/shitamachi/push-service/log"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// last_used_at is updated at most once in this interval to avoid writing db on every request
+const lastUsedInterval = time.Minute
+
+var (
+	KeyIsEmpty = errors.New("api key is empty")
+	InvalidKey = errors.New("api key is invalid, revoked or expired")
+)
+
+var unauthorizedErrors = []error{KeyIsEmpty, InvalidKey, InvalidSignature, InvalidTimestamp, InvalidNonce, InvalidUserToken}
+
+// IsUnauthorized reports whether the error is caused by the invalid credentials of request
+func IsUnauthorized(err error) bool {
+	for _, e := range unauthorizedErrors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticateRequest returns the caller of request, the request is authenticated
+// with its signature if it is signed, otherwise with its API key
+func AuthenticateRequest(ctx context.Context, r *http.Request) (*Caller, error) {
+	if config.GetFromContext(ctx).Auth.Disable {
+		return rootCaller, nil
+	}
+	if !IsSignedRequest(r) {
+		return Authenticate(ctx, GetKeyFromRequest(r))
+	}
+
+	record, err := authenticateSignature(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return getCaller(ctx, record)
+}
+
+// Authenticate returns the caller of the API key
+func Authenticate(ctx context.Context, key string) (*Caller, error) {
+	authConfig := config.GetFromContext(ctx).Auth
+	if authConfig.Disable {
+		return rootCaller, nil
+	}
+	if len(key) <= 0 {
+		return nil, KeyIsEmpty
+	}
+	hash := HashKey(key)
+	if len(authConfig.RootKey) > 0 && subtle.ConstantTimeCompare([]byte(hash), []byte(HashKey(authConfig.RootKey))) == 1 {
+		return rootCaller, nil
+	}
+
+	record, err := db.GetFromContext(ctx).ApiKey.Query().Where(apikey.KeyHash(hash)).Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, InvalidKey
+	case err != nil:
+		return nil, err
+	}
+	return getCaller(ctx, record)
+}
+
+// getCaller checks whether the API key is still valid and returns it as caller
+func getCaller(ctx context.Context, record *ent.ApiKey) (*Caller, error) {
+	now := time.Now()
+	if record.RevokedAt != nil || record.ExpiresAt != nil && now.After(*record.ExpiresAt) {
+		return nil, InvalidKey
+	}
+
+	if record.LastUsedAt == nil || now.Sub(*record.LastUsedAt) >= lastUsedInterval {
+		err := db.GetFromContext(ctx).ApiKey.UpdateOne(record).SetLastUsedAt(now).Exec(ctx)
+		if err != nil {
+			log.WithCtx(ctx).Warn("Authenticate: failed to update last used time of api key", zap.Int("key_id", record.ID), zap.Error(err))
+		}
+	}
+
+	return &Caller{
+		KeyId:       record.ID,
+		Name:        record.Name,
+		AppIds:      record.AppIds,
+		Permissions: record.Permissions,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package auth
+
+// Caller is the API key which the request is authenticated with
+type Caller struct {
+	// the id of API key, 0 for the root key
+	KeyId int
+	Name  string
+	// empty means all the apps
+	AppIds      []string
+	Permissions []Permission
+	// the subject of user JWT, it is set only when the request is authenticated with the user token of mobile clients
+	UserId string
+}
+
+// rootCaller has all the permissions of all the apps, it is used for the root key and when the authentication is disabled
+var rootCaller = &Caller{
+	Name:        "root",
+	Permissions: []Permission{PermissionAdmin},
+}
+
+// IsUser reports whether the caller is a user of mobile clients instead of API key
+func (c *Caller) IsUser() bool {
+	return len(c.UserId) > 0
+}
+
+// IsScoped reports whether the caller can only access part of the apps
+func (c *Caller) IsScoped() bool {
+	return len(c.AppIds) > 0
+}
+
+func (c *Caller) HasPermission(p Permission) bool {
+	for _, permission := range c.Permissions {
+		if permission == p || permission == PermissionAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccessApps reports whether the caller can access all the apps,
+// empty apps means all the apps so only the caller which is not scoped can access it
+func (c *Caller) CanAccessApps(appIds ...string) bool {
+	if !c.IsScoped() {
+		return true
+	}
+	if len(appIds) <= 0 {
+		return false
+	}
+	for _, appId := range appIds {
+		if !c.canAccessApp(appId) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Caller) canAccessApp(appId string) bool {
+	for _, id := range c.AppIds {
+		if id == appId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCaller_HasPermission(t *testing.T) {
+	caller := &Caller{Permissions: []Permission{PermissionSend}}
+	assert.True(t, caller.HasPermission(PermissionSend))
+	assert.False(t, caller.HasPermission(PermissionBroadcast))
+	assert.False(t, caller.HasPermission(PermissionAdmin))
+	// the metrics permission does not grant any other permission
+	scraper := &Caller{Permissions: []Permission{PermissionMetrics}}
+	assert.True(t, scraper.HasPermission(PermissionMetrics))
+	assert.False(t, scraper.HasPermission(PermissionSend))
+	assert.False(t, scraper.HasPermission(PermissionAdmin))
+
+	// admin has all the permissions
+	assert.True(t, rootCaller.HasPermission(PermissionBroadcast))
+	assert.True(t, rootCaller.HasPermission(PermissionTokens))
+}
+
+func TestCaller_CanAccessApps(t *testing.T) {
+	assert.True(t, rootCaller.CanAccessApps())
+	assert.True(t, rootCaller.CanAccessApps("a", "b"))
+
+	caller := &Caller{AppIds: []string{"a", "b"}}
+	assert.True(t, caller.CanAccessApps("a"))
+	assert.True(t, caller.CanAccessApps("a", "b"))
+	assert.False(t, caller.CanAccessApps("a", "c"))
+	// empty apps means all the apps
+	assert.False(t, caller.CanAccessApps())
+}
+
+func TestGenerateKey(t *testing.T) {
+	key, prefix, hash, err := GenerateKey()
+	assert.NoError(t, err)
+	assert.Len(t, key, len(keyPrefix)+2*keyLength)
+	assert.Equal(t, key[:displayPrefixLength], prefix)
+	assert.Equal(t, HashKey(key), hash)
+	assert.NotEqual(t, key, hash)
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// the key set is refreshed in this interval to pick up the rotated keys
+	jwksRefreshInterval = time.Hour
+	// the key set is refreshed at most once in this interval when the key id of token is not found
+	jwksMinRefreshInterval = time.Minute
+	jwksRequestTimeout     = 10 * time.Second
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type publicKey struct {
+	kid string
+	key crypto.PublicKey
+}
+
+type keySet struct {
+	keys      []publicKey
+	fetchedAt time.Time
+}
+
+var jwksCache = struct {
+	sync.Mutex
+	items map[string]*keySet
+}{items: make(map[string]*keySet)}
+
+var jwksClient = &http.Client{Timeout: jwksRequestTimeout}
+
+// getJwksKeys returns the keys of the JWKS url which match the key id, all the keys are returned for an empty key id
+func getJwksKeys(ctx context.Context, url, kid string) ([]crypto.PublicKey, error) {
+	now := time.Now()
+	jwksCache.Lock()
+	cached := jwksCache.items[url]
+	jwksCache.Unlock()
+
+	if cached == nil || now.Sub(cached.fetchedAt) >= jwksRefreshInterval ||
+		len(matchKeys(cached.keys, kid)) <= 0 && now.Sub(cached.fetchedAt) >= jwksMinRefreshInterval {
+		keys, err := fetchJwks(ctx, url)
+		switch {
+		case err == nil:
+			cached = &keySet{keys: keys, fetchedAt: now}
+			jwksCache.Lock()
+			jwksCache.items[url] = cached
+			jwksCache.Unlock()
+		case cached == nil:
+			return nil, err
+		}
+		// the stale keys are still used if failed to refresh
+	}
+	return matchKeys(cached.keys, kid), nil
+}
+
+func matchKeys(keys []publicKey, kid string) []crypto.PublicKey {
+	matched := make([]crypto.PublicKey, 0, len(keys))
+	for _, k := range keys {
+		if len(kid) <= 0 || k.kid == kid {
+			matched = append(matched, k.key)
+		}
+	}
+	return matched
+}
+
+func fetchJwks(ctx context.Context, url string) ([]publicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := jwksClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks endpoint responded with status %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+	keys := make([]publicKey, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if len(k.Use) > 0 && k.Use != "sig" {
+			continue
+		}
+		key, err := parseJwk(k)
+		if err != nil {
+			// the keys of unsupported types are skipped
+			continue
+		}
+		keys = append(keys, publicKey{kid: k.Kid, key: key})
+	}
+	return keys, nil
+}
+
+func parseJwk(k jsonWebKey) (crypto.PublicKey, error) {
+	decode := base64.RawURLEncoding.DecodeString
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid ed25519 key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+const (
+	// 请求头中的 API key, 也可以使用 Authorization: Bearer <key>
+	KeyHeader = "X-Api-Key"
+
+	keyPrefix = "psk_"
+	keyLength = 24
+	// the length of prefix which is saved for identifying the key
+	displayPrefixLength = len(keyPrefix) + 8
+)
+
+// GenerateKey generates a new API key and returns it with its display prefix and hash
+func GenerateKey() (key, prefix, hash string, err error) {
+	b := make([]byte, keyLength)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = keyPrefix + hex.EncodeToString(b)
+	return key, key[:displayPrefixLength], HashKey(key), nil
+}
+
+// HashKey returns the hash of key which is saved in db, the keys are random enough so a fast hash is used
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetKeyFromRequest returns the API key in the KeyHeader or the Authorization bearer token
+func GetKeyFromRequest(r *http.Request) string {
+	if key := r.Header.Get(KeyHeader); len(key) > 0 {
+		return key
+	}
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) > 7 && strings.EqualFold(authorization[:7], "Bearer ") {
+		return strings.TrimSpace(authorization[7:])
+	}
+	return ""
+}
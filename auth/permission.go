@@ -0,0 +1,27 @@
+package auth
+
+type Permission = string
+
+const (
+	// 推送消息以及查询, 取消推送动作
+	PermissionSend Permission = "send"
+	// 全体推送以及管理受众
+	PermissionBroadcast Permission = "broadcast"
+	// 管理设备 token, 用户的推送设置以及上报通知事件
+	PermissionTokens Permission = "tokens"
+	// 只读访问 /metrics 指标, 用于 Prometheus 抓取; 指标包含所有 app 的数据
+	PermissionMetrics Permission = "metrics"
+	// 管理 API key, webhook 以及访问调试接口, 拥有所有权限
+	PermissionAdmin Permission = "admin"
+)
+
+var Permissions = []Permission{PermissionSend, PermissionBroadcast, PermissionTokens, PermissionMetrics, PermissionAdmin}
+
+func IsValidPermission(p string) bool {
+	for _, permission := range Permissions {
+		if permission == p {
+			return true
+		}
+	}
+	return false
+}
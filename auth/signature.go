@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/secret"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// 使用请求签名认证时的 API key id
+	KeyIdHeader = "X-Push-Key-Id"
+	// 请求签名, 格式为 v1=<hex>, 为使用签名密钥对 StringToSign 计算的 HMAC-SHA256
+	SignatureHeader = "X-Push-Signature"
+	// 签名时的 unix 时间戳, 单位 s; 与服务端时间相差超过 5 分钟的请求将被拒绝
+	TimestampHeader = "X-Push-Timestamp"
+	// 每个请求唯一的随机字符串, 用于防止请求重放
+	NonceHeader = "X-Push-Nonce"
+
+	signatureVersion = "v1"
+	maxClockSkew     = 5 * time.Minute
+	maxNonceLength   = 64
+	nonceKeyPrefix   = "request_nonce"
+	secretLength     = 32
+	// the prefix of the signing secrets which are stored encrypted
+	encryptedSecretPrefix = "enc:"
+)
+
+var (
+	InvalidSignature = errors.New("request signature is invalid")
+	InvalidTimestamp = errors.New("request timestamp is invalid or out of the allowed window")
+	InvalidNonce     = errors.New("request nonce is invalid or has been used")
+)
+
+// StringToSign returns the content of request which is signed, it is
+// <method>\n<path with query>\n<timestamp>\n<nonce>\n<hex sha256 of body>
+func StringToSign(method, uri string, timestamp int64, nonce string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	return strings.Join([]string{
+		strings.ToUpper(method),
+		uri,
+		strconv.FormatInt(timestamp, 10),
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+}
+
+// SignRequest returns the signature of request which is sent in the SignatureHeader
+func SignRequest(secret, method, uri string, timestamp int64, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(StringToSign(method, uri, timestamp, nonce, body)))
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateSigningSecret generates a random secret for signing requests
+func GenerateSigningSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// IsSignedRequest reports whether the request is authenticated with signature instead of API key
+func IsSignedRequest(r *http.Request) bool {
+	return len(r.Header.Get(SignatureHeader)) > 0
+}
+
+// EncryptSigningSecret returns the signing secret to be stored in database,
+// it is encrypted if the encryption key is set, otherwise the plaintext is returned
+func EncryptSigningSecret(conf config_entries.AuthConfig, signingSecret string) (string, error) {
+	if len(conf.SigningSecretEncryptionKey) <= 0 {
+		return signingSecret, nil
+	}
+	c, err := secret.NewCipher(conf.SigningSecretEncryptionKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := c.Encrypt([]byte(signingSecret))
+	if err != nil {
+		return "", err
+	}
+	return encryptedSecretPrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decryptSigningSecret returns the plaintext of the signing secret stored in database,
+// the secrets stored before the encryption key is set are plaintext
+func decryptSigningSecret(conf config_entries.AuthConfig, stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedSecretPrefix) {
+		return stored, nil
+	}
+	if len(conf.SigningSecretEncryptionKey) <= 0 {
+		return "", errors.New("signing secret is encrypted but the encryption key is not set")
+	}
+	c, err := secret.NewCipher(conf.SigningSecretEncryptionKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(stored[len(encryptedSecretPrefix):])
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// getSigningSecrets returns the stored secrets which are accepted now, the previous one is accepted until it expires
+func getSigningSecrets(record *ent.ApiKey, now time.Time) []string {
+	secrets := make([]string, 0, 2)
+	if len(record.SigningSecret) > 0 {
+		secrets = append(secrets, record.SigningSecret)
+	}
+	if len(record.PreviousSigningSecret) > 0 && record.PreviousSigningSecretExpiresAt != nil && now.Before(*record.PreviousSigningSecretExpiresAt) {
+		secrets = append(secrets, record.PreviousSigningSecret)
+	}
+	return secrets
+}
+
+// authenticateSignature verifies the signature of request and returns the API key which signs it,
+// the body of request is read for verifying and replaced so that it can be read again.
+func authenticateSignature(ctx context.Context, r *http.Request) (*ent.ApiKey, error) {
+	keyId, err := strconv.Atoi(r.Header.Get(KeyIdHeader))
+	if err != nil {
+		return nil, InvalidKey
+	}
+	now := time.Now()
+	timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return nil, InvalidTimestamp
+	}
+	if skew := now.Sub(time.Unix(timestamp, 0)); skew > maxClockSkew || skew < -maxClockSkew {
+		return nil, InvalidTimestamp
+	}
+	nonce := r.Header.Get(NonceHeader)
+	if len(nonce) <= 0 || len(nonce) > maxNonceLength {
+		return nil, InvalidNonce
+	}
+
+	record, err := db.GetFromContext(ctx).ApiKey.Get(ctx, keyId)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, InvalidKey
+	case err != nil:
+		return nil, err
+	}
+	secrets := getSigningSecrets(record, now)
+	if len(secrets) <= 0 {
+		return nil, InvalidKey
+	}
+	authConfig := config.GetFromContext(ctx).Auth
+	for i := range secrets {
+		secrets[i], err = decryptSigningSecret(authConfig, secrets[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt signing secret of key %d: %w", keyId, err)
+		}
+	}
+
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	signature := []byte(r.Header.Get(SignatureHeader))
+	verified := false
+	for _, secret := range secrets {
+		expected := SignRequest(secret, r.Method, r.URL.RequestURI(), timestamp, nonce, body)
+		if hmac.Equal([]byte(expected), signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, InvalidSignature
+	}
+
+	// the nonce is recorded only after the signature is verified, so that others can not use up the nonces.
+	// It is kept longer than the allowed window of timestamp, the replayed request is rejected by its timestamp after that.
+	ok, err := cache.GetFromContext(ctx).SetNX(ctx, fmt.Sprintf("%s:%d:%s", nonceKeyPrefix, keyId, nonce), 1, 2*maxClockSkew).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, InvalidNonce
+	}
+	return record, nil
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStringToSign(t *testing.T) {
+	assert.Equal(t,
+		"POST\n/v1/tokens?a=1\n1600000000\nnonce\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		StringToSign("post", "/v1/tokens?a=1", 1600000000, "nonce", nil),
+	)
+}
+
+func TestSignRequest(t *testing.T) {
+	body := []byte(`{"app_id":"a"}`)
+	signature := SignRequest("secret", "POST", "/v1/tokens", 1600000000, "nonce", body)
+	assert.Equal(t, signature, SignRequest("secret", "POST", "/v1/tokens", 1600000000, "nonce", body))
+	assert.Regexp(t, "^v1=[0-9a-f]{64}$", signature)
+
+	// every part of request is covered by the signature
+	assert.NotEqual(t, signature, SignRequest("other", "POST", "/v1/tokens", 1600000000, "nonce", body))
+	assert.NotEqual(t, signature, SignRequest("secret", "DELETE", "/v1/tokens", 1600000000, "nonce", body))
+	assert.NotEqual(t, signature, SignRequest("secret", "POST", "/v1/preferences", 1600000000, "nonce", body))
+	assert.NotEqual(t, signature, SignRequest("secret", "POST", "/v1/tokens", 1600000001, "nonce", body))
+	assert.NotEqual(t, signature, SignRequest("secret", "POST", "/v1/tokens", 1600000000, "other", body))
+	assert.NotEqual(t, signature, SignRequest("secret", "POST", "/v1/tokens", 1600000000, "nonce", []byte(`{"app_id":"b"}`)))
+}
+
+func TestGetSigningSecrets(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	record := &ent.ApiKey{
+		SigningSecret:                  "new",
+		PreviousSigningSecret:          "old",
+		PreviousSigningSecretExpiresAt: &expiresAt,
+	}
+	assert.Equal(t, []string{"new", "old"}, getSigningSecrets(record, now))
+	// the previous secret is not accepted after it expires
+	assert.Equal(t, []string{"new"}, getSigningSecrets(record, now.Add(2*time.Hour)))
+	assert.Empty(t, getSigningSecrets(&ent.ApiKey{}, now))
+}
+
+func TestEncryptSigningSecret(t *testing.T) {
+	conf := config_entries.AuthConfig{SigningSecretEncryptionKey: "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}
+	stored, err := EncryptSigningSecret(conf, "secret")
+	assert.NoError(t, err)
+	assert.NotContains(t, stored, "secret")
+	plaintext, err := decryptSigningSecret(conf, stored)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	// the secrets stored before the encryption key is set are plaintext
+	plaintext, err = decryptSigningSecret(conf, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+
+	// the encrypted secrets can not be used without the key
+	stored, err = EncryptSigningSecret(config_entries.AuthConfig{}, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", stored)
+	_, err = decryptSigningSecret(config_entries.AuthConfig{}, encryptedSecretPrefix+"AAAA")
+	assert.Error(t, err)
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/log"
+	"go.uber.org/zap"
+	"sort"
+	"strings"
+)
+
+var InvalidUserToken = errors.New("user token is invalid or expired")
+
+// only the asymmetric algorithms are accepted, the mobile clients can not keep a shared secret
+var userTokenMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// IsUserToken reports whether the bearer token is a user JWT instead of API key
+func IsUserToken(token string) bool {
+	return !strings.HasPrefix(token, keyPrefix) && strings.Count(token, ".") == 2
+}
+
+// AuthenticateUser verifies the user JWT with the configs of apps whose issuer is the same as the token,
+// the returned caller is the subject of token and is scoped to the apps which the token is verified by.
+func AuthenticateUser(ctx context.Context, token string) (*Caller, error) {
+	authConfig := config.GetFromContext(ctx).Auth
+	if authConfig.Disable {
+		return rootCaller, nil
+	}
+	var unverified jwt.RegisteredClaims
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token, &unverified)
+	if err != nil {
+		return nil, InvalidUserToken
+	}
+	kid, _ := parsed.Header["kid"].(string)
+
+	userTokens := authConfig.UserTokens
+	appIds := make([]string, 0, len(userTokens))
+	for appId := range userTokens {
+		appIds = append(appIds, appId)
+	}
+	sort.Strings(appIds)
+
+	caller := &Caller{AppIds: make([]string, 0, 1)}
+	for _, appId := range appIds {
+		item := userTokens[appId]
+		if item.Issuer != unverified.Issuer {
+			continue
+		}
+		claims, err := verifyUserToken(ctx, token, kid, item)
+		if err != nil {
+			log.WithCtx(ctx).Debug("AuthenticateUser: failed to verify user token", zap.String("app_id", appId), zap.Error(err))
+			continue
+		}
+		caller.UserId = claims.Subject
+		caller.AppIds = append(caller.AppIds, appId)
+	}
+	if len(caller.AppIds) <= 0 {
+		return nil, InvalidUserToken
+	}
+	caller.Name = "user:" + caller.UserId
+	return caller, nil
+}
+
+func verifyUserToken(ctx context.Context, token, kid string, item config_entries.UserTokenAuthItem) (*jwt.RegisteredClaims, error) {
+	keys := make([]crypto.PublicKey, 0, len(item.Keys))
+	for _, pem := range item.Keys {
+		key, err := parsePublicKeyFromPEM([]byte(pem))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(item.JwksUrl) > 0 {
+		jwksKeys, err := getJwksKeys(ctx, item.JwksUrl, kid)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, jwksKeys...)
+	}
+
+	parser := jwt.NewParser(jwt.WithValidMethods(userTokenMethods))
+	err := InvalidUserToken
+	for _, key := range keys {
+		claims := new(jwt.RegisteredClaims)
+		_, err = parser.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+			return key, nil
+		})
+		if err != nil {
+			continue
+		}
+		switch {
+		case claims.ExpiresAt == nil || len(claims.Subject) <= 0:
+			return nil, InvalidUserToken
+		case !claims.VerifyIssuer(item.Issuer, true):
+			return nil, InvalidUserToken
+		case len(item.Audience) > 0 && !claims.VerifyAudience(item.Audience, true):
+			return nil, InvalidUserToken
+		}
+		return claims, nil
+	}
+	return nil, err
+}
+
+func parsePublicKeyFromPEM(pem []byte) (crypto.PublicKey, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+		return key, nil
+	}
+	return jwt.ParseEdPublicKeyFromPEM(pem)
+}
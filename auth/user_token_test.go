@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newUserToken(t *testing.T, key *ecdsa.PrivateKey, kid string, claims jwt.RegisteredClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return s
+}
+
+func newUserTokenContext(userTokens map[string]config_entries.UserTokenAuthItem) context.Context {
+	ctx := log.SetLoggerToContext(context.Background(), zap.NewNop())
+	return config.SetToContext(ctx, &config.AppConfig{
+		Auth: config_entries.AuthConfig{UserTokens: userTokens},
+	})
+}
+
+func TestAuthenticateUser(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+	publicPem := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	ctx := newUserTokenContext(map[string]config_entries.UserTokenAuthItem{
+		"app_a": {Issuer: "https://auth.example.com", Audience: "push", Keys: []string{publicPem}},
+		"app_b": {Issuer: "https://auth.example.com", Keys: []string{publicPem}},
+		"app_c": {Issuer: "https://other.example.com", Keys: []string{publicPem}},
+	})
+	valid := jwt.RegisteredClaims{
+		Issuer:    "https://auth.example.com",
+		Subject:   "user_1",
+		Audience:  jwt.ClaimStrings{"push"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	caller, err := AuthenticateUser(ctx, newUserToken(t, key, "", valid))
+	assert.NoError(t, err)
+	assert.Equal(t, "user_1", caller.UserId)
+	assert.Equal(t, []string{"app_a", "app_b"}, caller.AppIds)
+	assert.False(t, caller.HasPermission(PermissionTokens))
+
+	// the audience is only required by app_a
+	noAudience := valid
+	noAudience.Audience = nil
+	caller, err = AuthenticateUser(ctx, newUserToken(t, key, "", noAudience))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app_b"}, caller.AppIds)
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	_, err = AuthenticateUser(ctx, newUserToken(t, key, "", expired))
+	assert.ErrorIs(t, err, InvalidUserToken)
+
+	noExpiry := valid
+	noExpiry.ExpiresAt = nil
+	_, err = AuthenticateUser(ctx, newUserToken(t, key, "", noExpiry))
+	assert.ErrorIs(t, err, InvalidUserToken)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	_, err = AuthenticateUser(ctx, newUserToken(t, otherKey, "", valid))
+	assert.ErrorIs(t, err, InvalidUserToken)
+
+	// the symmetric algorithms are not accepted even if signed with the public key
+	hs, err := jwt.NewWithClaims(jwt.SigningMethodHS256, valid).SignedString([]byte(publicPem))
+	assert.NoError(t, err)
+	_, err = AuthenticateUser(ctx, hs)
+	assert.ErrorIs(t, err, InvalidUserToken)
+}
+
+func TestAuthenticateUserWithJwks(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kty: "EC",
+				Kid: "key_1",
+				Use: "sig",
+				Crv: "P-256",
+				X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+				Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+			}},
+		})
+	}))
+	defer server.Close()
+
+	ctx := newUserTokenContext(map[string]config_entries.UserTokenAuthItem{
+		"app_a": {Issuer: "https://auth.example.com", JwksUrl: server.URL},
+	})
+	claims := jwt.RegisteredClaims{
+		Issuer:    "https://auth.example.com",
+		Subject:   "user_1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	caller, err := AuthenticateUser(ctx, newUserToken(t, key, "key_1", claims))
+	assert.NoError(t, err)
+	assert.Equal(t, "user_1", caller.UserId)
+
+	_, err = AuthenticateUser(ctx, newUserToken(t, key, "key_2", claims))
+	assert.ErrorIs(t, err, InvalidUserToken)
+}
+
+func TestIsUserToken(t *testing.T) {
+	assert.True(t, IsUserToken("a.b.c"))
+	assert.False(t, IsUserToken("psk_0123456789abcdef"))
+	assert.False(t, IsUserToken(""))
+}
@@ -29,3 +29,16 @@ func InitRedis(ctx context.Context, conf config_entries.CacheConfig) (*redis.Cli
 
 	return cli, err
 }
+
+type SetRedisToContextKey string
+
+var key = SetRedisToContextKey("redis")
+
+func SetToContext(ctx context.Context, client *redis.Client) context.Context {
+	return context.WithValue(ctx, key, client)
+}
+
+func GetFromContext(ctx context.Context) *redis.Client {
+	client, _ := ctx.Value(key).(*redis.Client)
+	return client
+}
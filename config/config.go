@@ -3,8 +3,10 @@ package config
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/shitamachi/push-service/config/config_entries"
 	"os"
+	"time"
 )
 
 type AppConfig struct {
@@ -13,28 +15,66 @@ type AppConfig struct {
 	// mode; debug 发送的为测试环境的 push; production 为线上环境的 push
 	Mode string `json:"mode"`
 	// server port
-	Port               int                                        `json:"port"`
-	LogMode            string                                     `json:"log_mode"`
-	LogFilePath        string                                     `json:"log_file_path"`
+	Port int `json:"port"`
+	// (optional, default: 0) 收到退出信号后, 在关闭 http 服务前等待的时间, 期间 readiness 探针失败以便负载均衡摘除该实例, 单位 s
+	ShutdownDelay int    `json:"shutdown_delay"`
+	LogMode       string `json:"log_mode"`
+	LogFilePath   string `json:"log_file_path"`
+	// (optional) 日志推送到 loki 的配置, url 为空时不推送
+	Loki               config_entries.LokiConfig                  `json:"loki"`
 	ClientConfig       map[string]config_entries.ClientConfigItem `json:"client_config"`
 	DBConfig           config_entries.DBConfigItem                `json:"db_config"`
 	CacheConfig        config_entries.CacheConfig                 `json:"cache_config"`
 	ApplePushConfig    config_entries.ApplePushSecretConfig       `json:"apple_push_config"`
 	FirebasePushConfig config_entries.FirebaseConfig              `json:"firebase_push_config"`
 	Mq                 config_entries.MqConfig                    `json:"mq"`
+	RateLimit          config_entries.RateLimitConfig             `json:"rate_limit"`
+	Idempotency        config_entries.IdempotencyConfig           `json:"idempotency"`
+	FrequencyCap       config_entries.FrequencyCapConfig          `json:"frequency_cap"`
+	QuietHours         config_entries.QuietHoursConfig            `json:"quiet_hours"`
+	Auth               config_entries.AuthConfig                  `json:"auth"`
+	Tracing            config_entries.TracingConfig               `json:"tracing"`
+	// (optional) 数据库中的 app 注册表, 可以通过 API 添加 app, 无需修改配置文件
+	AppRegistry config_entries.AppRegistryConfig `json:"app_registry"`
+	// (optional) 密钥引用的获取方式, 密钥字段除了直接填写外, 还支持 file://<path>, env://<name> 和 vault://<path>#<key> 引用;
+	// 密钥轮换后发送 SIGHUP 重新加载配置, 推送客户端会使用新的密钥重建
+	SecretProviders config_entries.SecretProvidersConfig `json:"secret_providers"`
 }
 
-func InitConfig() *AppConfig {
-	bytes, err := os.ReadFile("conf/conf.json")
+const (
+	DefaultConfigPath = "conf/conf.json"
+	// the max time to resolve all the secret references of config
+	secretResolveTimeout = 30 * time.Second
+)
+
+// LoadConfig reads the config from the file, overrides it with the environment variables,
+// resolves the secret references and validates it.
+// The problems of environment variables and validation are reported at once by *ValidationError.
+func LoadConfig(path string) (*AppConfig, error) {
+	return loadConfig(path, os.LookupEnv)
+}
+
+func loadConfig(path string, lookupEnv func(key string) (string, bool)) (*AppConfig, error) {
+	bytes, err := os.ReadFile(path)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	var config AppConfig
 	err = json.Unmarshal(bytes, &config)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	problems := applyEnv(&config, lookupEnv)
+	ctx, cancel := context.WithTimeout(context.Background(), secretResolveTimeout)
+	defer cancel()
+	problems = append(problems, resolveSecrets(ctx, &config)...)
+	if err := config.Validate(); err != nil {
+		problems = append(problems, err.(*ValidationError).Problems...)
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
 	}
-	return &config
+	return &config, nil
 }
 
 type SetConfigToContextKey string
@@ -48,7 +88,7 @@ func SetToContext(ctx context.Context, config *AppConfig) context.Context {
 func GetFromContext(ctx context.Context) *AppConfig {
 	config := ctx.Value(key).(*AppConfig)
 	if config == nil {
-		config = InitConfig()
+		panic("config: config is not set to the context")
 	}
 
 	return config
@@ -0,0 +1,24 @@
+package config_entries
+
+import "time"
+
+const defaultAppRegistryCacheTTL = 30 * time.Second
+
+type AppRegistryConfig struct {
+	// (optional) 加密数据库中 app 推送凭证的 AES-256 密钥, base64 编码的 32 字节; 为空时不能通过 API 管理 app, 只使用配置文件中的 app;
+	// 支持 file://, env:// 和 vault:// 引用
+	EncryptionKey string `json:"encryption_key" secret:"true"`
+	// (optional, default: 30) 从数据库读取的 app 的缓存时间, 单位 s; 其他实例上对 app 的修改在缓存过期后生效
+	CacheTTL int `json:"cache_ttl"`
+}
+
+func (c AppRegistryConfig) IsEnabled() bool {
+	return len(c.EncryptionKey) > 0
+}
+
+func (c AppRegistryConfig) GetCacheTTL() time.Duration {
+	if c.CacheTTL <= 0 {
+		return defaultAppRegistryCacheTTL
+	}
+	return time.Duration(c.CacheTTL) * time.Second
+}
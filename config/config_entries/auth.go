@@ -0,0 +1,24 @@
+package config_entries
+
+type UserTokenAuthItem struct {
+	// 签发用户 JWT 的 issuer, 与 JWT 的 iss 一致
+	Issuer string `json:"issuer"`
+	// (optional) JWT 的 aud 必须包含的值
+	Audience string `json:"audience"`
+	// (optional) 获取签名公钥的 JWKS 地址
+	JwksUrl string `json:"jwks_url"`
+	// (optional) PEM 格式的签名公钥, 支持 RSA, ECDSA 和 Ed25519; 与 jwks_url 至少设置一个
+	Keys []string `json:"keys"`
+}
+
+type AuthConfig struct {
+	// (optional, default: false) 关闭 API key 认证, 所有请求都拥有全部权限; 仅用于本地开发
+	Disable bool `json:"disable"`
+	// (optional) 拥有全部权限且不限制 app 的根 API key, 用于创建第一个 API key; 创建 admin 权限的 API key 后建议移除; 支持 file://, env:// 和 vault:// 引用
+	RootKey string `json:"root_key" secret:"true"`
+	// (optional) 加密存储 API key 签名密钥的密钥, base64 编码的 32 字节 AES-256 密钥; 设置后新生成的签名密钥将加密后存入数据库,
+	// 设置之前生成的明文签名密钥仍然可用, 轮换后即为加密存储; 设置后不能更换或移除, 否则已加密的签名密钥将无法使用; 支持 file://, env:// 和 vault:// 引用
+	SigningSecretEncryptionKey string `json:"signing_secret_encryption_key" secret:"true"`
+	// (optional) 按 app id 配置的用户 JWT 认证, key 为 app id; 设置后移动端可以使用用户的 JWT 调用设备 token 接口, 只能注册和删除 JWT sub 对应用户的 token
+	UserTokens map[string]UserTokenAuthItem `json:"user_tokens"`
+}
@@ -1,9 +1,10 @@
 package config_entries
 
 type CacheConfig struct {
-	RedisAddr     string `json:"redis_addr"`
-	RedisDB       int    `json:"redis_db"`
-	RedisPassword string `json:"redis_password"`
+	RedisAddr string `json:"redis_addr"`
+	RedisDB   int    `json:"redis_db"`
+	// 支持 file://, env:// 和 vault:// 引用
+	RedisPassword string `json:"redis_password" secret:"true"`
 	ReadTimeout   int    `json:"read_timeout"`
 	WriteTimeout  int    `json:"write_timeout"`
 }
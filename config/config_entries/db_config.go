@@ -1,9 +1,12 @@
 package config_entries
 
 type DBConfigItem struct {
-	Addr     string `json:"addr"`
-	Port     int    `json:"port"`
-	User     string `json:"user"`
-	Password string `json:"password"`
+	Addr string `json:"addr"`
+	Port int    `json:"port"`
+	User string `json:"user"`
+	// 支持 file://, env:// 和 vault:// 引用
+	Password string `json:"password" secret:"true"`
 	DB       string `json:"db"`
+	// 启动时是否自动创建或更新数据表
+	AutoMigrate bool `json:"auto_migrate"`
 }
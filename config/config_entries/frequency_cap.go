@@ -0,0 +1,17 @@
+package config_entries
+
+type FrequencyCapRule struct {
+	// 规则适用的消息分类, 为空时适用于所有分类的消息
+	Category string `json:"category"`
+	// 周期内每个用户最多收到的推送数量; 推送在发送前计数, 发送失败的推送同样计入
+	Limit int `json:"limit"`
+	// 周期, 单位 s
+	Period int `json:"period"`
+}
+
+type FrequencyCapConfig struct {
+	// 是否开启频率限制
+	Enable bool `json:"enable"`
+	// 按 app id 配置的频率限制规则, key 为 app id; 一条消息需要满足所有匹配的规则才会被发送
+	Apps map[string][]FrequencyCapRule `json:"apps"`
+}
@@ -0,0 +1,17 @@
+package config_entries
+
+import "time"
+
+const defaultIdempotencyWindow = 24 * time.Hour
+
+type IdempotencyConfig struct {
+	// (optional, default: 86400) 相同 action_id 或 Idempotency-Key 的请求以及相同 action_id 与 token 的消息在此时间窗口内只会被处理一次, 单位 s
+	Window int `json:"window"`
+}
+
+func (c IdempotencyConfig) GetWindow() time.Duration {
+	if c.Window <= 0 {
+		return defaultIdempotencyWindow
+	}
+	return time.Duration(c.Window) * time.Second
+}
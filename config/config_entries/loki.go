@@ -1,5 +1,16 @@
 package config_entries
 
+import "time"
+
+const (
+	defaultLokiSource        = "hot-novel"
+	defaultLokiLevelName     = "severity"
+	defaultLokiBatchSize     = 500
+	defaultLokiBufferSize    = 10000
+	defaultLokiFlushInterval = time.Second
+	defaultLokiTimeout       = 5 * time.Second
+)
+
 type LokiConfig struct {
 	// the loki api url
 	URL string `json:"url"`
@@ -11,4 +22,58 @@ type LokiConfig struct {
 	SendLevel int8 `json:"send_level"`
 	// the labels which will be sent to loki, contains the {levelname: level}
 	Labels map[string]string `json:"labels"`
+	// (optional, default: 500) 每次推送到 loki 的最大日志条数
+	BatchSize int `json:"batch_size"`
+	// (optional, default: 10000) 等待推送的日志的最大条数, 超出时新的日志将被丢弃, 以免阻塞业务逻辑
+	BufferSize int `json:"buffer_size"`
+	// (optional, default: 1000) 推送日志的时间间隔, 单位 ms
+	FlushInterval int `json:"flush_interval"`
+	// (optional, default: 5000) 推送请求的超时时间, 单位 ms
+	Timeout int `json:"timeout"`
+}
+
+func (c LokiConfig) IsEnabled() bool {
+	return len(c.URL) > 0
+}
+
+func (c LokiConfig) GetSource() string {
+	if len(c.Source) <= 0 {
+		return defaultLokiSource
+	}
+	return c.Source
+}
+
+func (c LokiConfig) GetLevelName() string {
+	if len(c.LevelName) <= 0 {
+		return defaultLokiLevelName
+	}
+	return c.LevelName
+}
+
+func (c LokiConfig) GetBatchSize() int {
+	if c.BatchSize <= 0 {
+		return defaultLokiBatchSize
+	}
+	return c.BatchSize
+}
+
+func (c LokiConfig) GetBufferSize() int {
+	if c.BufferSize <= 0 {
+		return defaultLokiBufferSize
+	}
+	return c.BufferSize
+}
+
+func (c LokiConfig) GetFlushInterval() time.Duration {
+	if c.FlushInterval <= 0 {
+		return defaultLokiFlushInterval
+	}
+	return time.Duration(c.FlushInterval) * time.Millisecond
+}
+
+func (c LokiConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultLokiTimeout
+	}
+	return time.Duration(c.Timeout) * time.Millisecond
 }
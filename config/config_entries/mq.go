@@ -7,4 +7,6 @@ type MqConfig struct {
 	OnceReadMessageCount     int `json:"once_read_message_count"`
 	InitCreatedConsumerCount int `json:"init_created_consumer_count"`
 	MaxPendingTime           int `json:"max_pending_time"`
+	// (optional, default: 1000) 每次从延迟队列移回消息队列的消息数量, 每秒会持续移动直到没有到期的消息
+	DelayedMessageBatchSize int `json:"delayed_message_batch_size"`
 }
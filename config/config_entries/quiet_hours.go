@@ -0,0 +1,15 @@
+package config_entries
+
+type QuietHoursItem struct {
+	// 免打扰开始时间, 格式为 HH:MM
+	Start string `json:"start"`
+	// 免打扰结束时间, 格式为 HH:MM; 早于开始时间时表示跨天, 例如 22:00-08:00
+	End string `json:"end"`
+	// (optional, default: UTC) 设备没有时区信息时使用的时区, IANA 时区名称, 例如 Asia/Shanghai
+	Timezone string `json:"timezone"`
+}
+
+type QuietHoursConfig struct {
+	// 按 app id 配置的免打扰时段, key 为 app id; 非事务类消息在用户当地的免打扰时段内将被推迟到时段结束后发送
+	Apps map[string]QuietHoursItem `json:"apps"`
+}
@@ -0,0 +1,22 @@
+package config_entries
+
+type RateLimitItem struct {
+	// 每个周期内允许发送的消息数量
+	Limit int `json:"limit"`
+	// (optional, default: 1) 限流周期, 单位 s; 例如厂商通道每日上限可设置为 86400
+	Period int `json:"period"`
+	// (optional, default: limit) 令牌桶容量, 即允许的最大突发数量
+	Burst int `json:"burst"`
+}
+
+type RateLimitConfig struct {
+	// 是否开启限流
+	Enable bool `json:"enable"`
+	// (optional, default: 3000) 被限流的消息在消费者中等待的最长时间, 单位 ms; 超过之后消息将被延迟重新投递到队列中;
+	// 等待时间加上推送重试的时间需要小于消息队列的 visibility timeout, 因此最大为 3000
+	MaxWaitTime int `json:"max_wait_time"`
+	// 按 app id 限流, key 为 app id
+	Apps map[string]RateLimitItem `json:"apps"`
+	// 按推送服务提供方限流, key 为 push type (apple/firebase)
+	Providers map[PushType]RateLimitItem `json:"providers"`
+}
@@ -2,9 +2,10 @@ package config_entries
 
 type ApplePushSecretConfigItem struct {
 	BundleID string `json:"bundle_id"`
-	AuthKey  string `json:"auth_key"`
-	KeyID    string `json:"key_id"`
-	TeamID   string `json:"team_id"`
+	// p8 格式的 APNs 签名密钥内容, 支持 file://, env:// 和 vault:// 引用
+	AuthKey string `json:"auth_key" secret:"true"`
+	KeyID   string `json:"key_id"`
+	TeamID  string `json:"team_id"`
 }
 
 type ApplePushSecretConfig struct {
@@ -12,8 +13,9 @@ type ApplePushSecretConfig struct {
 }
 
 type FirebaseConfigItem struct {
-	PackageName               string `json:"package_name"`
-	ServiceAccountFileContent string `json:"service_account_file_content"`
+	PackageName string `json:"package_name"`
+	// Firebase 服务账号 JSON 文件内容, 支持 file://, env:// 和 vault:// 引用
+	ServiceAccountFileContent string `json:"service_account_file_content" secret:"true"`
 }
 
 type FirebaseConfig struct {
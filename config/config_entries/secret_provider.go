@@ -0,0 +1,47 @@
+package config_entries
+
+import (
+	"os"
+	"time"
+)
+
+const defaultVaultTimeout = 5 * time.Second
+
+type VaultConfig struct {
+	// (optional, default: 环境变量 VAULT_ADDR) Vault 服务地址, 例如 https://vault:8200; 为空时不能使用 vault:// 引用
+	Address string `json:"address"`
+	// (optional, default: 环境变量 VAULT_TOKEN) 访问 Vault 的 token, 支持 file:// 和 env:// 引用
+	Token string `json:"token" secret:"true"`
+	// (optional) Vault 企业版的 namespace
+	Namespace string `json:"namespace"`
+	// (optional, default: 5000) 请求的超时时间, 单位 ms
+	Timeout int `json:"timeout"`
+}
+
+func (c VaultConfig) GetAddress() string {
+	if len(c.Address) <= 0 {
+		return os.Getenv("VAULT_ADDR")
+	}
+	return c.Address
+}
+
+func (c VaultConfig) GetToken() string {
+	if len(c.Token) <= 0 {
+		return os.Getenv("VAULT_TOKEN")
+	}
+	return c.Token
+}
+
+func (c VaultConfig) GetTimeout() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultVaultTimeout
+	}
+	return time.Duration(c.Timeout) * time.Millisecond
+}
+
+// SecretProvidersConfig configures the providers of secret references, the secret fields of config accept
+// file://<path>, env://<name> and vault://<path>#<key> references besides the inline values
+type SecretProvidersConfig struct {
+	// (optional) vault://<path>#<key> 引用使用的 Vault 配置, path 为 KV secret 的 API 路径, 例如 vault://secret/data/push/apns#auth_key
+	Vault VaultConfig `json:"vault"`
+}
@@ -0,0 +1,47 @@
+package config_entries
+
+const (
+	TracingExporterOtlp   = "otlp"
+	TracingExporterStdout = "stdout"
+
+	defaultTracingServiceName = "push-service"
+	defaultTracingSampleRatio = 1.0
+)
+
+type TracingConfig struct {
+	// 是否开启 OpenTelemetry 链路追踪
+	Enable bool `json:"enable"`
+	// (optional, default: otlp) 导出方式 otlp/stdout; stdout 将 span 输出到标准输出, 用于本地调试及测试
+	Exporter string `json:"exporter"`
+	// (optional, default: localhost:4317) OTLP gRPC 接收端地址, 例如 otel-collector:4317
+	Endpoint string `json:"endpoint"`
+	// (optional, default: false) 是否使用不加密的连接访问 OTLP 接收端
+	Insecure bool `json:"insecure"`
+	// (optional) 发送到 OTLP 接收端的请求头, 例如鉴权信息
+	Headers map[string]string `json:"headers"`
+	// (optional, default: push-service) 上报的服务名称 service.name
+	ServiceName string `json:"service_name"`
+	// (optional, default: 1) 采样比例 0~1, 上游已采样的请求总是会被采样
+	SampleRatio float64 `json:"sample_ratio"`
+}
+
+func (c TracingConfig) GetExporter() string {
+	if len(c.Exporter) <= 0 {
+		return TracingExporterOtlp
+	}
+	return c.Exporter
+}
+
+func (c TracingConfig) GetServiceName() string {
+	if len(c.ServiceName) <= 0 {
+		return defaultTracingServiceName
+	}
+	return c.ServiceName
+}
+
+func (c TracingConfig) GetSampleRatio() float64 {
+	if c.SampleRatio <= 0 {
+		return defaultTracingSampleRatio
+	}
+	return c.SampleRatio
+}
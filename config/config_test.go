@@ -0,0 +1,100 @@
+package config
+
+import (
+	"errors"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func lookupEnvFrom(env map[string]string) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+}
+
+func TestLoadExampleConfig(t *testing.T) {
+	config, err := loadConfig("../conf/conf.example.json", lookupEnvFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 8899, config.Port)
+}
+
+func TestLoadConfigWithEnv(t *testing.T) {
+	config, err := loadConfig("../conf/conf.example.json", lookupEnvFrom(map[string]string{
+		"PUSH_PORT":                 "9000",
+		"PUSH_DB_CONFIG_PASSWORD":   "secret",
+		"PUSH_RATE_LIMIT_ENABLE":    "false",
+		"PUSH_TRACING_SAMPLE_RATIO": "0.5",
+		"PUSH_LOKI_SEND_LEVEL":      "1",
+		"PUSH_CLIENT_CONFIG":        `{"your ios app bundle id": {"push_type": "apple"}}`,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 9000, config.Port)
+	assert.Equal(t, "secret", config.DBConfig.Password)
+	assert.False(t, config.RateLimit.Enable)
+	assert.Equal(t, 0.5, config.Tracing.SampleRatio)
+	assert.Equal(t, int8(1), config.Loki.SendLevel)
+	assert.Equal(t, map[string]config_entries.ClientConfigItem{
+		"your ios app bundle id": {PushType: config_entries.ApplePush},
+	}, config.ClientConfig)
+}
+
+func TestLoadConfigReportsAllProblems(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "conf.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{
+		"mode": "prod",
+		"port": 70000,
+		"db_config": {"addr": "127.0.0.1", "port": 3306},
+		"cache_config": {"redis_addr": "127.0.0.1:6379"},
+		"client_config": {
+			"ios_app": {"push_type": "apple"},
+			"android_app": {"push_type": "firebase"},
+			"other_app": {"push_type": "huawei"}
+		},
+		"firebase_push_config": {"items": {"android_app": {"package_name": "android_app"}}}
+	}`), 0600))
+
+	_, err := loadConfig(path, lookupEnvFrom(map[string]string{"PUSH_SHUTDOWN_DELAY": "five"}))
+	var validationErr *ValidationError
+	if assert.True(t, errors.As(err, &validationErr)) {
+		assert.Equal(t, []string{
+			`PUSH_SHUTDOWN_DELAY: invalid value: strconv.ParseInt: parsing "five": invalid syntax`,
+			`mode: must be one of debug/test/release, got "prod"`,
+			`port: must be in 1-65535, got 70000`,
+			`client_config.ios_app: apple_push_config.items.ios_app is missing`,
+			`client_config.other_app.push_type: must be apple or firebase, got "huawei"`,
+			`firebase_push_config.items.android_app: service_account_file_content is required`,
+		}, validationErr.Problems)
+	}
+}
+
+func TestLoadConfigResolvesSecrets(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "apns.p8")
+	assert.NoError(t, os.WriteFile(keyPath, []byte("apns key\n"), 0600))
+
+	config, err := loadConfig("../conf/conf.example.json", lookupEnvFrom(map[string]string{
+		"PUSH_DB_CONFIG_PASSWORD": "file://" + keyPath,
+		"PUSH_APPLE_PUSH_CONFIG_ITEMS": `{"your ios app bundle id": {
+			"auth_key": "file://` + keyPath + `", "key_id": "key id", "team_id": "team id"
+		}}`,
+		"PUSH_AUTH_ROOT_KEY": "file://" + filepath.Join(dir, "not_exist"),
+	}))
+	var validationErr *ValidationError
+	if assert.True(t, errors.As(err, &validationErr)) && assert.Len(t, validationErr.Problems, 1) {
+		assert.Contains(t, validationErr.Problems[0], "auth.root_key: failed to resolve secret file://")
+	}
+
+	config, err = loadConfig("../conf/conf.example.json", lookupEnvFrom(map[string]string{
+		"PUSH_DB_CONFIG_PASSWORD": "file://" + keyPath,
+		"PUSH_APPLE_PUSH_CONFIG_ITEMS": `{"your ios app bundle id": {
+			"auth_key": "file://` + keyPath + `", "key_id": "key id", "team_id": "team id"
+		}}`,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "apns key", config.DBConfig.Password)
+	assert.Equal(t, "apns key", config.ApplePushConfig.Items["your ios app bundle id"].AuthKey)
+}
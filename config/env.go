@@ -0,0 +1,97 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvPrefix is the prefix of environment variables which override the config, the name of variable is
+	// the json keys of field joined by underscore in upper case, e.g. PUSH_DB_CONFIG_PASSWORD
+	EnvPrefix = "PUSH_"
+	// ConfigPathEnv is the environment variable of config file path, the -config flag takes precedence over it
+	ConfigPathEnv = "PUSH_CONFIG_PATH"
+)
+
+// GetPathFromEnv returns the config file path from environment variable, the default path is returned if it is not set
+func GetPathFromEnv() string {
+	if path := os.Getenv(ConfigPathEnv); len(path) > 0 {
+		return path
+	}
+	return DefaultConfigPath
+}
+
+// applyEnv overrides the fields of config with the environment variables, the maps and slices are
+// overridden as a whole by JSON, e.g. PUSH_CLIENT_CONFIG='{"app": {"push_type": "apple"}}'.
+// The problems of invalid values are returned.
+func applyEnv(config *AppConfig, lookup func(key string) (string, bool)) []string {
+	var problems []string
+	applyEnvToStruct(reflect.ValueOf(config).Elem(), strings.TrimSuffix(EnvPrefix, "_"), lookup, &problems)
+	return problems
+}
+
+func applyEnvToStruct(v reflect.Value, prefix string, lookup func(key string) (string, bool), problems *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" || len(name) <= 0 {
+			continue
+		}
+		key := prefix + "_" + strings.ToUpper(name)
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct {
+			applyEnvToStruct(fv, key, lookup, problems)
+			continue
+		}
+		value, ok := lookup(key)
+		if !ok {
+			continue
+		}
+		if err := setFieldFromEnv(fv, value); err != nil {
+			*problems = append(*problems, fmt.Sprintf("%s: invalid value: %v", key, err))
+		}
+	}
+}
+
+func setFieldFromEnv(v reflect.Value, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		ptr := reflect.New(v.Type())
+		if err := json.Unmarshal([]byte(value), ptr.Interface()); err != nil {
+			return err
+		}
+		v.Set(ptr.Elem())
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package config
+
+import (
+	"context"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// the file events within it are handled as one reload, since editors and kubernetes config map
+// update the file by several writes or renames
+const reloadDebounce = 500 * time.Millisecond
+
+// ReloadFunc is called with the old and new config after the config is reloaded
+type ReloadFunc func(ctx context.Context, oldConfig, newConfig *AppConfig)
+
+// AuditFunc records the result of a reload of the config file at path, e.g. in the audit log.
+// changed is the names of the changed settings, err is set if the config can not be reloaded
+type AuditFunc func(ctx context.Context, path string, changed []string, err error) error
+
+// Store holds the current config, the config is replaced as a whole on reload,
+// so that the readers always get a consistent config
+type Store struct {
+	path    string
+	logger  *zap.Logger
+	current atomic.Value
+	// mu serializes the reloads and guards the callbacks
+	mu        sync.Mutex
+	callbacks []ReloadFunc
+	audit     AuditFunc
+}
+
+func NewStore(path string, config *AppConfig, logger *zap.Logger) *Store {
+	s := &Store{path: path, logger: logger}
+	s.current.Store(config)
+	return s
+}
+
+// Get returns the current config, it must not be modified
+func (s *Store) Get() *AppConfig {
+	return s.current.Load().(*AppConfig)
+}
+
+// OnReload adds the callback which applies the new config, e.g. rebuilds the push clients
+func (s *Store) OnReload(f ReloadFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.callbacks = append(s.callbacks, f)
+}
+
+// SetAuditFunc sets the func which records the result of each reload
+func (s *Store) SetAuditFunc(f AuditFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.audit = f
+}
+
+// Reload reads the config file again and replaces the current config, the current config is kept if
+// the file can not be read. The settings which are only applied at startup are not changed.
+func (s *Store) Reload(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	newConfig, err := LoadConfig(s.path)
+	if err != nil {
+		s.writeAudit(ctx, nil, err)
+		return err
+	}
+	oldConfig := s.Get()
+	if reflect.DeepEqual(oldConfig, newConfig) {
+		s.logger.Info("Reload: config is not changed")
+		return nil
+	}
+	if ignored := keepStartupSettings(oldConfig, newConfig); len(ignored) > 0 {
+		s.logger.Warn("Reload: the changes of settings are ignored until restart", zap.Strings("settings", ignored))
+	}
+	changed := changedSettings(oldConfig, newConfig)
+	s.current.Store(newConfig)
+	for _, f := range s.callbacks {
+		f(ctx, oldConfig, newConfig)
+	}
+	s.logger.Info("Reload: config is reloaded", zap.Strings("changed", changed))
+	s.writeAudit(ctx, changed, nil)
+	return nil
+}
+
+func (s *Store) writeAudit(ctx context.Context, changed []string, reloadErr error) {
+	if s.audit == nil {
+		return
+	}
+	if err := s.audit(ctx, s.path, changed, reloadErr); err != nil {
+		s.logger.Error("Reload: failed to write audit log", zap.Error(err))
+	}
+}
+
+// Watch reloads the config on SIGHUP and when the config file is changed, it returns when ctx is done
+func (s *Store) Watch(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	// the directory is watched instead of the file, the file may be replaced rather than written
+	var fileEvents <-chan fsnotify.Event
+	var fileErrors <-chan error
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		err = watcher.Add(filepath.Dir(s.path))
+	}
+	if err != nil {
+		s.logger.Error("Watch: failed to watch config file, config is only reloaded on SIGHUP", zap.String("path", s.path), zap.Error(err))
+	} else {
+		defer watcher.Close()
+		fileEvents, fileErrors = watcher.Events, watcher.Errors
+	}
+
+	debounce := time.NewTimer(reloadDebounce)
+	debounce.Stop()
+	defer debounce.Stop()
+	reload := func(reason string) {
+		s.logger.Info("Watch: reload config", zap.String("reason", reason), zap.String("path", s.path))
+		if err := s.Reload(ctx); err != nil {
+			s.logger.Error("Watch: failed to reload config, keep the current config", zap.String("path", s.path), zap.Error(err))
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			reload("SIGHUP")
+		case event := <-fileEvents:
+			if s.isConfigFileEvent(event) {
+				debounce.Reset(reloadDebounce)
+			}
+		case <-debounce.C:
+			reload("file changed")
+		case err := <-fileErrors:
+			s.logger.Error("Watch: config file watcher error", zap.Error(err))
+		}
+	}
+}
+
+func (s *Store) isConfigFileEvent(event fsnotify.Event) bool {
+	if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+		return false
+	}
+	// the config map of kubernetes swaps the symlink of data directory, the config file is not in the events
+	return filepath.Clean(event.Name) == filepath.Clean(s.path) || filepath.Base(event.Name) == "..data"
+}
+
+// keepStartupSettings copies the settings which are only applied at startup from the old config,
+// the names of settings whose changes are ignored are returned
+func keepStartupSettings(oldConfig, newConfig *AppConfig) []string {
+	var ignored []string
+	keep := func(name string, o, n interface{}) {
+		ov, nv := reflect.ValueOf(o).Elem(), reflect.ValueOf(n).Elem()
+		if !reflect.DeepEqual(ov.Interface(), nv.Interface()) {
+			ignored = append(ignored, name)
+			nv.Set(ov)
+		}
+	}
+	keep("mode", &oldConfig.Mode, &newConfig.Mode)
+	keep("worker_id", &oldConfig.WorkerID, &newConfig.WorkerID)
+	keep("port", &oldConfig.Port, &newConfig.Port)
+	keep("log_mode", &oldConfig.LogMode, &newConfig.LogMode)
+	keep("log_file_path", &oldConfig.LogFilePath, &newConfig.LogFilePath)
+	keep("loki", &oldConfig.Loki, &newConfig.Loki)
+	keep("db_config", &oldConfig.DBConfig, &newConfig.DBConfig)
+	keep("cache_config", &oldConfig.CacheConfig, &newConfig.CacheConfig)
+	keep("mq", &oldConfig.Mq, &newConfig.Mq)
+	keep("tracing", &oldConfig.Tracing, &newConfig.Tracing)
+	return ignored
+}
+
+// changedSettings returns the json names of the top level settings which are different in the new config
+func changedSettings(oldConfig, newConfig *AppConfig) []string {
+	var changed []string
+	ov, nv := reflect.ValueOf(oldConfig).Elem(), reflect.ValueOf(newConfig).Elem()
+	for i := 0; i < ov.NumField(); i++ {
+		if reflect.DeepEqual(ov.Field(i).Interface(), nv.Field(i).Interface()) {
+			continue
+		}
+		field := ov.Type().Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if len(name) <= 0 {
+			name = field.Name
+		}
+		changed = append(changed, name)
+	}
+	return changed
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStoreReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "conf.json")
+	writeConfig := func(config string) {
+		assert.NoError(t, os.WriteFile(path, []byte(`{
+			"mode": "debug",
+			"db_config": {"addr": "127.0.0.1", "port": 3306},
+			"cache_config": {"redis_addr": "127.0.0.1:6379"},
+			`+config+`
+		}`), 0600))
+	}
+	writeConfig(`"port": 8080, "rate_limit": {"enable": false}`)
+	config, err := LoadConfig(path)
+	assert.NoError(t, err)
+
+	store := NewStore(path, config, zap.NewNop())
+	var reloaded []*AppConfig
+	store.OnReload(func(ctx context.Context, oldConfig, newConfig *AppConfig) {
+		assert.Same(t, config, oldConfig)
+		reloaded = append(reloaded, newConfig)
+	})
+	var audited [][]string
+	var auditErrors []error
+	store.SetAuditFunc(func(ctx context.Context, auditPath string, changed []string, err error) error {
+		assert.Equal(t, path, auditPath)
+		audited = append(audited, changed)
+		auditErrors = append(auditErrors, err)
+		return nil
+	})
+
+	// the config is kept if the file is invalid
+	writeConfig(`"port": `)
+	assert.Error(t, store.Reload(context.Background()))
+	assert.Same(t, config, store.Get())
+	assert.Empty(t, reloaded)
+
+	// the port is only applied at startup
+	writeConfig(`"port": 9090, "rate_limit": {"enable": true}`)
+	assert.NoError(t, store.Reload(context.Background()))
+	assert.True(t, store.Get().RateLimit.Enable)
+	assert.Equal(t, 8080, store.Get().Port)
+	assert.Len(t, reloaded, 1)
+	assert.Same(t, store.Get(), reloaded[0])
+
+	// both the failed and succeeded reloads are audited
+	assert.Equal(t, [][]string{nil, {"rate_limit"}}, audited)
+	assert.Error(t, auditErrors[0])
+	assert.NoError(t, auditErrors[1])
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"github.com/shitamachi/push-service/secret"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var (
+	secretProvidersMu sync.RWMutex
+	secretProviders   = make(map[string]secret.Provider)
+)
+
+// RegisterSecretProvider adds the provider of secret references of scheme, it must be called before the config is loaded.
+// The built-in file, env and vault providers can be replaced by it.
+func RegisterSecretProvider(scheme string, provider secret.Provider) {
+	secretProvidersMu.Lock()
+	defer secretProvidersMu.Unlock()
+	secretProviders[scheme] = provider
+}
+
+func newSecretResolver(ctx context.Context, config *AppConfig) (*secret.Resolver, []string) {
+	resolver := secret.NewResolver()
+	var problems []string
+	// the token of vault may be a reference of the other providers
+	vaultConf := config.SecretProviders.Vault
+	token, err := resolver.Resolve(ctx, vaultConf.Token)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("secret_providers.vault.token: %v", err))
+	}
+	vaultConf.Token = token
+	config.SecretProviders.Vault.Token = token
+	resolver.Register(secret.SchemeVault, secret.NewVaultProvider(vaultConf))
+
+	secretProvidersMu.RLock()
+	defer secretProvidersMu.RUnlock()
+	for scheme, provider := range secretProviders {
+		resolver.Register(scheme, provider)
+	}
+	return resolver, problems
+}
+
+// resolveSecrets replaces the references of the fields tagged with `secret:"true"` with their secrets,
+// the problems of the references which can not be resolved are returned
+func resolveSecrets(ctx context.Context, config *AppConfig) []string {
+	resolver, problems := newSecretResolver(ctx, config)
+	resolveSecretsOfValue(ctx, resolver, reflect.ValueOf(config).Elem(), "", &problems)
+	return problems
+}
+
+func resolveSecretsOfValue(ctx context.Context, resolver *secret.Resolver, v reflect.Value, path string, problems *[]string) {
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := joinConfigPath(path, strings.Split(field.Tag.Get("json"), ",")[0])
+			fv := v.Field(i)
+			if field.Tag.Get("secret") == "true" && fv.Kind() == reflect.String {
+				value, err := resolver.Resolve(ctx, fv.String())
+				if err != nil {
+					*problems = append(*problems, fmt.Sprintf("%s: %v", fieldPath, err))
+					continue
+				}
+				fv.SetString(value)
+				continue
+			}
+			resolveSecretsOfValue(ctx, resolver, fv, fieldPath, problems)
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			// the values of map are not addressable, resolve a copy and set it back
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(iter.Value())
+			resolveSecretsOfValue(ctx, resolver, elem, joinConfigPath(path, fmt.Sprint(iter.Key().Interface())), problems)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	}
+}
+
+func joinConfigPath(path, name string) string {
+	if len(path) <= 0 {
+		return name
+	}
+	return path + "." + name
+}
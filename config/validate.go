@@ -0,0 +1,123 @@
+package config
+
+import (
+	"fmt"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/secret"
+	"sort"
+	"strings"
+)
+
+// ValidationError reports all the problems of config at once
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid config, %d problems:\n  %s", len(e.Problems), strings.Join(e.Problems, "\n  "))
+}
+
+var modes = []string{"debug", "test", "release"}
+
+// Validate checks the config, the returned error is a *ValidationError with all the problems
+func (c *AppConfig) Validate() error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if !isOneOf(c.Mode, modes) {
+		addf("mode: must be one of %s, got %q", strings.Join(modes, "/"), c.Mode)
+	}
+	if len(c.LogMode) > 0 && !isOneOf(c.LogMode, modes) {
+		addf("log_mode: must be empty or one of %s, got %q", strings.Join(modes, "/"), c.LogMode)
+	}
+	if !isValidPort(c.Port) {
+		addf("port: must be in 1-65535, got %d", c.Port)
+	}
+	if c.ShutdownDelay < 0 {
+		addf("shutdown_delay: must not be negative, got %d", c.ShutdownDelay)
+	}
+	if len(c.DBConfig.Addr) <= 0 {
+		addf("db_config.addr: is required")
+	}
+	if !isValidPort(c.DBConfig.Port) {
+		addf("db_config.port: must be in 1-65535, got %d", c.DBConfig.Port)
+	}
+	if len(c.CacheConfig.RedisAddr) <= 0 {
+		addf("cache_config.redis_addr: is required")
+	}
+
+	// every app must have the credentials of its push type
+	for _, appId := range sortedKeys(c.ClientConfig) {
+		switch pushType := c.ClientConfig[appId].PushType; pushType {
+		case config_entries.ApplePush:
+			if _, ok := c.ApplePushConfig.Items[appId]; !ok {
+				addf("client_config.%s: apple_push_config.items.%s is missing", appId, appId)
+			}
+		case config_entries.FirebasePush:
+			if _, ok := c.FirebasePushConfig.Items[appId]; !ok {
+				addf("client_config.%s: firebase_push_config.items.%s is missing", appId, appId)
+			}
+		default:
+			addf("client_config.%s.push_type: must be apple or firebase, got %q", appId, pushType)
+		}
+	}
+	for _, bundleId := range sortedKeys(c.ApplePushConfig.Items) {
+		item := c.ApplePushConfig.Items[bundleId]
+		if len(item.AuthKey) <= 0 || len(item.KeyID) <= 0 || len(item.TeamID) <= 0 {
+			addf("apple_push_config.items.%s: auth_key, key_id and team_id are required", bundleId)
+		}
+	}
+	for _, packageName := range sortedKeys(c.FirebasePushConfig.Items) {
+		if len(c.FirebasePushConfig.Items[packageName].ServiceAccountFileContent) <= 0 {
+			addf("firebase_push_config.items.%s: service_account_file_content is required", packageName)
+		}
+	}
+
+	if len(c.Auth.SigningSecretEncryptionKey) > 0 {
+		if _, err := secret.NewCipher(c.Auth.SigningSecretEncryptionKey); err != nil {
+			addf("auth.signing_secret_encryption_key: %v", err)
+		}
+	}
+	if c.AppRegistry.IsEnabled() {
+		if _, err := secret.NewCipher(c.AppRegistry.EncryptionKey); err != nil {
+			addf("app_registry.encryption_key: %v", err)
+		}
+	}
+	if c.Tracing.Enable {
+		if exporter := c.Tracing.GetExporter(); exporter != config_entries.TracingExporterOtlp && exporter != config_entries.TracingExporterStdout {
+			addf("tracing.exporter: must be otlp or stdout, got %q", exporter)
+		}
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		addf("tracing.sample_ratio: must be in 0-1, got %v", c.Tracing.SampleRatio)
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func isOneOf(s string, values []string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidPort(port int) bool {
+	return port > 0 && port <= 65535
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
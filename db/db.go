@@ -1,16 +1,23 @@
 package db
 
 import (
+	"context"
 	"entgo.io/ent/dialect/sql"
+	"errors"
 	"fmt"
 	"github.com/shitamachi/push-service/config"
 	"github.com/shitamachi/push-service/config/config_entries"
 	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/tracing"
 	"time"
 )
 
+// the driver of client, it is used to check the connection to database
+var driver *sql.Driver
+
 func InitDB(config *config.AppConfig) *ent.Client {
-	driver, err := sql.Open(
+	var err error
+	driver, err = sql.Open(
 		"mysql",
 		//username:password.@tcp(127.0.0.1:3306)/db_name?checkConnLiveness=false&loc=Local&parseTime=true&readTimeout=1s&timeout=3s&writeTimeout=1s
 		getDSN(&config.DBConfig),
@@ -24,11 +31,43 @@ func InitDB(config *config.AppConfig) *ent.Client {
 	db.SetMaxOpenConns(100)
 	db.SetConnMaxLifetime(time.Hour)
 
-	client := ent.NewClient(ent.Driver(driver), ent.Debug())
+	client := ent.NewClient(ent.Driver(tracing.NewDriver(driver)), ent.Debug())
 	if client == nil {
 		panic("got client but is nil")
 	}
 
+	// the audit logs are append-only
+	client.AuditLog.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpCreate) {
+				return nil, fmt.Errorf("audit logs can not be changed, op: %s", m.Op())
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	// the revision of app is the version of its push client, the timestamps are not precise enough for it
+	client.App.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if am, ok := m.(*ent.AppMutation); ok && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+				am.AddRevision(1)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	if config.DBConfig.AutoMigrate {
+		// the unique index can not be created if there are duplicated device tokens
+		err = dedupeDeviceTokens(context.Background(), db)
+		if err != nil {
+			panic(err)
+		}
+		err = client.Schema.Create(context.Background())
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return client
 }
 
@@ -37,3 +76,24 @@ func getDSN(conf *config_entries.DBConfigItem) string {
 		conf.User, conf.Password, conf.Addr, conf.Port, conf.DB,
 	)
 }
+
+// Ping checks whether the database is reachable, an error is returned if InitDB has not been called
+func Ping(ctx context.Context) error {
+	if driver == nil {
+		return errors.New("database is not initialized")
+	}
+	return driver.DB().PingContext(ctx)
+}
+
+type SetDBToContextKey string
+
+var key = SetDBToContextKey("db")
+
+func SetToContext(ctx context.Context, client *ent.Client) context.Context {
+	return context.WithValue(ctx, key, client)
+}
+
+func GetFromContext(ctx context.Context) *ent.Client {
+	client, _ := ctx.Value(key).(*ent.Client)
+	return client
+}
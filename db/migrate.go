@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const (
+	deviceTokenTable       = "user_platform_tokens"
+	deviceTokenUniqueIndex = "userplatformtokens_app_id_device_id"
+)
+
+// dedupeDeviceTokens removes the duplicated tokens of the same device before the unique index of (app_id, device_id)
+// is created by auto migration, only the latest updated token of each device is kept.
+// It does nothing if the table does not exist or the index has been created
+func dedupeDeviceTokens(ctx context.Context, db *sql.DB) error {
+	var tables, indexes int
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?",
+		deviceTokenTable,
+	).Scan(&tables)
+	if err != nil || tables <= 0 {
+		return err
+	}
+	err = db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+		deviceTokenTable, deviceTokenUniqueIndex,
+	).Scan(&indexes)
+	if err != nil || indexes > 0 {
+		return err
+	}
+	// the rows updated at the same time are ordered by id
+	_, err = db.ExecContext(ctx, `DELETE t1 FROM user_platform_tokens t1
+JOIN user_platform_tokens t2 ON t1.app_id = t2.app_id AND t1.device_id = t2.device_id
+AND (t1.updated_at < t2.updated_at OR (t1.updated_at = t2.updated_at AND t1.id < t2.id))`)
+	return err
+}
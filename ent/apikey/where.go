@@ -0,0 +1,1311 @@
+// Code generated by entc, DO NOT EDIT.
+
+package apikey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// KeyHash applies equality check predicate on the "key_hash" field. It's identical to KeyHashEQ.
+func KeyHash(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// SigningSecret applies equality check predicate on the "signing_secret" field. It's identical to SigningSecretEQ.
+func SigningSecret(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecret applies equality check predicate on the "previous_signing_secret" field. It's identical to PreviousSigningSecretEQ.
+func PreviousSigningSecret(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAt applies equality check predicate on the "previous_signing_secret_expires_at" field. It's identical to PreviousSigningSecretExpiresAtEQ.
+func PreviousSigningSecretExpiresAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrefix), v...))
+	})
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPrefix), v))
+	})
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPrefix), v))
+	})
+}
+
+// KeyHashEQ applies the EQ predicate on the "key_hash" field.
+func KeyHashEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashNEQ applies the NEQ predicate on the "key_hash" field.
+func KeyHashNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashIn applies the In predicate on the "key_hash" field.
+func KeyHashIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashNotIn applies the NotIn predicate on the "key_hash" field.
+func KeyHashNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashGT applies the GT predicate on the "key_hash" field.
+func KeyHashGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashGTE applies the GTE predicate on the "key_hash" field.
+func KeyHashGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLT applies the LT predicate on the "key_hash" field.
+func KeyHashLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLTE applies the LTE predicate on the "key_hash" field.
+func KeyHashLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContains applies the Contains predicate on the "key_hash" field.
+func KeyHashContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasPrefix applies the HasPrefix predicate on the "key_hash" field.
+func KeyHashHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasSuffix applies the HasSuffix predicate on the "key_hash" field.
+func KeyHashHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashEqualFold applies the EqualFold predicate on the "key_hash" field.
+func KeyHashEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContainsFold applies the ContainsFold predicate on the "key_hash" field.
+func KeyHashContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// AppIdsIsNil applies the IsNil predicate on the "app_ids" field.
+func AppIdsIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppIds)))
+	})
+}
+
+// AppIdsNotNil applies the NotNil predicate on the "app_ids" field.
+func AppIdsNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppIds)))
+	})
+}
+
+// SigningSecretEQ applies the EQ predicate on the "signing_secret" field.
+func SigningSecretEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretNEQ applies the NEQ predicate on the "signing_secret" field.
+func SigningSecretNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretIn applies the In predicate on the "signing_secret" field.
+func SigningSecretIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSigningSecret), v...))
+	})
+}
+
+// SigningSecretNotIn applies the NotIn predicate on the "signing_secret" field.
+func SigningSecretNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSigningSecret), v...))
+	})
+}
+
+// SigningSecretGT applies the GT predicate on the "signing_secret" field.
+func SigningSecretGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretGTE applies the GTE predicate on the "signing_secret" field.
+func SigningSecretGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretLT applies the LT predicate on the "signing_secret" field.
+func SigningSecretLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretLTE applies the LTE predicate on the "signing_secret" field.
+func SigningSecretLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretContains applies the Contains predicate on the "signing_secret" field.
+func SigningSecretContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretHasPrefix applies the HasPrefix predicate on the "signing_secret" field.
+func SigningSecretHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretHasSuffix applies the HasSuffix predicate on the "signing_secret" field.
+func SigningSecretHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretIsNil applies the IsNil predicate on the "signing_secret" field.
+func SigningSecretIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSigningSecret)))
+	})
+}
+
+// SigningSecretNotNil applies the NotNil predicate on the "signing_secret" field.
+func SigningSecretNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSigningSecret)))
+	})
+}
+
+// SigningSecretEqualFold applies the EqualFold predicate on the "signing_secret" field.
+func SigningSecretEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSigningSecret), v))
+	})
+}
+
+// SigningSecretContainsFold applies the ContainsFold predicate on the "signing_secret" field.
+func SigningSecretContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretEQ applies the EQ predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretNEQ applies the NEQ predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretIn applies the In predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPreviousSigningSecret), v...))
+	})
+}
+
+// PreviousSigningSecretNotIn applies the NotIn predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPreviousSigningSecret), v...))
+	})
+}
+
+// PreviousSigningSecretGT applies the GT predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretGTE applies the GTE predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretLT applies the LT predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretLTE applies the LTE predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretContains applies the Contains predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretHasPrefix applies the HasPrefix predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretHasSuffix applies the HasSuffix predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretIsNil applies the IsNil predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousSigningSecret)))
+	})
+}
+
+// PreviousSigningSecretNotNil applies the NotNil predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousSigningSecret)))
+	})
+}
+
+// PreviousSigningSecretEqualFold applies the EqualFold predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretContainsFold applies the ContainsFold predicate on the "previous_signing_secret" field.
+func PreviousSigningSecretContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPreviousSigningSecret), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtEQ applies the EQ predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtNEQ applies the NEQ predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtIn applies the In predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPreviousSigningSecretExpiresAt), v...))
+	})
+}
+
+// PreviousSigningSecretExpiresAtNotIn applies the NotIn predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPreviousSigningSecretExpiresAt), v...))
+	})
+}
+
+// PreviousSigningSecretExpiresAtGT applies the GT predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtGTE applies the GTE predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtLT applies the LT predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtLTE applies the LTE predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPreviousSigningSecretExpiresAt), v))
+	})
+}
+
+// PreviousSigningSecretExpiresAtIsNil applies the IsNil predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPreviousSigningSecretExpiresAt)))
+	})
+}
+
+// PreviousSigningSecretExpiresAtNotNil applies the NotNil predicate on the "previous_signing_secret_expires_at" field.
+func PreviousSigningSecretExpiresAtNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPreviousSigningSecretExpiresAt)))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRevokedAt)))
+	})
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRevokedAt)))
+	})
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
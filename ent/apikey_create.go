@@ -0,0 +1,482 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/apikey"
+)
+
+// ApiKeyCreate is the builder for creating a ApiKey entity.
+type ApiKeyCreate struct {
+	config
+	mutation *ApiKeyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (akc *ApiKeyCreate) SetName(s string) *ApiKeyCreate {
+	akc.mutation.SetName(s)
+	return akc
+}
+
+// SetPrefix sets the "prefix" field.
+func (akc *ApiKeyCreate) SetPrefix(s string) *ApiKeyCreate {
+	akc.mutation.SetPrefix(s)
+	return akc
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (akc *ApiKeyCreate) SetKeyHash(s string) *ApiKeyCreate {
+	akc.mutation.SetKeyHash(s)
+	return akc
+}
+
+// SetAppIds sets the "app_ids" field.
+func (akc *ApiKeyCreate) SetAppIds(s []string) *ApiKeyCreate {
+	akc.mutation.SetAppIds(s)
+	return akc
+}
+
+// SetPermissions sets the "permissions" field.
+func (akc *ApiKeyCreate) SetPermissions(s []string) *ApiKeyCreate {
+	akc.mutation.SetPermissions(s)
+	return akc
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (akc *ApiKeyCreate) SetSigningSecret(s string) *ApiKeyCreate {
+	akc.mutation.SetSigningSecret(s)
+	return akc
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableSigningSecret(s *string) *ApiKeyCreate {
+	if s != nil {
+		akc.SetSigningSecret(*s)
+	}
+	return akc
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (akc *ApiKeyCreate) SetPreviousSigningSecret(s string) *ApiKeyCreate {
+	akc.mutation.SetPreviousSigningSecret(s)
+	return akc
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillablePreviousSigningSecret(s *string) *ApiKeyCreate {
+	if s != nil {
+		akc.SetPreviousSigningSecret(*s)
+	}
+	return akc
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (akc *ApiKeyCreate) SetPreviousSigningSecretExpiresAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return akc
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return akc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akc *ApiKeyCreate) SetExpiresAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetExpiresAt(t)
+	return akc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableExpiresAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetExpiresAt(*t)
+	}
+	return akc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akc *ApiKeyCreate) SetRevokedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetRevokedAt(t)
+	return akc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableRevokedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetRevokedAt(*t)
+	}
+	return akc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akc *ApiKeyCreate) SetLastUsedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetLastUsedAt(t)
+	return akc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableLastUsedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetLastUsedAt(*t)
+	}
+	return akc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (akc *ApiKeyCreate) SetCreatedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetCreatedAt(t)
+	return akc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableCreatedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetCreatedAt(*t)
+	}
+	return akc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (akc *ApiKeyCreate) SetUpdatedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetUpdatedAt(t)
+	return akc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableUpdatedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetUpdatedAt(*t)
+	}
+	return akc
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (akc *ApiKeyCreate) Mutation() *ApiKeyMutation {
+	return akc.mutation
+}
+
+// Save creates the ApiKey in the database.
+func (akc *ApiKeyCreate) Save(ctx context.Context) (*ApiKey, error) {
+	var (
+		err  error
+		node *ApiKey
+	)
+	akc.defaults()
+	if len(akc.hooks) == 0 {
+		if err = akc.check(); err != nil {
+			return nil, err
+		}
+		node, err = akc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ApiKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = akc.check(); err != nil {
+				return nil, err
+			}
+			akc.mutation = mutation
+			if node, err = akc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(akc.hooks) - 1; i >= 0; i-- {
+			if akc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = akc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, akc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (akc *ApiKeyCreate) SaveX(ctx context.Context) *ApiKey {
+	v, err := akc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akc *ApiKeyCreate) Exec(ctx context.Context) error {
+	_, err := akc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akc *ApiKeyCreate) ExecX(ctx context.Context) {
+	if err := akc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akc *ApiKeyCreate) defaults() {
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		v := apikey.DefaultCreatedAt()
+		akc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := akc.mutation.UpdatedAt(); !ok {
+		v := apikey.DefaultUpdatedAt()
+		akc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akc *ApiKeyCreate) check() error {
+	if _, ok := akc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ApiKey.name"`)}
+	}
+	if _, ok := akc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "ApiKey.prefix"`)}
+	}
+	if _, ok := akc.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "ApiKey.key_hash"`)}
+	}
+	if _, ok := akc.mutation.Permissions(); !ok {
+		return &ValidationError{Name: "permissions", err: errors.New(`ent: missing required field "ApiKey.permissions"`)}
+	}
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApiKey.created_at"`)}
+	}
+	if _, ok := akc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ApiKey.updated_at"`)}
+	}
+	return nil
+}
+
+func (akc *ApiKeyCreate) sqlSave(ctx context.Context) (*ApiKey, error) {
+	_node, _spec := akc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, akc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (akc *ApiKeyCreate) createSpec() (*ApiKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApiKey{config: akc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: apikey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apikey.FieldID,
+			},
+		}
+	)
+	if value, ok := akc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := akc.mutation.Prefix(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPrefix,
+		})
+		_node.Prefix = value
+	}
+	if value, ok := akc.mutation.KeyHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldKeyHash,
+		})
+		_node.KeyHash = value
+	}
+	if value, ok := akc.mutation.AppIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldAppIds,
+		})
+		_node.AppIds = value
+	}
+	if value, ok := akc.mutation.Permissions(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldPermissions,
+		})
+		_node.Permissions = value
+	}
+	if value, ok := akc.mutation.SigningSecret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldSigningSecret,
+		})
+		_node.SigningSecret = value
+	}
+	if value, ok := akc.mutation.PreviousSigningSecret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecret,
+		})
+		_node.PreviousSigningSecret = value
+	}
+	if value, ok := akc.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecretExpiresAt,
+		})
+		_node.PreviousSigningSecretExpiresAt = &value
+	}
+	if value, ok := akc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := akc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldRevokedAt,
+		})
+		_node.RevokedAt = &value
+	}
+	if value, ok := akc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	if value, ok := akc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := akc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ApiKeyCreateBulk is the builder for creating many ApiKey entities in bulk.
+type ApiKeyCreateBulk struct {
+	config
+	builders []*ApiKeyCreate
+}
+
+// Save creates the ApiKey entities in the database.
+func (akcb *ApiKeyCreateBulk) Save(ctx context.Context) ([]*ApiKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(akcb.builders))
+	nodes := make([]*ApiKey, len(akcb.builders))
+	mutators := make([]Mutator, len(akcb.builders))
+	for i := range akcb.builders {
+		func(i int, root context.Context) {
+			builder := akcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApiKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, akcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, akcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, akcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akcb *ApiKeyCreateBulk) SaveX(ctx context.Context) []*ApiKey {
+	v, err := akcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akcb *ApiKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := akcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akcb *ApiKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := akcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,876 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/apikey"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// ApiKeyUpdate is the builder for updating ApiKey entities.
+type ApiKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ApiKeyMutation
+}
+
+// Where appends a list predicates to the ApiKeyUpdate builder.
+func (aku *ApiKeyUpdate) Where(ps ...predicate.ApiKey) *ApiKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *ApiKeyUpdate) SetName(s string) *ApiKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetPrefix sets the "prefix" field.
+func (aku *ApiKeyUpdate) SetPrefix(s string) *ApiKeyUpdate {
+	aku.mutation.SetPrefix(s)
+	return aku
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (aku *ApiKeyUpdate) SetKeyHash(s string) *ApiKeyUpdate {
+	aku.mutation.SetKeyHash(s)
+	return aku
+}
+
+// SetAppIds sets the "app_ids" field.
+func (aku *ApiKeyUpdate) SetAppIds(s []string) *ApiKeyUpdate {
+	aku.mutation.SetAppIds(s)
+	return aku
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (aku *ApiKeyUpdate) ClearAppIds() *ApiKeyUpdate {
+	aku.mutation.ClearAppIds()
+	return aku
+}
+
+// SetPermissions sets the "permissions" field.
+func (aku *ApiKeyUpdate) SetPermissions(s []string) *ApiKeyUpdate {
+	aku.mutation.SetPermissions(s)
+	return aku
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (aku *ApiKeyUpdate) SetSigningSecret(s string) *ApiKeyUpdate {
+	aku.mutation.SetSigningSecret(s)
+	return aku
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableSigningSecret(s *string) *ApiKeyUpdate {
+	if s != nil {
+		aku.SetSigningSecret(*s)
+	}
+	return aku
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (aku *ApiKeyUpdate) ClearSigningSecret() *ApiKeyUpdate {
+	aku.mutation.ClearSigningSecret()
+	return aku
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (aku *ApiKeyUpdate) SetPreviousSigningSecret(s string) *ApiKeyUpdate {
+	aku.mutation.SetPreviousSigningSecret(s)
+	return aku
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillablePreviousSigningSecret(s *string) *ApiKeyUpdate {
+	if s != nil {
+		aku.SetPreviousSigningSecret(*s)
+	}
+	return aku
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (aku *ApiKeyUpdate) ClearPreviousSigningSecret() *ApiKeyUpdate {
+	aku.mutation.ClearPreviousSigningSecret()
+	return aku
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (aku *ApiKeyUpdate) SetPreviousSigningSecretExpiresAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return aku
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (aku *ApiKeyUpdate) ClearPreviousSigningSecretExpiresAt() *ApiKeyUpdate {
+	aku.mutation.ClearPreviousSigningSecretExpiresAt()
+	return aku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aku *ApiKeyUpdate) SetExpiresAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetExpiresAt(t)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableExpiresAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetExpiresAt(*t)
+	}
+	return aku
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (aku *ApiKeyUpdate) ClearExpiresAt() *ApiKeyUpdate {
+	aku.mutation.ClearExpiresAt()
+	return aku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (aku *ApiKeyUpdate) SetRevokedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetRevokedAt(t)
+	return aku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableRevokedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetRevokedAt(*t)
+	}
+	return aku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (aku *ApiKeyUpdate) ClearRevokedAt() *ApiKeyUpdate {
+	aku.mutation.ClearRevokedAt()
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *ApiKeyUpdate) SetLastUsedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *ApiKeyUpdate) ClearLastUsedAt() *ApiKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aku *ApiKeyUpdate) SetCreatedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetCreatedAt(t)
+	return aku
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableCreatedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetCreatedAt(*t)
+	}
+	return aku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aku *ApiKeyUpdate) SetUpdatedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetUpdatedAt(t)
+	return aku
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (aku *ApiKeyUpdate) Mutation() *ApiKeyMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *ApiKeyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	aku.defaults()
+	if len(aku.hooks) == 0 {
+		affected, err = aku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ApiKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aku.mutation = mutation
+			affected, err = aku.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(aku.hooks) - 1; i >= 0; i-- {
+			if aku.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *ApiKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *ApiKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *ApiKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aku *ApiKeyUpdate) defaults() {
+	if _, ok := aku.mutation.UpdatedAt(); !ok {
+		v := apikey.UpdateDefaultUpdatedAt()
+		aku.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (aku *ApiKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apikey.Table,
+			Columns: apikey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apikey.FieldID,
+			},
+		},
+	}
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+	}
+	if value, ok := aku.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPrefix,
+		})
+	}
+	if value, ok := aku.mutation.KeyHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldKeyHash,
+		})
+	}
+	if value, ok := aku.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldAppIds,
+		})
+	}
+	if aku.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: apikey.FieldAppIds,
+		})
+	}
+	if value, ok := aku.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldPermissions,
+		})
+	}
+	if value, ok := aku.mutation.SigningSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldSigningSecret,
+		})
+	}
+	if aku.mutation.SigningSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: apikey.FieldSigningSecret,
+		})
+	}
+	if value, ok := aku.mutation.PreviousSigningSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecret,
+		})
+	}
+	if aku.mutation.PreviousSigningSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: apikey.FieldPreviousSigningSecret,
+		})
+	}
+	if value, ok := aku.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecretExpiresAt,
+		})
+	}
+	if aku.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldPreviousSigningSecretExpiresAt,
+		})
+	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldExpiresAt,
+		})
+	}
+	if aku.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldExpiresAt,
+		})
+	}
+	if value, ok := aku.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldRevokedAt,
+		})
+	}
+	if aku.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldRevokedAt,
+		})
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedAt,
+		})
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldLastUsedAt,
+		})
+	}
+	if value, ok := aku.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldCreatedAt,
+		})
+	}
+	if value, ok := aku.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ApiKeyUpdateOne is the builder for updating a single ApiKey entity.
+type ApiKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ApiKeyMutation
+}
+
+// SetName sets the "name" field.
+func (akuo *ApiKeyUpdateOne) SetName(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (akuo *ApiKeyUpdateOne) SetPrefix(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetPrefix(s)
+	return akuo
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (akuo *ApiKeyUpdateOne) SetKeyHash(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetKeyHash(s)
+	return akuo
+}
+
+// SetAppIds sets the "app_ids" field.
+func (akuo *ApiKeyUpdateOne) SetAppIds(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.SetAppIds(s)
+	return akuo
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (akuo *ApiKeyUpdateOne) ClearAppIds() *ApiKeyUpdateOne {
+	akuo.mutation.ClearAppIds()
+	return akuo
+}
+
+// SetPermissions sets the "permissions" field.
+func (akuo *ApiKeyUpdateOne) SetPermissions(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.SetPermissions(s)
+	return akuo
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (akuo *ApiKeyUpdateOne) SetSigningSecret(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetSigningSecret(s)
+	return akuo
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableSigningSecret(s *string) *ApiKeyUpdateOne {
+	if s != nil {
+		akuo.SetSigningSecret(*s)
+	}
+	return akuo
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (akuo *ApiKeyUpdateOne) ClearSigningSecret() *ApiKeyUpdateOne {
+	akuo.mutation.ClearSigningSecret()
+	return akuo
+}
+
+// SetPreviousSigningSecret sets the "previous_signing_secret" field.
+func (akuo *ApiKeyUpdateOne) SetPreviousSigningSecret(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetPreviousSigningSecret(s)
+	return akuo
+}
+
+// SetNillablePreviousSigningSecret sets the "previous_signing_secret" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillablePreviousSigningSecret(s *string) *ApiKeyUpdateOne {
+	if s != nil {
+		akuo.SetPreviousSigningSecret(*s)
+	}
+	return akuo
+}
+
+// ClearPreviousSigningSecret clears the value of the "previous_signing_secret" field.
+func (akuo *ApiKeyUpdateOne) ClearPreviousSigningSecret() *ApiKeyUpdateOne {
+	akuo.mutation.ClearPreviousSigningSecret()
+	return akuo
+}
+
+// SetPreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field.
+func (akuo *ApiKeyUpdateOne) SetPreviousSigningSecretExpiresAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetPreviousSigningSecretExpiresAt(t)
+	return akuo
+}
+
+// SetNillablePreviousSigningSecretExpiresAt sets the "previous_signing_secret_expires_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillablePreviousSigningSecretExpiresAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetPreviousSigningSecretExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearPreviousSigningSecretExpiresAt clears the value of the "previous_signing_secret_expires_at" field.
+func (akuo *ApiKeyUpdateOne) ClearPreviousSigningSecretExpiresAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearPreviousSigningSecretExpiresAt()
+	return akuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *ApiKeyUpdateOne) SetExpiresAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetExpiresAt(t)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableExpiresAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetExpiresAt(*t)
+	}
+	return akuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (akuo *ApiKeyUpdateOne) ClearExpiresAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearExpiresAt()
+	return akuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akuo *ApiKeyUpdateOne) SetRevokedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetRevokedAt(t)
+	return akuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableRevokedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetRevokedAt(*t)
+	}
+	return akuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (akuo *ApiKeyUpdateOne) ClearRevokedAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearRevokedAt()
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) SetLastUsedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) ClearLastUsedAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (akuo *ApiKeyUpdateOne) SetCreatedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetCreatedAt(t)
+	return akuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableCreatedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetCreatedAt(*t)
+	}
+	return akuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (akuo *ApiKeyUpdateOne) SetUpdatedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetUpdatedAt(t)
+	return akuo
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (akuo *ApiKeyUpdateOne) Mutation() *ApiKeyMutation {
+	return akuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *ApiKeyUpdateOne) Select(field string, fields ...string) *ApiKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated ApiKey entity.
+func (akuo *ApiKeyUpdateOne) Save(ctx context.Context) (*ApiKey, error) {
+	var (
+		err  error
+		node *ApiKey
+	)
+	akuo.defaults()
+	if len(akuo.hooks) == 0 {
+		node, err = akuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ApiKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			akuo.mutation = mutation
+			node, err = akuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(akuo.hooks) - 1; i >= 0; i-- {
+			if akuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = akuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, akuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *ApiKeyUpdateOne) SaveX(ctx context.Context) *ApiKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *ApiKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *ApiKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akuo *ApiKeyUpdateOne) defaults() {
+	if _, ok := akuo.mutation.UpdatedAt(); !ok {
+		v := apikey.UpdateDefaultUpdatedAt()
+		akuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (akuo *ApiKeyUpdateOne) sqlSave(ctx context.Context) (_node *ApiKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   apikey.Table,
+			Columns: apikey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: apikey.FieldID,
+			},
+		},
+	}
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApiKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+	}
+	if value, ok := akuo.mutation.Prefix(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPrefix,
+		})
+	}
+	if value, ok := akuo.mutation.KeyHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldKeyHash,
+		})
+	}
+	if value, ok := akuo.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldAppIds,
+		})
+	}
+	if akuo.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: apikey.FieldAppIds,
+		})
+	}
+	if value, ok := akuo.mutation.Permissions(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: apikey.FieldPermissions,
+		})
+	}
+	if value, ok := akuo.mutation.SigningSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldSigningSecret,
+		})
+	}
+	if akuo.mutation.SigningSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: apikey.FieldSigningSecret,
+		})
+	}
+	if value, ok := akuo.mutation.PreviousSigningSecret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecret,
+		})
+	}
+	if akuo.mutation.PreviousSigningSecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: apikey.FieldPreviousSigningSecret,
+		})
+	}
+	if value, ok := akuo.mutation.PreviousSigningSecretExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldPreviousSigningSecretExpiresAt,
+		})
+	}
+	if akuo.mutation.PreviousSigningSecretExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldPreviousSigningSecretExpiresAt,
+		})
+	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldExpiresAt,
+		})
+	}
+	if akuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldExpiresAt,
+		})
+	}
+	if value, ok := akuo.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldRevokedAt,
+		})
+	}
+	if akuo.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldRevokedAt,
+		})
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedAt,
+		})
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: apikey.FieldLastUsedAt,
+		})
+	}
+	if value, ok := akuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldCreatedAt,
+		})
+	}
+	if value, ok := akuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldUpdatedAt,
+		})
+	}
+	_node = &ApiKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,175 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/app"
+)
+
+// App is the model entity for the App schema.
+type App struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// PushType holds the value of the "push_type" field.
+	PushType app.PushType `json:"push_type,omitempty"`
+	// Credentials holds the value of the "credentials" field.
+	Credentials []byte `json:"-"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int64 `json:"revision,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*App) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case app.FieldCredentials:
+			values[i] = new([]byte)
+		case app.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case app.FieldID, app.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case app.FieldAppID, app.FieldName, app.FieldPushType:
+			values[i] = new(sql.NullString)
+		case app.FieldCreatedAt, app.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type App", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the App fields.
+func (a *App) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case app.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case app.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				a.AppID = value.String
+			}
+		case app.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case app.FieldPushType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_type", values[i])
+			} else if value.Valid {
+				a.PushType = app.PushType(value.String)
+			}
+		case app.FieldCredentials:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credentials", values[i])
+			} else if value != nil {
+				a.Credentials = *value
+			}
+		case app.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				a.Enabled = value.Bool
+			}
+		case app.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				a.Revision = value.Int64
+			}
+		case app.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case app.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this App.
+// Note that you need to call App.Unwrap() before calling this method if this App
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *App) Update() *AppUpdateOne {
+	return (&AppClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the App entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *App) Unwrap() *App {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: App is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *App) String() string {
+	var builder strings.Builder
+	builder.WriteString("App(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", app_id=")
+	builder.WriteString(a.AppID)
+	builder.WriteString(", name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", push_type=")
+	builder.WriteString(fmt.Sprintf("%v", a.PushType))
+	builder.WriteString(", credentials=<sensitive>")
+	builder.WriteString(", enabled=")
+	builder.WriteString(fmt.Sprintf("%v", a.Enabled))
+	builder.WriteString(", revision=")
+	builder.WriteString(fmt.Sprintf("%v", a.Revision))
+	builder.WriteString(", created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Apps is a parsable slice of App.
+type Apps []*App
+
+func (a Apps) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by entc, DO NOT EDIT.
+
+package app
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the app type in the database.
+	Label = "app"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPushType holds the string denoting the push_type field in the database.
+	FieldPushType = "push_type"
+	// FieldCredentials holds the string denoting the credentials field in the database.
+	FieldCredentials = "credentials"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the app in the database.
+	Table = "apps"
+)
+
+// Columns holds all SQL columns for app fields.
+var Columns = []string{
+	FieldID,
+	FieldAppID,
+	FieldName,
+	FieldPushType,
+	FieldCredentials,
+	FieldEnabled,
+	FieldRevision,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// PushType defines the type for the "push_type" enum field.
+type PushType string
+
+// PushType values.
+const (
+	PushTypeApple    PushType = "apple"
+	PushTypeFirebase PushType = "firebase"
+)
+
+func (pt PushType) String() string {
+	return string(pt)
+}
+
+// PushTypeValidator is a validator for the "push_type" field enum values. It is called by the builders before save.
+func PushTypeValidator(pt PushType) error {
+	switch pt {
+	case PushTypeApple, PushTypeFirebase:
+		return nil
+	default:
+		return fmt.Errorf("app: invalid enum value for push_type field: %q", pt)
+	}
+}
@@ -0,0 +1,404 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/app"
+)
+
+// AppCreate is the builder for creating a App entity.
+type AppCreate struct {
+	config
+	mutation *AppMutation
+	hooks    []Hook
+}
+
+// SetAppID sets the "app_id" field.
+func (ac *AppCreate) SetAppID(s string) *AppCreate {
+	ac.mutation.SetAppID(s)
+	return ac
+}
+
+// SetName sets the "name" field.
+func (ac *AppCreate) SetName(s string) *AppCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ac *AppCreate) SetNillableName(s *string) *AppCreate {
+	if s != nil {
+		ac.SetName(*s)
+	}
+	return ac
+}
+
+// SetPushType sets the "push_type" field.
+func (ac *AppCreate) SetPushType(at app.PushType) *AppCreate {
+	ac.mutation.SetPushType(at)
+	return ac
+}
+
+// SetCredentials sets the "credentials" field.
+func (ac *AppCreate) SetCredentials(b []byte) *AppCreate {
+	ac.mutation.SetCredentials(b)
+	return ac
+}
+
+// SetEnabled sets the "enabled" field.
+func (ac *AppCreate) SetEnabled(b bool) *AppCreate {
+	ac.mutation.SetEnabled(b)
+	return ac
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ac *AppCreate) SetNillableEnabled(b *bool) *AppCreate {
+	if b != nil {
+		ac.SetEnabled(*b)
+	}
+	return ac
+}
+
+// SetRevision sets the "revision" field.
+func (ac *AppCreate) SetRevision(i int64) *AppCreate {
+	ac.mutation.SetRevision(i)
+	return ac
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (ac *AppCreate) SetNillableRevision(i *int64) *AppCreate {
+	if i != nil {
+		ac.SetRevision(*i)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AppCreate) SetCreatedAt(t time.Time) *AppCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AppCreate) SetNillableCreatedAt(t *time.Time) *AppCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AppCreate) SetUpdatedAt(t time.Time) *AppCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AppCreate) SetNillableUpdatedAt(t *time.Time) *AppCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// Mutation returns the AppMutation object of the builder.
+func (ac *AppCreate) Mutation() *AppMutation {
+	return ac.mutation
+}
+
+// Save creates the App in the database.
+func (ac *AppCreate) Save(ctx context.Context) (*App, error) {
+	var (
+		err  error
+		node *App
+	)
+	ac.defaults()
+	if len(ac.hooks) == 0 {
+		if err = ac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AppMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ac.check(); err != nil {
+				return nil, err
+			}
+			ac.mutation = mutation
+			if node, err = ac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			if ac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AppCreate) SaveX(ctx context.Context) *App {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AppCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AppCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AppCreate) defaults() {
+	if _, ok := ac.mutation.Enabled(); !ok {
+		v := app.DefaultEnabled
+		ac.mutation.SetEnabled(v)
+	}
+	if _, ok := ac.mutation.Revision(); !ok {
+		v := app.DefaultRevision
+		ac.mutation.SetRevision(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := app.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := app.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AppCreate) check() error {
+	if _, ok := ac.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "App.app_id"`)}
+	}
+	if _, ok := ac.mutation.PushType(); !ok {
+		return &ValidationError{Name: "push_type", err: errors.New(`ent: missing required field "App.push_type"`)}
+	}
+	if v, ok := ac.mutation.PushType(); ok {
+		if err := app.PushTypeValidator(v); err != nil {
+			return &ValidationError{Name: "push_type", err: fmt.Errorf(`ent: validator failed for field "App.push_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Credentials(); !ok {
+		return &ValidationError{Name: "credentials", err: errors.New(`ent: missing required field "App.credentials"`)}
+	}
+	if _, ok := ac.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "App.enabled"`)}
+	}
+	if _, ok := ac.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "App.revision"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "App.created_at"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "App.updated_at"`)}
+	}
+	return nil
+}
+
+func (ac *AppCreate) sqlSave(ctx context.Context) (*App, error) {
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ac *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
+	var (
+		_node = &App{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: app.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: app.FieldID,
+			},
+		}
+	)
+	if value, ok := ac.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.PushType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: app.FieldPushType,
+		})
+		_node.PushType = value
+	}
+	if value, ok := ac.mutation.Credentials(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: app.FieldCredentials,
+		})
+		_node.Credentials = value
+	}
+	if value, ok := ac.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: app.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := ac.mutation.Revision(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: app.FieldRevision,
+		})
+		_node.Revision = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AppCreateBulk is the builder for creating many App entities in bulk.
+type AppCreateBulk struct {
+	config
+	builders []*AppCreate
+}
+
+// Save creates the App entities in the database.
+func (acb *AppCreateBulk) Save(ctx context.Context) ([]*App, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*App, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AppMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AppCreateBulk) SaveX(ctx context.Context) []*App {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AppCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AppCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
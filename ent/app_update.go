@@ -0,0 +1,614 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/app"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// AppUpdate is the builder for updating App entities.
+type AppUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AppMutation
+}
+
+// Where appends a list predicates to the AppUpdate builder.
+func (au *AppUpdate) Where(ps ...predicate.App) *AppUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetAppID sets the "app_id" field.
+func (au *AppUpdate) SetAppID(s string) *AppUpdate {
+	au.mutation.SetAppID(s)
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AppUpdate) SetName(s string) *AppUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (au *AppUpdate) SetNillableName(s *string) *AppUpdate {
+	if s != nil {
+		au.SetName(*s)
+	}
+	return au
+}
+
+// ClearName clears the value of the "name" field.
+func (au *AppUpdate) ClearName() *AppUpdate {
+	au.mutation.ClearName()
+	return au
+}
+
+// SetPushType sets the "push_type" field.
+func (au *AppUpdate) SetPushType(at app.PushType) *AppUpdate {
+	au.mutation.SetPushType(at)
+	return au
+}
+
+// SetCredentials sets the "credentials" field.
+func (au *AppUpdate) SetCredentials(b []byte) *AppUpdate {
+	au.mutation.SetCredentials(b)
+	return au
+}
+
+// SetEnabled sets the "enabled" field.
+func (au *AppUpdate) SetEnabled(b bool) *AppUpdate {
+	au.mutation.SetEnabled(b)
+	return au
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (au *AppUpdate) SetNillableEnabled(b *bool) *AppUpdate {
+	if b != nil {
+		au.SetEnabled(*b)
+	}
+	return au
+}
+
+// SetRevision sets the "revision" field.
+func (au *AppUpdate) SetRevision(i int64) *AppUpdate {
+	au.mutation.ResetRevision()
+	au.mutation.SetRevision(i)
+	return au
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (au *AppUpdate) SetNillableRevision(i *int64) *AppUpdate {
+	if i != nil {
+		au.SetRevision(*i)
+	}
+	return au
+}
+
+// AddRevision adds i to the "revision" field.
+func (au *AppUpdate) AddRevision(i int64) *AppUpdate {
+	au.mutation.AddRevision(i)
+	return au
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (au *AppUpdate) SetCreatedAt(t time.Time) *AppUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (au *AppUpdate) SetNillableCreatedAt(t *time.Time) *AppUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AppUpdate) SetUpdatedAt(t time.Time) *AppUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// Mutation returns the AppMutation object of the builder.
+func (au *AppUpdate) Mutation() *AppMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AppUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	au.defaults()
+	if len(au.hooks) == 0 {
+		if err = au.check(); err != nil {
+			return 0, err
+		}
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AppMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = au.check(); err != nil {
+				return 0, err
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			if au.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AppUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AppUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AppUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AppUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := app.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AppUpdate) check() error {
+	if v, ok := au.mutation.PushType(); ok {
+		if err := app.PushTypeValidator(v); err != nil {
+			return &ValidationError{Name: "push_type", err: fmt.Errorf(`ent: validator failed for field "App.push_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (au *AppUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   app.Table,
+			Columns: app.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: app.FieldID,
+			},
+		},
+	}
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldAppID,
+		})
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldName,
+		})
+	}
+	if au.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: app.FieldName,
+		})
+	}
+	if value, ok := au.mutation.PushType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: app.FieldPushType,
+		})
+	}
+	if value, ok := au.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: app.FieldCredentials,
+		})
+	}
+	if value, ok := au.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: app.FieldEnabled,
+		})
+	}
+	if value, ok := au.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: app.FieldRevision,
+		})
+	}
+	if value, ok := au.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: app.FieldRevision,
+		})
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldCreatedAt,
+		})
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{app.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AppUpdateOne is the builder for updating a single App entity.
+type AppUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppMutation
+}
+
+// SetAppID sets the "app_id" field.
+func (auo *AppUpdateOne) SetAppID(s string) *AppUpdateOne {
+	auo.mutation.SetAppID(s)
+	return auo
+}
+
+// SetName sets the "name" field.
+func (auo *AppUpdateOne) SetName(s string) *AppUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (auo *AppUpdateOne) SetNillableName(s *string) *AppUpdateOne {
+	if s != nil {
+		auo.SetName(*s)
+	}
+	return auo
+}
+
+// ClearName clears the value of the "name" field.
+func (auo *AppUpdateOne) ClearName() *AppUpdateOne {
+	auo.mutation.ClearName()
+	return auo
+}
+
+// SetPushType sets the "push_type" field.
+func (auo *AppUpdateOne) SetPushType(at app.PushType) *AppUpdateOne {
+	auo.mutation.SetPushType(at)
+	return auo
+}
+
+// SetCredentials sets the "credentials" field.
+func (auo *AppUpdateOne) SetCredentials(b []byte) *AppUpdateOne {
+	auo.mutation.SetCredentials(b)
+	return auo
+}
+
+// SetEnabled sets the "enabled" field.
+func (auo *AppUpdateOne) SetEnabled(b bool) *AppUpdateOne {
+	auo.mutation.SetEnabled(b)
+	return auo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (auo *AppUpdateOne) SetNillableEnabled(b *bool) *AppUpdateOne {
+	if b != nil {
+		auo.SetEnabled(*b)
+	}
+	return auo
+}
+
+// SetRevision sets the "revision" field.
+func (auo *AppUpdateOne) SetRevision(i int64) *AppUpdateOne {
+	auo.mutation.ResetRevision()
+	auo.mutation.SetRevision(i)
+	return auo
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (auo *AppUpdateOne) SetNillableRevision(i *int64) *AppUpdateOne {
+	if i != nil {
+		auo.SetRevision(*i)
+	}
+	return auo
+}
+
+// AddRevision adds i to the "revision" field.
+func (auo *AppUpdateOne) AddRevision(i int64) *AppUpdateOne {
+	auo.mutation.AddRevision(i)
+	return auo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *AppUpdateOne) SetCreatedAt(t time.Time) *AppUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *AppUpdateOne) SetNillableCreatedAt(t *time.Time) *AppUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AppUpdateOne) SetUpdatedAt(t time.Time) *AppUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// Mutation returns the AppMutation object of the builder.
+func (auo *AppUpdateOne) Mutation() *AppMutation {
+	return auo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AppUpdateOne) Select(field string, fields ...string) *AppUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated App entity.
+func (auo *AppUpdateOne) Save(ctx context.Context) (*App, error) {
+	var (
+		err  error
+		node *App
+	)
+	auo.defaults()
+	if len(auo.hooks) == 0 {
+		if err = auo.check(); err != nil {
+			return nil, err
+		}
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AppMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = auo.check(); err != nil {
+				return nil, err
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			if auo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AppUpdateOne) SaveX(ctx context.Context) *App {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AppUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AppUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AppUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := app.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AppUpdateOne) check() error {
+	if v, ok := auo.mutation.PushType(); ok {
+		if err := app.PushTypeValidator(v); err != nil {
+			return &ValidationError{Name: "push_type", err: fmt.Errorf(`ent: validator failed for field "App.push_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   app.Table,
+			Columns: app.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: app.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "App.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, app.FieldID)
+		for _, f := range fields {
+			if !app.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != app.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldAppID,
+		})
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: app.FieldName,
+		})
+	}
+	if auo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: app.FieldName,
+		})
+	}
+	if value, ok := auo.mutation.PushType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: app.FieldPushType,
+		})
+	}
+	if value, ok := auo.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: app.FieldCredentials,
+		})
+	}
+	if value, ok := auo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: app.FieldEnabled,
+		})
+	}
+	if value, ok := auo.mutation.Revision(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: app.FieldRevision,
+		})
+	}
+	if value, ok := auo.mutation.AddedRevision(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: app.FieldRevision,
+		})
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldCreatedAt,
+		})
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: app.FieldUpdatedAt,
+		})
+	}
+	_node = &App{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{app.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
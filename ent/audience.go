@@ -0,0 +1,177 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/audience"
+)
+
+// Audience is the model entity for the Audience schema.
+type Audience struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Segment holds the value of the "segment" field.
+	Segment string `json:"segment,omitempty"`
+	// AppIds holds the value of the "app_ids" field.
+	AppIds []string `json:"app_ids,omitempty"`
+	// MemberCount holds the value of the "member_count" field.
+	MemberCount int64 `json:"member_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Audience) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audience.FieldAppIds:
+			values[i] = new([]byte)
+		case audience.FieldID, audience.FieldMemberCount:
+			values[i] = new(sql.NullInt64)
+		case audience.FieldName, audience.FieldDescription, audience.FieldType, audience.FieldSegment:
+			values[i] = new(sql.NullString)
+		case audience.FieldCreatedAt, audience.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Audience", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Audience fields.
+func (a *Audience) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audience.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case audience.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				a.Name = value.String
+			}
+		case audience.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				a.Description = value.String
+			}
+		case audience.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				a.Type = value.String
+			}
+		case audience.FieldSegment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field segment", values[i])
+			} else if value.Valid {
+				a.Segment = value.String
+			}
+		case audience.FieldAppIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field app_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.AppIds); err != nil {
+					return fmt.Errorf("unmarshal field app_ids: %w", err)
+				}
+			}
+		case audience.FieldMemberCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field member_count", values[i])
+			} else if value.Valid {
+				a.MemberCount = value.Int64
+			}
+		case audience.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case audience.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Audience.
+// Note that you need to call Audience.Unwrap() before calling this method if this Audience
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Audience) Update() *AudienceUpdateOne {
+	return (&AudienceClient{config: a.config}).UpdateOne(a)
+}
+
+// Unwrap unwraps the Audience entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Audience) Unwrap() *Audience {
+	tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Audience is not a transactional entity")
+	}
+	a.config.driver = tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Audience) String() string {
+	var builder strings.Builder
+	builder.WriteString("Audience(")
+	builder.WriteString(fmt.Sprintf("id=%v", a.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(a.Name)
+	builder.WriteString(", description=")
+	builder.WriteString(a.Description)
+	builder.WriteString(", type=")
+	builder.WriteString(a.Type)
+	builder.WriteString(", segment=")
+	builder.WriteString(a.Segment)
+	builder.WriteString(", app_ids=")
+	builder.WriteString(fmt.Sprintf("%v", a.AppIds))
+	builder.WriteString(", member_count=")
+	builder.WriteString(fmt.Sprintf("%v", a.MemberCount))
+	builder.WriteString(", created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Audiences is a parsable slice of Audience.
+type Audiences []*Audience
+
+func (a Audiences) config(cfg config) {
+	for _i := range a {
+		a[_i].config = cfg
+	}
+}
@@ -0,0 +1,401 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/audience"
+)
+
+// AudienceCreate is the builder for creating a Audience entity.
+type AudienceCreate struct {
+	config
+	mutation *AudienceMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ac *AudienceCreate) SetName(s string) *AudienceCreate {
+	ac.mutation.SetName(s)
+	return ac
+}
+
+// SetDescription sets the "description" field.
+func (ac *AudienceCreate) SetDescription(s string) *AudienceCreate {
+	ac.mutation.SetDescription(s)
+	return ac
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ac *AudienceCreate) SetNillableDescription(s *string) *AudienceCreate {
+	if s != nil {
+		ac.SetDescription(*s)
+	}
+	return ac
+}
+
+// SetType sets the "type" field.
+func (ac *AudienceCreate) SetType(s string) *AudienceCreate {
+	ac.mutation.SetType(s)
+	return ac
+}
+
+// SetSegment sets the "segment" field.
+func (ac *AudienceCreate) SetSegment(s string) *AudienceCreate {
+	ac.mutation.SetSegment(s)
+	return ac
+}
+
+// SetNillableSegment sets the "segment" field if the given value is not nil.
+func (ac *AudienceCreate) SetNillableSegment(s *string) *AudienceCreate {
+	if s != nil {
+		ac.SetSegment(*s)
+	}
+	return ac
+}
+
+// SetAppIds sets the "app_ids" field.
+func (ac *AudienceCreate) SetAppIds(s []string) *AudienceCreate {
+	ac.mutation.SetAppIds(s)
+	return ac
+}
+
+// SetMemberCount sets the "member_count" field.
+func (ac *AudienceCreate) SetMemberCount(i int64) *AudienceCreate {
+	ac.mutation.SetMemberCount(i)
+	return ac
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (ac *AudienceCreate) SetNillableMemberCount(i *int64) *AudienceCreate {
+	if i != nil {
+		ac.SetMemberCount(*i)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AudienceCreate) SetCreatedAt(t time.Time) *AudienceCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AudienceCreate) SetNillableCreatedAt(t *time.Time) *AudienceCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AudienceCreate) SetUpdatedAt(t time.Time) *AudienceCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AudienceCreate) SetNillableUpdatedAt(t *time.Time) *AudienceCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// Mutation returns the AudienceMutation object of the builder.
+func (ac *AudienceCreate) Mutation() *AudienceMutation {
+	return ac.mutation
+}
+
+// Save creates the Audience in the database.
+func (ac *AudienceCreate) Save(ctx context.Context) (*Audience, error) {
+	var (
+		err  error
+		node *Audience
+	)
+	ac.defaults()
+	if len(ac.hooks) == 0 {
+		if err = ac.check(); err != nil {
+			return nil, err
+		}
+		node, err = ac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ac.check(); err != nil {
+				return nil, err
+			}
+			ac.mutation = mutation
+			if node, err = ac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ac.hooks) - 1; i >= 0; i-- {
+			if ac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ac.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ac.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AudienceCreate) SaveX(ctx context.Context) *Audience {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AudienceCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AudienceCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AudienceCreate) defaults() {
+	if _, ok := ac.mutation.Description(); !ok {
+		v := audience.DefaultDescription
+		ac.mutation.SetDescription(v)
+	}
+	if _, ok := ac.mutation.MemberCount(); !ok {
+		v := audience.DefaultMemberCount
+		ac.mutation.SetMemberCount(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := audience.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := audience.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AudienceCreate) check() error {
+	if _, ok := ac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Audience.name"`)}
+	}
+	if v, ok := ac.mutation.Name(); ok {
+		if err := audience.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Audience.name": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Audience.description"`)}
+	}
+	if _, ok := ac.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Audience.type"`)}
+	}
+	if _, ok := ac.mutation.MemberCount(); !ok {
+		return &ValidationError{Name: "member_count", err: errors.New(`ent: missing required field "Audience.member_count"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Audience.created_at"`)}
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Audience.updated_at"`)}
+	}
+	return nil
+}
+
+func (ac *AudienceCreate) sqlSave(ctx context.Context) (*Audience, error) {
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (ac *AudienceCreate) createSpec() (*Audience, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Audience{config: ac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: audience.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audience.FieldID,
+			},
+		}
+	)
+	if value, ok := ac.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := ac.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := ac.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldType,
+		})
+		_node.Type = value
+	}
+	if value, ok := ac.mutation.Segment(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldSegment,
+		})
+		_node.Segment = value
+	}
+	if value, ok := ac.mutation.AppIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: audience.FieldAppIds,
+		})
+		_node.AppIds = value
+	}
+	if value, ok := ac.mutation.MemberCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: audience.FieldMemberCount,
+		})
+		_node.MemberCount = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AudienceCreateBulk is the builder for creating many Audience entities in bulk.
+type AudienceCreateBulk struct {
+	config
+	builders []*AudienceCreate
+}
+
+// Save creates the Audience entities in the database.
+func (acb *AudienceCreateBulk) Save(ctx context.Context) ([]*Audience, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Audience, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudienceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AudienceCreateBulk) SaveX(ctx context.Context) []*Audience {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AudienceCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AudienceCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,638 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/audience"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// AudienceUpdate is the builder for updating Audience entities.
+type AudienceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudienceMutation
+}
+
+// Where appends a list predicates to the AudienceUpdate builder.
+func (au *AudienceUpdate) Where(ps ...predicate.Audience) *AudienceUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetName sets the "name" field.
+func (au *AudienceUpdate) SetName(s string) *AudienceUpdate {
+	au.mutation.SetName(s)
+	return au
+}
+
+// SetDescription sets the "description" field.
+func (au *AudienceUpdate) SetDescription(s string) *AudienceUpdate {
+	au.mutation.SetDescription(s)
+	return au
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (au *AudienceUpdate) SetNillableDescription(s *string) *AudienceUpdate {
+	if s != nil {
+		au.SetDescription(*s)
+	}
+	return au
+}
+
+// SetType sets the "type" field.
+func (au *AudienceUpdate) SetType(s string) *AudienceUpdate {
+	au.mutation.SetType(s)
+	return au
+}
+
+// SetSegment sets the "segment" field.
+func (au *AudienceUpdate) SetSegment(s string) *AudienceUpdate {
+	au.mutation.SetSegment(s)
+	return au
+}
+
+// SetNillableSegment sets the "segment" field if the given value is not nil.
+func (au *AudienceUpdate) SetNillableSegment(s *string) *AudienceUpdate {
+	if s != nil {
+		au.SetSegment(*s)
+	}
+	return au
+}
+
+// ClearSegment clears the value of the "segment" field.
+func (au *AudienceUpdate) ClearSegment() *AudienceUpdate {
+	au.mutation.ClearSegment()
+	return au
+}
+
+// SetAppIds sets the "app_ids" field.
+func (au *AudienceUpdate) SetAppIds(s []string) *AudienceUpdate {
+	au.mutation.SetAppIds(s)
+	return au
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (au *AudienceUpdate) ClearAppIds() *AudienceUpdate {
+	au.mutation.ClearAppIds()
+	return au
+}
+
+// SetMemberCount sets the "member_count" field.
+func (au *AudienceUpdate) SetMemberCount(i int64) *AudienceUpdate {
+	au.mutation.ResetMemberCount()
+	au.mutation.SetMemberCount(i)
+	return au
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (au *AudienceUpdate) SetNillableMemberCount(i *int64) *AudienceUpdate {
+	if i != nil {
+		au.SetMemberCount(*i)
+	}
+	return au
+}
+
+// AddMemberCount adds i to the "member_count" field.
+func (au *AudienceUpdate) AddMemberCount(i int64) *AudienceUpdate {
+	au.mutation.AddMemberCount(i)
+	return au
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (au *AudienceUpdate) SetCreatedAt(t time.Time) *AudienceUpdate {
+	au.mutation.SetCreatedAt(t)
+	return au
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (au *AudienceUpdate) SetNillableCreatedAt(t *time.Time) *AudienceUpdate {
+	if t != nil {
+		au.SetCreatedAt(*t)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AudienceUpdate) SetUpdatedAt(t time.Time) *AudienceUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// Mutation returns the AudienceMutation object of the builder.
+func (au *AudienceUpdate) Mutation() *AudienceMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AudienceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	au.defaults()
+	if len(au.hooks) == 0 {
+		if err = au.check(); err != nil {
+			return 0, err
+		}
+		affected, err = au.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = au.check(); err != nil {
+				return 0, err
+			}
+			au.mutation = mutation
+			affected, err = au.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(au.hooks) - 1; i >= 0; i-- {
+			if au.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = au.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, au.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AudienceUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AudienceUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AudienceUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AudienceUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := audience.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AudienceUpdate) check() error {
+	if v, ok := au.mutation.Name(); ok {
+		if err := audience.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Audience.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (au *AudienceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audience.Table,
+			Columns: audience.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audience.FieldID,
+			},
+		},
+	}
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldName,
+		})
+	}
+	if value, ok := au.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldDescription,
+		})
+	}
+	if value, ok := au.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldType,
+		})
+	}
+	if value, ok := au.mutation.Segment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldSegment,
+		})
+	}
+	if au.mutation.SegmentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: audience.FieldSegment,
+		})
+	}
+	if value, ok := au.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: audience.FieldAppIds,
+		})
+	}
+	if au.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: audience.FieldAppIds,
+		})
+	}
+	if value, ok := au.mutation.MemberCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: audience.FieldMemberCount,
+		})
+	}
+	if value, ok := au.mutation.AddedMemberCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: audience.FieldMemberCount,
+		})
+	}
+	if value, ok := au.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldCreatedAt,
+		})
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audience.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AudienceUpdateOne is the builder for updating a single Audience entity.
+type AudienceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudienceMutation
+}
+
+// SetName sets the "name" field.
+func (auo *AudienceUpdateOne) SetName(s string) *AudienceUpdateOne {
+	auo.mutation.SetName(s)
+	return auo
+}
+
+// SetDescription sets the "description" field.
+func (auo *AudienceUpdateOne) SetDescription(s string) *AudienceUpdateOne {
+	auo.mutation.SetDescription(s)
+	return auo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (auo *AudienceUpdateOne) SetNillableDescription(s *string) *AudienceUpdateOne {
+	if s != nil {
+		auo.SetDescription(*s)
+	}
+	return auo
+}
+
+// SetType sets the "type" field.
+func (auo *AudienceUpdateOne) SetType(s string) *AudienceUpdateOne {
+	auo.mutation.SetType(s)
+	return auo
+}
+
+// SetSegment sets the "segment" field.
+func (auo *AudienceUpdateOne) SetSegment(s string) *AudienceUpdateOne {
+	auo.mutation.SetSegment(s)
+	return auo
+}
+
+// SetNillableSegment sets the "segment" field if the given value is not nil.
+func (auo *AudienceUpdateOne) SetNillableSegment(s *string) *AudienceUpdateOne {
+	if s != nil {
+		auo.SetSegment(*s)
+	}
+	return auo
+}
+
+// ClearSegment clears the value of the "segment" field.
+func (auo *AudienceUpdateOne) ClearSegment() *AudienceUpdateOne {
+	auo.mutation.ClearSegment()
+	return auo
+}
+
+// SetAppIds sets the "app_ids" field.
+func (auo *AudienceUpdateOne) SetAppIds(s []string) *AudienceUpdateOne {
+	auo.mutation.SetAppIds(s)
+	return auo
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (auo *AudienceUpdateOne) ClearAppIds() *AudienceUpdateOne {
+	auo.mutation.ClearAppIds()
+	return auo
+}
+
+// SetMemberCount sets the "member_count" field.
+func (auo *AudienceUpdateOne) SetMemberCount(i int64) *AudienceUpdateOne {
+	auo.mutation.ResetMemberCount()
+	auo.mutation.SetMemberCount(i)
+	return auo
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (auo *AudienceUpdateOne) SetNillableMemberCount(i *int64) *AudienceUpdateOne {
+	if i != nil {
+		auo.SetMemberCount(*i)
+	}
+	return auo
+}
+
+// AddMemberCount adds i to the "member_count" field.
+func (auo *AudienceUpdateOne) AddMemberCount(i int64) *AudienceUpdateOne {
+	auo.mutation.AddMemberCount(i)
+	return auo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *AudienceUpdateOne) SetCreatedAt(t time.Time) *AudienceUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *AudienceUpdateOne) SetNillableCreatedAt(t *time.Time) *AudienceUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AudienceUpdateOne) SetUpdatedAt(t time.Time) *AudienceUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// Mutation returns the AudienceMutation object of the builder.
+func (auo *AudienceUpdateOne) Mutation() *AudienceMutation {
+	return auo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AudienceUpdateOne) Select(field string, fields ...string) *AudienceUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Audience entity.
+func (auo *AudienceUpdateOne) Save(ctx context.Context) (*Audience, error) {
+	var (
+		err  error
+		node *Audience
+	)
+	auo.defaults()
+	if len(auo.hooks) == 0 {
+		if err = auo.check(); err != nil {
+			return nil, err
+		}
+		node, err = auo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = auo.check(); err != nil {
+				return nil, err
+			}
+			auo.mutation = mutation
+			node, err = auo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(auo.hooks) - 1; i >= 0; i-- {
+			if auo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = auo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, auo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AudienceUpdateOne) SaveX(ctx context.Context) *Audience {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AudienceUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AudienceUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AudienceUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := audience.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AudienceUpdateOne) check() error {
+	if v, ok := auo.mutation.Name(); ok {
+		if err := audience.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Audience.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (auo *AudienceUpdateOne) sqlSave(ctx context.Context) (_node *Audience, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audience.Table,
+			Columns: audience.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audience.FieldID,
+			},
+		},
+	}
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Audience.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audience.FieldID)
+		for _, f := range fields {
+			if !audience.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != audience.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldName,
+		})
+	}
+	if value, ok := auo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldDescription,
+		})
+	}
+	if value, ok := auo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldType,
+		})
+	}
+	if value, ok := auo.mutation.Segment(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audience.FieldSegment,
+		})
+	}
+	if auo.mutation.SegmentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: audience.FieldSegment,
+		})
+	}
+	if value, ok := auo.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: audience.FieldAppIds,
+		})
+	}
+	if auo.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: audience.FieldAppIds,
+		})
+	}
+	if value, ok := auo.mutation.MemberCount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: audience.FieldMemberCount,
+		})
+	}
+	if value, ok := auo.mutation.AddedMemberCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: audience.FieldMemberCount,
+		})
+	}
+	if value, ok := auo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldCreatedAt,
+		})
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audience.FieldUpdatedAt,
+		})
+	}
+	_node = &Audience{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audience.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,122 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+)
+
+// AudienceMember is the model entity for the AudienceMember schema.
+type AudienceMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AudienceID holds the value of the "audience_id" field.
+	AudienceID int `json:"audience_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AudienceMember) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case audiencemember.FieldID, audiencemember.FieldAudienceID:
+			values[i] = new(sql.NullInt64)
+		case audiencemember.FieldUserID:
+			values[i] = new(sql.NullString)
+		case audiencemember.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AudienceMember", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AudienceMember fields.
+func (am *AudienceMember) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case audiencemember.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			am.ID = int(value.Int64)
+		case audiencemember.FieldAudienceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field audience_id", values[i])
+			} else if value.Valid {
+				am.AudienceID = int(value.Int64)
+			}
+		case audiencemember.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				am.UserID = value.String
+			}
+		case audiencemember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				am.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AudienceMember.
+// Note that you need to call AudienceMember.Unwrap() before calling this method if this AudienceMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (am *AudienceMember) Update() *AudienceMemberUpdateOne {
+	return (&AudienceMemberClient{config: am.config}).UpdateOne(am)
+}
+
+// Unwrap unwraps the AudienceMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (am *AudienceMember) Unwrap() *AudienceMember {
+	tx, ok := am.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AudienceMember is not a transactional entity")
+	}
+	am.config.driver = tx.drv
+	return am
+}
+
+// String implements the fmt.Stringer.
+func (am *AudienceMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("AudienceMember(")
+	builder.WriteString(fmt.Sprintf("id=%v", am.ID))
+	builder.WriteString(", audience_id=")
+	builder.WriteString(fmt.Sprintf("%v", am.AudienceID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(am.UserID)
+	builder.WriteString(", created_at=")
+	builder.WriteString(am.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AudienceMembers is a parsable slice of AudienceMember.
+type AudienceMembers []*AudienceMember
+
+func (am AudienceMembers) config(cfg config) {
+	for _i := range am {
+		am[_i].config = cfg
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by entc, DO NOT EDIT.
+
+package audiencemember
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the audiencemember type in the database.
+	Label = "audience_member"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAudienceID holds the string denoting the audience_id field in the database.
+	FieldAudienceID = "audience_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the audiencemember in the database.
+	Table = "audience_members"
+)
+
+// Columns holds all SQL columns for audiencemember fields.
+var Columns = []string{
+	FieldID,
+	FieldAudienceID,
+	FieldUserID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
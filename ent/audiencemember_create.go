@@ -0,0 +1,273 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+)
+
+// AudienceMemberCreate is the builder for creating a AudienceMember entity.
+type AudienceMemberCreate struct {
+	config
+	mutation *AudienceMemberMutation
+	hooks    []Hook
+}
+
+// SetAudienceID sets the "audience_id" field.
+func (amc *AudienceMemberCreate) SetAudienceID(i int) *AudienceMemberCreate {
+	amc.mutation.SetAudienceID(i)
+	return amc
+}
+
+// SetUserID sets the "user_id" field.
+func (amc *AudienceMemberCreate) SetUserID(s string) *AudienceMemberCreate {
+	amc.mutation.SetUserID(s)
+	return amc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (amc *AudienceMemberCreate) SetCreatedAt(t time.Time) *AudienceMemberCreate {
+	amc.mutation.SetCreatedAt(t)
+	return amc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (amc *AudienceMemberCreate) SetNillableCreatedAt(t *time.Time) *AudienceMemberCreate {
+	if t != nil {
+		amc.SetCreatedAt(*t)
+	}
+	return amc
+}
+
+// Mutation returns the AudienceMemberMutation object of the builder.
+func (amc *AudienceMemberCreate) Mutation() *AudienceMemberMutation {
+	return amc.mutation
+}
+
+// Save creates the AudienceMember in the database.
+func (amc *AudienceMemberCreate) Save(ctx context.Context) (*AudienceMember, error) {
+	var (
+		err  error
+		node *AudienceMember
+	)
+	amc.defaults()
+	if len(amc.hooks) == 0 {
+		if err = amc.check(); err != nil {
+			return nil, err
+		}
+		node, err = amc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = amc.check(); err != nil {
+				return nil, err
+			}
+			amc.mutation = mutation
+			if node, err = amc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(amc.hooks) - 1; i >= 0; i-- {
+			if amc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = amc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, amc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (amc *AudienceMemberCreate) SaveX(ctx context.Context) *AudienceMember {
+	v, err := amc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (amc *AudienceMemberCreate) Exec(ctx context.Context) error {
+	_, err := amc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amc *AudienceMemberCreate) ExecX(ctx context.Context) {
+	if err := amc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (amc *AudienceMemberCreate) defaults() {
+	if _, ok := amc.mutation.CreatedAt(); !ok {
+		v := audiencemember.DefaultCreatedAt()
+		amc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (amc *AudienceMemberCreate) check() error {
+	if _, ok := amc.mutation.AudienceID(); !ok {
+		return &ValidationError{Name: "audience_id", err: errors.New(`ent: missing required field "AudienceMember.audience_id"`)}
+	}
+	if _, ok := amc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AudienceMember.user_id"`)}
+	}
+	if _, ok := amc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AudienceMember.created_at"`)}
+	}
+	return nil
+}
+
+func (amc *AudienceMemberCreate) sqlSave(ctx context.Context) (*AudienceMember, error) {
+	_node, _spec := amc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, amc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (amc *AudienceMemberCreate) createSpec() (*AudienceMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AudienceMember{config: amc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: audiencemember.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audiencemember.FieldID,
+			},
+		}
+	)
+	if value, ok := amc.mutation.AudienceID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audiencemember.FieldAudienceID,
+		})
+		_node.AudienceID = value
+	}
+	if value, ok := amc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audiencemember.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := amc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audiencemember.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AudienceMemberCreateBulk is the builder for creating many AudienceMember entities in bulk.
+type AudienceMemberCreateBulk struct {
+	config
+	builders []*AudienceMemberCreate
+}
+
+// Save creates the AudienceMember entities in the database.
+func (amcb *AudienceMemberCreateBulk) Save(ctx context.Context) ([]*AudienceMember, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(amcb.builders))
+	nodes := make([]*AudienceMember, len(amcb.builders))
+	mutators := make([]Mutator, len(amcb.builders))
+	for i := range amcb.builders {
+		func(i int, root context.Context) {
+			builder := amcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AudienceMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, amcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, amcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, amcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amcb *AudienceMemberCreateBulk) SaveX(ctx context.Context) []*AudienceMember {
+	v, err := amcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (amcb *AudienceMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := amcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amcb *AudienceMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := amcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
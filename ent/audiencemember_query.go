@@ -0,0 +1,526 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// AudienceMemberQuery is the builder for querying AudienceMember entities.
+type AudienceMemberQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.AudienceMember
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AudienceMemberQuery builder.
+func (amq *AudienceMemberQuery) Where(ps ...predicate.AudienceMember) *AudienceMemberQuery {
+	amq.predicates = append(amq.predicates, ps...)
+	return amq
+}
+
+// Limit adds a limit step to the query.
+func (amq *AudienceMemberQuery) Limit(limit int) *AudienceMemberQuery {
+	amq.limit = &limit
+	return amq
+}
+
+// Offset adds an offset step to the query.
+func (amq *AudienceMemberQuery) Offset(offset int) *AudienceMemberQuery {
+	amq.offset = &offset
+	return amq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (amq *AudienceMemberQuery) Unique(unique bool) *AudienceMemberQuery {
+	amq.unique = &unique
+	return amq
+}
+
+// Order adds an order step to the query.
+func (amq *AudienceMemberQuery) Order(o ...OrderFunc) *AudienceMemberQuery {
+	amq.order = append(amq.order, o...)
+	return amq
+}
+
+// First returns the first AudienceMember entity from the query.
+// Returns a *NotFoundError when no AudienceMember was found.
+func (amq *AudienceMemberQuery) First(ctx context.Context) (*AudienceMember, error) {
+	nodes, err := amq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{audiencemember.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (amq *AudienceMemberQuery) FirstX(ctx context.Context) *AudienceMember {
+	node, err := amq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AudienceMember ID from the query.
+// Returns a *NotFoundError when no AudienceMember ID was found.
+func (amq *AudienceMemberQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = amq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{audiencemember.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (amq *AudienceMemberQuery) FirstIDX(ctx context.Context) int {
+	id, err := amq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AudienceMember entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AudienceMember entity is found.
+// Returns a *NotFoundError when no AudienceMember entities are found.
+func (amq *AudienceMemberQuery) Only(ctx context.Context) (*AudienceMember, error) {
+	nodes, err := amq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{audiencemember.Label}
+	default:
+		return nil, &NotSingularError{audiencemember.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (amq *AudienceMemberQuery) OnlyX(ctx context.Context) *AudienceMember {
+	node, err := amq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AudienceMember ID in the query.
+// Returns a *NotSingularError when more than one AudienceMember ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (amq *AudienceMemberQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = amq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{audiencemember.Label}
+	default:
+		err = &NotSingularError{audiencemember.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (amq *AudienceMemberQuery) OnlyIDX(ctx context.Context) int {
+	id, err := amq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AudienceMembers.
+func (amq *AudienceMemberQuery) All(ctx context.Context) ([]*AudienceMember, error) {
+	if err := amq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return amq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (amq *AudienceMemberQuery) AllX(ctx context.Context) []*AudienceMember {
+	nodes, err := amq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AudienceMember IDs.
+func (amq *AudienceMemberQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := amq.Select(audiencemember.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (amq *AudienceMemberQuery) IDsX(ctx context.Context) []int {
+	ids, err := amq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (amq *AudienceMemberQuery) Count(ctx context.Context) (int, error) {
+	if err := amq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return amq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (amq *AudienceMemberQuery) CountX(ctx context.Context) int {
+	count, err := amq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (amq *AudienceMemberQuery) Exist(ctx context.Context) (bool, error) {
+	if err := amq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return amq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (amq *AudienceMemberQuery) ExistX(ctx context.Context) bool {
+	exist, err := amq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AudienceMemberQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (amq *AudienceMemberQuery) Clone() *AudienceMemberQuery {
+	if amq == nil {
+		return nil
+	}
+	return &AudienceMemberQuery{
+		config:     amq.config,
+		limit:      amq.limit,
+		offset:     amq.offset,
+		order:      append([]OrderFunc{}, amq.order...),
+		predicates: append([]predicate.AudienceMember{}, amq.predicates...),
+		// clone intermediate query.
+		sql:    amq.sql.Clone(),
+		path:   amq.path,
+		unique: amq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AudienceID int `json:"audience_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AudienceMember.Query().
+//		GroupBy(audiencemember.FieldAudienceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (amq *AudienceMemberQuery) GroupBy(field string, fields ...string) *AudienceMemberGroupBy {
+	grbuild := &AudienceMemberGroupBy{config: amq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := amq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return amq.sqlQuery(ctx), nil
+	}
+	grbuild.label = audiencemember.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AudienceID int `json:"audience_id,omitempty"`
+//	}
+//
+//	client.AudienceMember.Query().
+//		Select(audiencemember.FieldAudienceID).
+//		Scan(ctx, &v)
+func (amq *AudienceMemberQuery) Select(fields ...string) *AudienceMemberSelect {
+	amq.fields = append(amq.fields, fields...)
+	selbuild := &AudienceMemberSelect{AudienceMemberQuery: amq}
+	selbuild.label = audiencemember.Label
+	selbuild.flds, selbuild.scan = &amq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (amq *AudienceMemberQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range amq.fields {
+		if !audiencemember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if amq.path != nil {
+		prev, err := amq.path(ctx)
+		if err != nil {
+			return err
+		}
+		amq.sql = prev
+	}
+	return nil
+}
+
+func (amq *AudienceMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AudienceMember, error) {
+	var (
+		nodes = []*AudienceMember{}
+		_spec = amq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*AudienceMember).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &AudienceMember{config: amq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, amq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (amq *AudienceMemberQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := amq.querySpec()
+	_spec.Node.Columns = amq.fields
+	if len(amq.fields) > 0 {
+		_spec.Unique = amq.unique != nil && *amq.unique
+	}
+	return sqlgraph.CountNodes(ctx, amq.driver, _spec)
+}
+
+func (amq *AudienceMemberQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := amq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (amq *AudienceMemberQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audiencemember.Table,
+			Columns: audiencemember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audiencemember.FieldID,
+			},
+		},
+		From:   amq.sql,
+		Unique: true,
+	}
+	if unique := amq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := amq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audiencemember.FieldID)
+		for i := range fields {
+			if fields[i] != audiencemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := amq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := amq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := amq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := amq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (amq *AudienceMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(amq.driver.Dialect())
+	t1 := builder.Table(audiencemember.Table)
+	columns := amq.fields
+	if len(columns) == 0 {
+		columns = audiencemember.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if amq.sql != nil {
+		selector = amq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if amq.unique != nil && *amq.unique {
+		selector.Distinct()
+	}
+	for _, p := range amq.predicates {
+		p(selector)
+	}
+	for _, p := range amq.order {
+		p(selector)
+	}
+	if offset := amq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := amq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AudienceMemberGroupBy is the group-by builder for AudienceMember entities.
+type AudienceMemberGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (amgb *AudienceMemberGroupBy) Aggregate(fns ...AggregateFunc) *AudienceMemberGroupBy {
+	amgb.fns = append(amgb.fns, fns...)
+	return amgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (amgb *AudienceMemberGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := amgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	amgb.sql = query
+	return amgb.sqlScan(ctx, v)
+}
+
+func (amgb *AudienceMemberGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range amgb.fields {
+		if !audiencemember.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := amgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := amgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (amgb *AudienceMemberGroupBy) sqlQuery() *sql.Selector {
+	selector := amgb.sql.Select()
+	aggregation := make([]string, 0, len(amgb.fns))
+	for _, fn := range amgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(amgb.fields)+len(amgb.fns))
+		for _, f := range amgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(amgb.fields...)...)
+}
+
+// AudienceMemberSelect is the builder for selecting fields of AudienceMember entities.
+type AudienceMemberSelect struct {
+	*AudienceMemberQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ams *AudienceMemberSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ams.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ams.sql = ams.AudienceMemberQuery.sqlQuery(ctx)
+	return ams.sqlScan(ctx, v)
+}
+
+func (ams *AudienceMemberSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ams.sql.Query()
+	if err := ams.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
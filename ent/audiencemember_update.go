@@ -0,0 +1,362 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// AudienceMemberUpdate is the builder for updating AudienceMember entities.
+type AudienceMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AudienceMemberMutation
+}
+
+// Where appends a list predicates to the AudienceMemberUpdate builder.
+func (amu *AudienceMemberUpdate) Where(ps ...predicate.AudienceMember) *AudienceMemberUpdate {
+	amu.mutation.Where(ps...)
+	return amu
+}
+
+// SetAudienceID sets the "audience_id" field.
+func (amu *AudienceMemberUpdate) SetAudienceID(i int) *AudienceMemberUpdate {
+	amu.mutation.ResetAudienceID()
+	amu.mutation.SetAudienceID(i)
+	return amu
+}
+
+// AddAudienceID adds i to the "audience_id" field.
+func (amu *AudienceMemberUpdate) AddAudienceID(i int) *AudienceMemberUpdate {
+	amu.mutation.AddAudienceID(i)
+	return amu
+}
+
+// SetUserID sets the "user_id" field.
+func (amu *AudienceMemberUpdate) SetUserID(s string) *AudienceMemberUpdate {
+	amu.mutation.SetUserID(s)
+	return amu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (amu *AudienceMemberUpdate) SetCreatedAt(t time.Time) *AudienceMemberUpdate {
+	amu.mutation.SetCreatedAt(t)
+	return amu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (amu *AudienceMemberUpdate) SetNillableCreatedAt(t *time.Time) *AudienceMemberUpdate {
+	if t != nil {
+		amu.SetCreatedAt(*t)
+	}
+	return amu
+}
+
+// Mutation returns the AudienceMemberMutation object of the builder.
+func (amu *AudienceMemberUpdate) Mutation() *AudienceMemberMutation {
+	return amu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (amu *AudienceMemberUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(amu.hooks) == 0 {
+		affected, err = amu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			amu.mutation = mutation
+			affected, err = amu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(amu.hooks) - 1; i >= 0; i-- {
+			if amu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = amu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, amu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amu *AudienceMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := amu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (amu *AudienceMemberUpdate) Exec(ctx context.Context) error {
+	_, err := amu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amu *AudienceMemberUpdate) ExecX(ctx context.Context) {
+	if err := amu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (amu *AudienceMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audiencemember.Table,
+			Columns: audiencemember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audiencemember.FieldID,
+			},
+		},
+	}
+	if ps := amu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := amu.mutation.AudienceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audiencemember.FieldAudienceID,
+		})
+	}
+	if value, ok := amu.mutation.AddedAudienceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audiencemember.FieldAudienceID,
+		})
+	}
+	if value, ok := amu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audiencemember.FieldUserID,
+		})
+	}
+	if value, ok := amu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audiencemember.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, amu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiencemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AudienceMemberUpdateOne is the builder for updating a single AudienceMember entity.
+type AudienceMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AudienceMemberMutation
+}
+
+// SetAudienceID sets the "audience_id" field.
+func (amuo *AudienceMemberUpdateOne) SetAudienceID(i int) *AudienceMemberUpdateOne {
+	amuo.mutation.ResetAudienceID()
+	amuo.mutation.SetAudienceID(i)
+	return amuo
+}
+
+// AddAudienceID adds i to the "audience_id" field.
+func (amuo *AudienceMemberUpdateOne) AddAudienceID(i int) *AudienceMemberUpdateOne {
+	amuo.mutation.AddAudienceID(i)
+	return amuo
+}
+
+// SetUserID sets the "user_id" field.
+func (amuo *AudienceMemberUpdateOne) SetUserID(s string) *AudienceMemberUpdateOne {
+	amuo.mutation.SetUserID(s)
+	return amuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (amuo *AudienceMemberUpdateOne) SetCreatedAt(t time.Time) *AudienceMemberUpdateOne {
+	amuo.mutation.SetCreatedAt(t)
+	return amuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (amuo *AudienceMemberUpdateOne) SetNillableCreatedAt(t *time.Time) *AudienceMemberUpdateOne {
+	if t != nil {
+		amuo.SetCreatedAt(*t)
+	}
+	return amuo
+}
+
+// Mutation returns the AudienceMemberMutation object of the builder.
+func (amuo *AudienceMemberUpdateOne) Mutation() *AudienceMemberMutation {
+	return amuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (amuo *AudienceMemberUpdateOne) Select(field string, fields ...string) *AudienceMemberUpdateOne {
+	amuo.fields = append([]string{field}, fields...)
+	return amuo
+}
+
+// Save executes the query and returns the updated AudienceMember entity.
+func (amuo *AudienceMemberUpdateOne) Save(ctx context.Context) (*AudienceMember, error) {
+	var (
+		err  error
+		node *AudienceMember
+	)
+	if len(amuo.hooks) == 0 {
+		node, err = amuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AudienceMemberMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			amuo.mutation = mutation
+			node, err = amuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(amuo.hooks) - 1; i >= 0; i-- {
+			if amuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = amuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, amuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amuo *AudienceMemberUpdateOne) SaveX(ctx context.Context) *AudienceMember {
+	node, err := amuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (amuo *AudienceMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := amuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amuo *AudienceMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := amuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (amuo *AudienceMemberUpdateOne) sqlSave(ctx context.Context) (_node *AudienceMember, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   audiencemember.Table,
+			Columns: audiencemember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audiencemember.FieldID,
+			},
+		},
+	}
+	id, ok := amuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AudienceMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := amuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, audiencemember.FieldID)
+		for _, f := range fields {
+			if !audiencemember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != audiencemember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := amuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := amuo.mutation.AudienceID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audiencemember.FieldAudienceID,
+		})
+	}
+	if value, ok := amuo.mutation.AddedAudienceID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: audiencemember.FieldAudienceID,
+		})
+	}
+	if value, ok := amuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: audiencemember.FieldUserID,
+		})
+	}
+	if value, ok := amuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: audiencemember.FieldCreatedAt,
+		})
+	}
+	_node = &AudienceMember{config: amuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, amuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{audiencemember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,247 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/auditlog"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CallerKeyID holds the value of the "caller_key_id" field.
+	CallerKeyID int `json:"caller_key_id,omitempty"`
+	// CallerName holds the value of the "caller_name" field.
+	CallerName string `json:"caller_name,omitempty"`
+	// CallerUserID holds the value of the "caller_user_id" field.
+	CallerUserID string `json:"caller_user_id,omitempty"`
+	// RemoteAddr holds the value of the "remote_addr" field.
+	RemoteAddr string `json:"remote_addr,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Endpoint holds the value of the "endpoint" field.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation string `json:"operation,omitempty"`
+	// Target holds the value of the "target" field.
+	Target string `json:"target,omitempty"`
+	// ActionID holds the value of the "action_id" field.
+	ActionID string `json:"action_id,omitempty"`
+	// AppIds holds the value of the "app_ids" field.
+	AppIds []string `json:"app_ids,omitempty"`
+	// AudienceSize holds the value of the "audience_size" field.
+	AudienceSize int64 `json:"audience_size,omitempty"`
+	// MessageSummary holds the value of the "message_summary" field.
+	MessageSummary string `json:"message_summary,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// Result holds the value of the "result" field.
+	Result string `json:"result,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldAppIds:
+			values[i] = new([]byte)
+		case auditlog.FieldID, auditlog.FieldCallerKeyID, auditlog.FieldAudienceSize, auditlog.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldCallerName, auditlog.FieldCallerUserID, auditlog.FieldRemoteAddr, auditlog.FieldMethod, auditlog.FieldEndpoint, auditlog.FieldOperation, auditlog.FieldTarget, auditlog.FieldActionID, auditlog.FieldMessageSummary, auditlog.FieldResult:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditLog", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case auditlog.FieldCallerKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field caller_key_id", values[i])
+			} else if value.Valid {
+				al.CallerKeyID = int(value.Int64)
+			}
+		case auditlog.FieldCallerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller_name", values[i])
+			} else if value.Valid {
+				al.CallerName = value.String
+			}
+		case auditlog.FieldCallerUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field caller_user_id", values[i])
+			} else if value.Valid {
+				al.CallerUserID = value.String
+			}
+		case auditlog.FieldRemoteAddr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remote_addr", values[i])
+			} else if value.Valid {
+				al.RemoteAddr = value.String
+			}
+		case auditlog.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				al.Method = value.String
+			}
+		case auditlog.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				al.Endpoint = value.String
+			}
+		case auditlog.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				al.Operation = value.String
+			}
+		case auditlog.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				al.Target = value.String
+			}
+		case auditlog.FieldActionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_id", values[i])
+			} else if value.Valid {
+				al.ActionID = value.String
+			}
+		case auditlog.FieldAppIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field app_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &al.AppIds); err != nil {
+					return fmt.Errorf("unmarshal field app_ids: %w", err)
+				}
+			}
+		case auditlog.FieldAudienceSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field audience_size", values[i])
+			} else if value.Valid {
+				al.AudienceSize = value.Int64
+			}
+		case auditlog.FieldMessageSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_summary", values[i])
+			} else if value.Valid {
+				al.MessageSummary = value.String
+			}
+		case auditlog.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				al.StatusCode = int(value.Int64)
+			}
+		case auditlog.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				al.Result = value.String
+			}
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return (&AuditLogClient{config: al.config}).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v", al.ID))
+	builder.WriteString(", caller_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.CallerKeyID))
+	builder.WriteString(", caller_name=")
+	builder.WriteString(al.CallerName)
+	builder.WriteString(", caller_user_id=")
+	builder.WriteString(al.CallerUserID)
+	builder.WriteString(", remote_addr=")
+	builder.WriteString(al.RemoteAddr)
+	builder.WriteString(", method=")
+	builder.WriteString(al.Method)
+	builder.WriteString(", endpoint=")
+	builder.WriteString(al.Endpoint)
+	builder.WriteString(", operation=")
+	builder.WriteString(al.Operation)
+	builder.WriteString(", target=")
+	builder.WriteString(al.Target)
+	builder.WriteString(", action_id=")
+	builder.WriteString(al.ActionID)
+	builder.WriteString(", app_ids=")
+	builder.WriteString(fmt.Sprintf("%v", al.AppIds))
+	builder.WriteString(", audience_size=")
+	builder.WriteString(fmt.Sprintf("%v", al.AudienceSize))
+	builder.WriteString(", message_summary=")
+	builder.WriteString(al.MessageSummary)
+	builder.WriteString(", status_code=")
+	builder.WriteString(fmt.Sprintf("%v", al.StatusCode))
+	builder.WriteString(", result=")
+	builder.WriteString(al.Result)
+	builder.WriteString(", created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
+
+func (al AuditLogs) config(cfg config) {
+	for _i := range al {
+		al[_i].config = cfg
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by entc, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the auditlog type in the database.
+	Label = "audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCallerKeyID holds the string denoting the caller_key_id field in the database.
+	FieldCallerKeyID = "caller_key_id"
+	// FieldCallerName holds the string denoting the caller_name field in the database.
+	FieldCallerName = "caller_name"
+	// FieldCallerUserID holds the string denoting the caller_user_id field in the database.
+	FieldCallerUserID = "caller_user_id"
+	// FieldRemoteAddr holds the string denoting the remote_addr field in the database.
+	FieldRemoteAddr = "remote_addr"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldEndpoint holds the string denoting the endpoint field in the database.
+	FieldEndpoint = "endpoint"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldActionID holds the string denoting the action_id field in the database.
+	FieldActionID = "action_id"
+	// FieldAppIds holds the string denoting the app_ids field in the database.
+	FieldAppIds = "app_ids"
+	// FieldAudienceSize holds the string denoting the audience_size field in the database.
+	FieldAudienceSize = "audience_size"
+	// FieldMessageSummary holds the string denoting the message_summary field in the database.
+	FieldMessageSummary = "message_summary"
+	// FieldStatusCode holds the string denoting the status_code field in the database.
+	FieldStatusCode = "status_code"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditlog in the database.
+	Table = "audit_logs"
+)
+
+// Columns holds all SQL columns for auditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCallerKeyID,
+	FieldCallerName,
+	FieldCallerUserID,
+	FieldRemoteAddr,
+	FieldMethod,
+	FieldEndpoint,
+	FieldOperation,
+	FieldTarget,
+	FieldActionID,
+	FieldAppIds,
+	FieldAudienceSize,
+	FieldMessageSummary,
+	FieldStatusCode,
+	FieldResult,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCallerKeyID holds the default value on creation for the "caller_key_id" field.
+	DefaultCallerKeyID int
+	// DefaultAudienceSize holds the default value on creation for the "audience_size" field.
+	DefaultAudienceSize int64
+	// MessageSummaryValidator is a validator for the "message_summary" field. It is called by the builders before save.
+	MessageSummaryValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
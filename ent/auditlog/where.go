@@ -0,0 +1,1735 @@
+// Code generated by entc, DO NOT EDIT.
+
+package auditlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CallerKeyID applies equality check predicate on the "caller_key_id" field. It's identical to CallerKeyIDEQ.
+func CallerKeyID(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerName applies equality check predicate on the "caller_name" field. It's identical to CallerNameEQ.
+func CallerName(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerUserID applies equality check predicate on the "caller_user_id" field. It's identical to CallerUserIDEQ.
+func CallerUserID(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerUserID), v))
+	})
+}
+
+// RemoteAddr applies equality check predicate on the "remote_addr" field. It's identical to RemoteAddrEQ.
+func RemoteAddr(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMethod), v))
+	})
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndpoint), v))
+	})
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTarget), v))
+	})
+}
+
+// ActionID applies equality check predicate on the "action_id" field. It's identical to ActionIDEQ.
+func ActionID(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// AudienceSize applies equality check predicate on the "audience_size" field. It's identical to AudienceSizeEQ.
+func AudienceSize(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAudienceSize), v))
+	})
+}
+
+// MessageSummary applies equality check predicate on the "message_summary" field. It's identical to MessageSummaryEQ.
+func MessageSummary(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMessageSummary), v))
+	})
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResult), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CallerKeyIDEQ applies the EQ predicate on the "caller_key_id" field.
+func CallerKeyIDEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerKeyIDNEQ applies the NEQ predicate on the "caller_key_id" field.
+func CallerKeyIDNEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerKeyIDIn applies the In predicate on the "caller_key_id" field.
+func CallerKeyIDIn(vs ...int) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCallerKeyID), v...))
+	})
+}
+
+// CallerKeyIDNotIn applies the NotIn predicate on the "caller_key_id" field.
+func CallerKeyIDNotIn(vs ...int) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCallerKeyID), v...))
+	})
+}
+
+// CallerKeyIDGT applies the GT predicate on the "caller_key_id" field.
+func CallerKeyIDGT(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerKeyIDGTE applies the GTE predicate on the "caller_key_id" field.
+func CallerKeyIDGTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerKeyIDLT applies the LT predicate on the "caller_key_id" field.
+func CallerKeyIDLT(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerKeyIDLTE applies the LTE predicate on the "caller_key_id" field.
+func CallerKeyIDLTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCallerKeyID), v))
+	})
+}
+
+// CallerNameEQ applies the EQ predicate on the "caller_name" field.
+func CallerNameEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameNEQ applies the NEQ predicate on the "caller_name" field.
+func CallerNameNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameIn applies the In predicate on the "caller_name" field.
+func CallerNameIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCallerName), v...))
+	})
+}
+
+// CallerNameNotIn applies the NotIn predicate on the "caller_name" field.
+func CallerNameNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCallerName), v...))
+	})
+}
+
+// CallerNameGT applies the GT predicate on the "caller_name" field.
+func CallerNameGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameGTE applies the GTE predicate on the "caller_name" field.
+func CallerNameGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameLT applies the LT predicate on the "caller_name" field.
+func CallerNameLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameLTE applies the LTE predicate on the "caller_name" field.
+func CallerNameLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameContains applies the Contains predicate on the "caller_name" field.
+func CallerNameContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameHasPrefix applies the HasPrefix predicate on the "caller_name" field.
+func CallerNameHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameHasSuffix applies the HasSuffix predicate on the "caller_name" field.
+func CallerNameHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameEqualFold applies the EqualFold predicate on the "caller_name" field.
+func CallerNameEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerNameContainsFold applies the ContainsFold predicate on the "caller_name" field.
+func CallerNameContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCallerName), v))
+	})
+}
+
+// CallerUserIDEQ applies the EQ predicate on the "caller_user_id" field.
+func CallerUserIDEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDNEQ applies the NEQ predicate on the "caller_user_id" field.
+func CallerUserIDNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDIn applies the In predicate on the "caller_user_id" field.
+func CallerUserIDIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCallerUserID), v...))
+	})
+}
+
+// CallerUserIDNotIn applies the NotIn predicate on the "caller_user_id" field.
+func CallerUserIDNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCallerUserID), v...))
+	})
+}
+
+// CallerUserIDGT applies the GT predicate on the "caller_user_id" field.
+func CallerUserIDGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDGTE applies the GTE predicate on the "caller_user_id" field.
+func CallerUserIDGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDLT applies the LT predicate on the "caller_user_id" field.
+func CallerUserIDLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDLTE applies the LTE predicate on the "caller_user_id" field.
+func CallerUserIDLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDContains applies the Contains predicate on the "caller_user_id" field.
+func CallerUserIDContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDHasPrefix applies the HasPrefix predicate on the "caller_user_id" field.
+func CallerUserIDHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDHasSuffix applies the HasSuffix predicate on the "caller_user_id" field.
+func CallerUserIDHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDIsNil applies the IsNil predicate on the "caller_user_id" field.
+func CallerUserIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCallerUserID)))
+	})
+}
+
+// CallerUserIDNotNil applies the NotNil predicate on the "caller_user_id" field.
+func CallerUserIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCallerUserID)))
+	})
+}
+
+// CallerUserIDEqualFold applies the EqualFold predicate on the "caller_user_id" field.
+func CallerUserIDEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCallerUserID), v))
+	})
+}
+
+// CallerUserIDContainsFold applies the ContainsFold predicate on the "caller_user_id" field.
+func CallerUserIDContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCallerUserID), v))
+	})
+}
+
+// RemoteAddrEQ applies the EQ predicate on the "remote_addr" field.
+func RemoteAddrEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrNEQ applies the NEQ predicate on the "remote_addr" field.
+func RemoteAddrNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrIn applies the In predicate on the "remote_addr" field.
+func RemoteAddrIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRemoteAddr), v...))
+	})
+}
+
+// RemoteAddrNotIn applies the NotIn predicate on the "remote_addr" field.
+func RemoteAddrNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRemoteAddr), v...))
+	})
+}
+
+// RemoteAddrGT applies the GT predicate on the "remote_addr" field.
+func RemoteAddrGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrGTE applies the GTE predicate on the "remote_addr" field.
+func RemoteAddrGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrLT applies the LT predicate on the "remote_addr" field.
+func RemoteAddrLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrLTE applies the LTE predicate on the "remote_addr" field.
+func RemoteAddrLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrContains applies the Contains predicate on the "remote_addr" field.
+func RemoteAddrContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrHasPrefix applies the HasPrefix predicate on the "remote_addr" field.
+func RemoteAddrHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrHasSuffix applies the HasSuffix predicate on the "remote_addr" field.
+func RemoteAddrHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrIsNil applies the IsNil predicate on the "remote_addr" field.
+func RemoteAddrIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRemoteAddr)))
+	})
+}
+
+// RemoteAddrNotNil applies the NotNil predicate on the "remote_addr" field.
+func RemoteAddrNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRemoteAddr)))
+	})
+}
+
+// RemoteAddrEqualFold applies the EqualFold predicate on the "remote_addr" field.
+func RemoteAddrEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// RemoteAddrContainsFold applies the ContainsFold predicate on the "remote_addr" field.
+func RemoteAddrContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRemoteAddr), v))
+	})
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMethod), v))
+	})
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMethod), v))
+	})
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMethod), v...))
+	})
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMethod), v...))
+	})
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMethod), v))
+	})
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMethod), v))
+	})
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMethod), v))
+	})
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMethod), v))
+	})
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMethod), v))
+	})
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMethod), v))
+	})
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMethod), v))
+	})
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMethod), v))
+	})
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMethod), v))
+	})
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEndpoint), v...))
+	})
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEndpoint), v...))
+	})
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEndpoint), v))
+	})
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEndpoint), v))
+	})
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOperation), v))
+	})
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOperation), v...))
+	})
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOperation), v))
+	})
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOperation), v))
+	})
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOperation), v))
+	})
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOperation), v))
+	})
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTarget), v))
+	})
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTarget), v))
+	})
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTarget), v...))
+	})
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTarget), v...))
+	})
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTarget), v))
+	})
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTarget), v))
+	})
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTarget), v))
+	})
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTarget), v))
+	})
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTarget), v))
+	})
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTarget), v))
+	})
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTarget), v))
+	})
+}
+
+// TargetIsNil applies the IsNil predicate on the "target" field.
+func TargetIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTarget)))
+	})
+}
+
+// TargetNotNil applies the NotNil predicate on the "target" field.
+func TargetNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTarget)))
+	})
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTarget), v))
+	})
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTarget), v))
+	})
+}
+
+// ActionIDEQ applies the EQ predicate on the "action_id" field.
+func ActionIDEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDNEQ applies the NEQ predicate on the "action_id" field.
+func ActionIDNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDIn applies the In predicate on the "action_id" field.
+func ActionIDIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDNotIn applies the NotIn predicate on the "action_id" field.
+func ActionIDNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDGT applies the GT predicate on the "action_id" field.
+func ActionIDGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDGTE applies the GTE predicate on the "action_id" field.
+func ActionIDGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLT applies the LT predicate on the "action_id" field.
+func ActionIDLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLTE applies the LTE predicate on the "action_id" field.
+func ActionIDLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContains applies the Contains predicate on the "action_id" field.
+func ActionIDContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasPrefix applies the HasPrefix predicate on the "action_id" field.
+func ActionIDHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasSuffix applies the HasSuffix predicate on the "action_id" field.
+func ActionIDHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDIsNil applies the IsNil predicate on the "action_id" field.
+func ActionIDIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldActionID)))
+	})
+}
+
+// ActionIDNotNil applies the NotNil predicate on the "action_id" field.
+func ActionIDNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldActionID)))
+	})
+}
+
+// ActionIDEqualFold applies the EqualFold predicate on the "action_id" field.
+func ActionIDEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContainsFold applies the ContainsFold predicate on the "action_id" field.
+func ActionIDContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActionID), v))
+	})
+}
+
+// AppIdsIsNil applies the IsNil predicate on the "app_ids" field.
+func AppIdsIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppIds)))
+	})
+}
+
+// AppIdsNotNil applies the NotNil predicate on the "app_ids" field.
+func AppIdsNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppIds)))
+	})
+}
+
+// AudienceSizeEQ applies the EQ predicate on the "audience_size" field.
+func AudienceSizeEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAudienceSize), v))
+	})
+}
+
+// AudienceSizeNEQ applies the NEQ predicate on the "audience_size" field.
+func AudienceSizeNEQ(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAudienceSize), v))
+	})
+}
+
+// AudienceSizeIn applies the In predicate on the "audience_size" field.
+func AudienceSizeIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAudienceSize), v...))
+	})
+}
+
+// AudienceSizeNotIn applies the NotIn predicate on the "audience_size" field.
+func AudienceSizeNotIn(vs ...int64) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAudienceSize), v...))
+	})
+}
+
+// AudienceSizeGT applies the GT predicate on the "audience_size" field.
+func AudienceSizeGT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAudienceSize), v))
+	})
+}
+
+// AudienceSizeGTE applies the GTE predicate on the "audience_size" field.
+func AudienceSizeGTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAudienceSize), v))
+	})
+}
+
+// AudienceSizeLT applies the LT predicate on the "audience_size" field.
+func AudienceSizeLT(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAudienceSize), v))
+	})
+}
+
+// AudienceSizeLTE applies the LTE predicate on the "audience_size" field.
+func AudienceSizeLTE(v int64) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAudienceSize), v))
+	})
+}
+
+// MessageSummaryEQ applies the EQ predicate on the "message_summary" field.
+func MessageSummaryEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryNEQ applies the NEQ predicate on the "message_summary" field.
+func MessageSummaryNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryIn applies the In predicate on the "message_summary" field.
+func MessageSummaryIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMessageSummary), v...))
+	})
+}
+
+// MessageSummaryNotIn applies the NotIn predicate on the "message_summary" field.
+func MessageSummaryNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMessageSummary), v...))
+	})
+}
+
+// MessageSummaryGT applies the GT predicate on the "message_summary" field.
+func MessageSummaryGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryGTE applies the GTE predicate on the "message_summary" field.
+func MessageSummaryGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryLT applies the LT predicate on the "message_summary" field.
+func MessageSummaryLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryLTE applies the LTE predicate on the "message_summary" field.
+func MessageSummaryLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryContains applies the Contains predicate on the "message_summary" field.
+func MessageSummaryContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryHasPrefix applies the HasPrefix predicate on the "message_summary" field.
+func MessageSummaryHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryHasSuffix applies the HasSuffix predicate on the "message_summary" field.
+func MessageSummaryHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryIsNil applies the IsNil predicate on the "message_summary" field.
+func MessageSummaryIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMessageSummary)))
+	})
+}
+
+// MessageSummaryNotNil applies the NotNil predicate on the "message_summary" field.
+func MessageSummaryNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMessageSummary)))
+	})
+}
+
+// MessageSummaryEqualFold applies the EqualFold predicate on the "message_summary" field.
+func MessageSummaryEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldMessageSummary), v))
+	})
+}
+
+// MessageSummaryContainsFold applies the ContainsFold predicate on the "message_summary" field.
+func MessageSummaryContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldMessageSummary), v))
+	})
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatusCode), v...))
+	})
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatusCode), v...))
+	})
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatusCode), v))
+	})
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatusCode), v))
+	})
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldResult), v))
+	})
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldResult), v))
+	})
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldResult), v...))
+	})
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldResult), v...))
+	})
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldResult), v))
+	})
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldResult), v))
+	})
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldResult), v))
+	})
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldResult), v))
+	})
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldResult), v))
+	})
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldResult), v))
+	})
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldResult), v))
+	})
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldResult)))
+	})
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldResult)))
+	})
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldResult), v))
+	})
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldResult), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditLog {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditLog(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditLog) predicate.AuditLog {
+	return predicate.AuditLog(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
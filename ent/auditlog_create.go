@@ -0,0 +1,533 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetCallerKeyID sets the "caller_key_id" field.
+func (alc *AuditLogCreate) SetCallerKeyID(i int) *AuditLogCreate {
+	alc.mutation.SetCallerKeyID(i)
+	return alc
+}
+
+// SetNillableCallerKeyID sets the "caller_key_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCallerKeyID(i *int) *AuditLogCreate {
+	if i != nil {
+		alc.SetCallerKeyID(*i)
+	}
+	return alc
+}
+
+// SetCallerName sets the "caller_name" field.
+func (alc *AuditLogCreate) SetCallerName(s string) *AuditLogCreate {
+	alc.mutation.SetCallerName(s)
+	return alc
+}
+
+// SetCallerUserID sets the "caller_user_id" field.
+func (alc *AuditLogCreate) SetCallerUserID(s string) *AuditLogCreate {
+	alc.mutation.SetCallerUserID(s)
+	return alc
+}
+
+// SetNillableCallerUserID sets the "caller_user_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCallerUserID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetCallerUserID(*s)
+	}
+	return alc
+}
+
+// SetRemoteAddr sets the "remote_addr" field.
+func (alc *AuditLogCreate) SetRemoteAddr(s string) *AuditLogCreate {
+	alc.mutation.SetRemoteAddr(s)
+	return alc
+}
+
+// SetNillableRemoteAddr sets the "remote_addr" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRemoteAddr(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetRemoteAddr(*s)
+	}
+	return alc
+}
+
+// SetMethod sets the "method" field.
+func (alc *AuditLogCreate) SetMethod(s string) *AuditLogCreate {
+	alc.mutation.SetMethod(s)
+	return alc
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (alc *AuditLogCreate) SetEndpoint(s string) *AuditLogCreate {
+	alc.mutation.SetEndpoint(s)
+	return alc
+}
+
+// SetOperation sets the "operation" field.
+func (alc *AuditLogCreate) SetOperation(s string) *AuditLogCreate {
+	alc.mutation.SetOperation(s)
+	return alc
+}
+
+// SetTarget sets the "target" field.
+func (alc *AuditLogCreate) SetTarget(s string) *AuditLogCreate {
+	alc.mutation.SetTarget(s)
+	return alc
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTarget(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTarget(*s)
+	}
+	return alc
+}
+
+// SetActionID sets the "action_id" field.
+func (alc *AuditLogCreate) SetActionID(s string) *AuditLogCreate {
+	alc.mutation.SetActionID(s)
+	return alc
+}
+
+// SetNillableActionID sets the "action_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActionID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetActionID(*s)
+	}
+	return alc
+}
+
+// SetAppIds sets the "app_ids" field.
+func (alc *AuditLogCreate) SetAppIds(s []string) *AuditLogCreate {
+	alc.mutation.SetAppIds(s)
+	return alc
+}
+
+// SetAudienceSize sets the "audience_size" field.
+func (alc *AuditLogCreate) SetAudienceSize(i int64) *AuditLogCreate {
+	alc.mutation.SetAudienceSize(i)
+	return alc
+}
+
+// SetNillableAudienceSize sets the "audience_size" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableAudienceSize(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetAudienceSize(*i)
+	}
+	return alc
+}
+
+// SetMessageSummary sets the "message_summary" field.
+func (alc *AuditLogCreate) SetMessageSummary(s string) *AuditLogCreate {
+	alc.mutation.SetMessageSummary(s)
+	return alc
+}
+
+// SetNillableMessageSummary sets the "message_summary" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableMessageSummary(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetMessageSummary(*s)
+	}
+	return alc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (alc *AuditLogCreate) SetStatusCode(i int) *AuditLogCreate {
+	alc.mutation.SetStatusCode(i)
+	return alc
+}
+
+// SetResult sets the "result" field.
+func (alc *AuditLogCreate) SetResult(s string) *AuditLogCreate {
+	alc.mutation.SetResult(s)
+	return alc
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableResult(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetResult(*s)
+	}
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	alc.defaults()
+	if len(alc.hooks) == 0 {
+		if err = alc.check(); err != nil {
+			return nil, err
+		}
+		node, err = alc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alc.check(); err != nil {
+				return nil, err
+			}
+			alc.mutation = mutation
+			if node, err = alc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(alc.hooks) - 1; i >= 0; i-- {
+			if alc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CallerKeyID(); !ok {
+		v := auditlog.DefaultCallerKeyID
+		alc.mutation.SetCallerKeyID(v)
+	}
+	if _, ok := alc.mutation.AudienceSize(); !ok {
+		v := auditlog.DefaultAudienceSize
+		alc.mutation.SetAudienceSize(v)
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CallerKeyID(); !ok {
+		return &ValidationError{Name: "caller_key_id", err: errors.New(`ent: missing required field "AuditLog.caller_key_id"`)}
+	}
+	if _, ok := alc.mutation.CallerName(); !ok {
+		return &ValidationError{Name: "caller_name", err: errors.New(`ent: missing required field "AuditLog.caller_name"`)}
+	}
+	if _, ok := alc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "AuditLog.method"`)}
+	}
+	if _, ok := alc.mutation.Endpoint(); !ok {
+		return &ValidationError{Name: "endpoint", err: errors.New(`ent: missing required field "AuditLog.endpoint"`)}
+	}
+	if _, ok := alc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "AuditLog.operation"`)}
+	}
+	if _, ok := alc.mutation.AudienceSize(); !ok {
+		return &ValidationError{Name: "audience_size", err: errors.New(`ent: missing required field "AuditLog.audience_size"`)}
+	}
+	if v, ok := alc.mutation.MessageSummary(); ok {
+		if err := auditlog.MessageSummaryValidator(v); err != nil {
+			return &ValidationError{Name: "message_summary", err: fmt.Errorf(`ent: validator failed for field "AuditLog.message_summary": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.StatusCode(); !ok {
+		return &ValidationError{Name: "status_code", err: errors.New(`ent: missing required field "AuditLog.status_code"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditlog.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		}
+	)
+	if value, ok := alc.mutation.CallerKeyID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldCallerKeyID,
+		})
+		_node.CallerKeyID = value
+	}
+	if value, ok := alc.mutation.CallerName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerName,
+		})
+		_node.CallerName = value
+	}
+	if value, ok := alc.mutation.CallerUserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerUserID,
+		})
+		_node.CallerUserID = value
+	}
+	if value, ok := alc.mutation.RemoteAddr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRemoteAddr,
+		})
+		_node.RemoteAddr = value
+	}
+	if value, ok := alc.mutation.Method(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMethod,
+		})
+		_node.Method = value
+	}
+	if value, ok := alc.mutation.Endpoint(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEndpoint,
+		})
+		_node.Endpoint = value
+	}
+	if value, ok := alc.mutation.Operation(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOperation,
+		})
+		_node.Operation = value
+	}
+	if value, ok := alc.mutation.Target(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTarget,
+		})
+		_node.Target = value
+	}
+	if value, ok := alc.mutation.ActionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldActionID,
+		})
+		_node.ActionID = value
+	}
+	if value, ok := alc.mutation.AppIds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditlog.FieldAppIds,
+		})
+		_node.AppIds = value
+	}
+	if value, ok := alc.mutation.AudienceSize(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldAudienceSize,
+		})
+		_node.AudienceSize = value
+	}
+	if value, ok := alc.mutation.MessageSummary(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMessageSummary,
+		})
+		_node.MessageSummary = value
+	}
+	if value, ok := alc.mutation.StatusCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldStatusCode,
+		})
+		_node.StatusCode = value
+	}
+	if value, ok := alc.mutation.Result(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldResult,
+		})
+		_node.Result = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
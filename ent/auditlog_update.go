@@ -0,0 +1,1015 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/auditlog"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetCallerKeyID sets the "caller_key_id" field.
+func (alu *AuditLogUpdate) SetCallerKeyID(i int) *AuditLogUpdate {
+	alu.mutation.ResetCallerKeyID()
+	alu.mutation.SetCallerKeyID(i)
+	return alu
+}
+
+// SetNillableCallerKeyID sets the "caller_key_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCallerKeyID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetCallerKeyID(*i)
+	}
+	return alu
+}
+
+// AddCallerKeyID adds i to the "caller_key_id" field.
+func (alu *AuditLogUpdate) AddCallerKeyID(i int) *AuditLogUpdate {
+	alu.mutation.AddCallerKeyID(i)
+	return alu
+}
+
+// SetCallerName sets the "caller_name" field.
+func (alu *AuditLogUpdate) SetCallerName(s string) *AuditLogUpdate {
+	alu.mutation.SetCallerName(s)
+	return alu
+}
+
+// SetCallerUserID sets the "caller_user_id" field.
+func (alu *AuditLogUpdate) SetCallerUserID(s string) *AuditLogUpdate {
+	alu.mutation.SetCallerUserID(s)
+	return alu
+}
+
+// SetNillableCallerUserID sets the "caller_user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCallerUserID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetCallerUserID(*s)
+	}
+	return alu
+}
+
+// ClearCallerUserID clears the value of the "caller_user_id" field.
+func (alu *AuditLogUpdate) ClearCallerUserID() *AuditLogUpdate {
+	alu.mutation.ClearCallerUserID()
+	return alu
+}
+
+// SetRemoteAddr sets the "remote_addr" field.
+func (alu *AuditLogUpdate) SetRemoteAddr(s string) *AuditLogUpdate {
+	alu.mutation.SetRemoteAddr(s)
+	return alu
+}
+
+// SetNillableRemoteAddr sets the "remote_addr" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRemoteAddr(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetRemoteAddr(*s)
+	}
+	return alu
+}
+
+// ClearRemoteAddr clears the value of the "remote_addr" field.
+func (alu *AuditLogUpdate) ClearRemoteAddr() *AuditLogUpdate {
+	alu.mutation.ClearRemoteAddr()
+	return alu
+}
+
+// SetMethod sets the "method" field.
+func (alu *AuditLogUpdate) SetMethod(s string) *AuditLogUpdate {
+	alu.mutation.SetMethod(s)
+	return alu
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (alu *AuditLogUpdate) SetEndpoint(s string) *AuditLogUpdate {
+	alu.mutation.SetEndpoint(s)
+	return alu
+}
+
+// SetOperation sets the "operation" field.
+func (alu *AuditLogUpdate) SetOperation(s string) *AuditLogUpdate {
+	alu.mutation.SetOperation(s)
+	return alu
+}
+
+// SetTarget sets the "target" field.
+func (alu *AuditLogUpdate) SetTarget(s string) *AuditLogUpdate {
+	alu.mutation.SetTarget(s)
+	return alu
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTarget(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTarget(*s)
+	}
+	return alu
+}
+
+// ClearTarget clears the value of the "target" field.
+func (alu *AuditLogUpdate) ClearTarget() *AuditLogUpdate {
+	alu.mutation.ClearTarget()
+	return alu
+}
+
+// SetActionID sets the "action_id" field.
+func (alu *AuditLogUpdate) SetActionID(s string) *AuditLogUpdate {
+	alu.mutation.SetActionID(s)
+	return alu
+}
+
+// SetNillableActionID sets the "action_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActionID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetActionID(*s)
+	}
+	return alu
+}
+
+// ClearActionID clears the value of the "action_id" field.
+func (alu *AuditLogUpdate) ClearActionID() *AuditLogUpdate {
+	alu.mutation.ClearActionID()
+	return alu
+}
+
+// SetAppIds sets the "app_ids" field.
+func (alu *AuditLogUpdate) SetAppIds(s []string) *AuditLogUpdate {
+	alu.mutation.SetAppIds(s)
+	return alu
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (alu *AuditLogUpdate) ClearAppIds() *AuditLogUpdate {
+	alu.mutation.ClearAppIds()
+	return alu
+}
+
+// SetAudienceSize sets the "audience_size" field.
+func (alu *AuditLogUpdate) SetAudienceSize(i int64) *AuditLogUpdate {
+	alu.mutation.ResetAudienceSize()
+	alu.mutation.SetAudienceSize(i)
+	return alu
+}
+
+// SetNillableAudienceSize sets the "audience_size" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAudienceSize(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetAudienceSize(*i)
+	}
+	return alu
+}
+
+// AddAudienceSize adds i to the "audience_size" field.
+func (alu *AuditLogUpdate) AddAudienceSize(i int64) *AuditLogUpdate {
+	alu.mutation.AddAudienceSize(i)
+	return alu
+}
+
+// SetMessageSummary sets the "message_summary" field.
+func (alu *AuditLogUpdate) SetMessageSummary(s string) *AuditLogUpdate {
+	alu.mutation.SetMessageSummary(s)
+	return alu
+}
+
+// SetNillableMessageSummary sets the "message_summary" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableMessageSummary(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetMessageSummary(*s)
+	}
+	return alu
+}
+
+// ClearMessageSummary clears the value of the "message_summary" field.
+func (alu *AuditLogUpdate) ClearMessageSummary() *AuditLogUpdate {
+	alu.mutation.ClearMessageSummary()
+	return alu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (alu *AuditLogUpdate) SetStatusCode(i int) *AuditLogUpdate {
+	alu.mutation.ResetStatusCode()
+	alu.mutation.SetStatusCode(i)
+	return alu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (alu *AuditLogUpdate) AddStatusCode(i int) *AuditLogUpdate {
+	alu.mutation.AddStatusCode(i)
+	return alu
+}
+
+// SetResult sets the "result" field.
+func (alu *AuditLogUpdate) SetResult(s string) *AuditLogUpdate {
+	alu.mutation.SetResult(s)
+	return alu
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableResult(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetResult(*s)
+	}
+	return alu
+}
+
+// ClearResult clears the value of the "result" field.
+func (alu *AuditLogUpdate) ClearResult() *AuditLogUpdate {
+	alu.mutation.ClearResult()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(alu.hooks) == 0 {
+		if err = alu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = alu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = alu.check(); err != nil {
+				return 0, err
+			}
+			alu.mutation = mutation
+			affected, err = alu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(alu.hooks) - 1; i >= 0; i-- {
+			if alu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = alu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alu *AuditLogUpdate) check() error {
+	if v, ok := alu.mutation.MessageSummary(); ok {
+		if err := auditlog.MessageSummaryValidator(v); err != nil {
+			return &ValidationError{Name: "message_summary", err: fmt.Errorf(`ent: validator failed for field "AuditLog.message_summary": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.CallerKeyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldCallerKeyID,
+		})
+	}
+	if value, ok := alu.mutation.AddedCallerKeyID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldCallerKeyID,
+		})
+	}
+	if value, ok := alu.mutation.CallerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerName,
+		})
+	}
+	if value, ok := alu.mutation.CallerUserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerUserID,
+		})
+	}
+	if alu.mutation.CallerUserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldCallerUserID,
+		})
+	}
+	if value, ok := alu.mutation.RemoteAddr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRemoteAddr,
+		})
+	}
+	if alu.mutation.RemoteAddrCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRemoteAddr,
+		})
+	}
+	if value, ok := alu.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMethod,
+		})
+	}
+	if value, ok := alu.mutation.Endpoint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEndpoint,
+		})
+	}
+	if value, ok := alu.mutation.Operation(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOperation,
+		})
+	}
+	if value, ok := alu.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTarget,
+		})
+	}
+	if alu.mutation.TargetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldTarget,
+		})
+	}
+	if value, ok := alu.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldActionID,
+		})
+	}
+	if alu.mutation.ActionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldActionID,
+		})
+	}
+	if value, ok := alu.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditlog.FieldAppIds,
+		})
+	}
+	if alu.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditlog.FieldAppIds,
+		})
+	}
+	if value, ok := alu.mutation.AudienceSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldAudienceSize,
+		})
+	}
+	if value, ok := alu.mutation.AddedAudienceSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldAudienceSize,
+		})
+	}
+	if value, ok := alu.mutation.MessageSummary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMessageSummary,
+		})
+	}
+	if alu.mutation.MessageSummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldMessageSummary,
+		})
+	}
+	if value, ok := alu.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldStatusCode,
+		})
+	}
+	if value, ok := alu.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldStatusCode,
+		})
+	}
+	if value, ok := alu.mutation.Result(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldResult,
+		})
+	}
+	if alu.mutation.ResultCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldResult,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetCallerKeyID sets the "caller_key_id" field.
+func (aluo *AuditLogUpdateOne) SetCallerKeyID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetCallerKeyID()
+	aluo.mutation.SetCallerKeyID(i)
+	return aluo
+}
+
+// SetNillableCallerKeyID sets the "caller_key_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCallerKeyID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetCallerKeyID(*i)
+	}
+	return aluo
+}
+
+// AddCallerKeyID adds i to the "caller_key_id" field.
+func (aluo *AuditLogUpdateOne) AddCallerKeyID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddCallerKeyID(i)
+	return aluo
+}
+
+// SetCallerName sets the "caller_name" field.
+func (aluo *AuditLogUpdateOne) SetCallerName(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetCallerName(s)
+	return aluo
+}
+
+// SetCallerUserID sets the "caller_user_id" field.
+func (aluo *AuditLogUpdateOne) SetCallerUserID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetCallerUserID(s)
+	return aluo
+}
+
+// SetNillableCallerUserID sets the "caller_user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCallerUserID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetCallerUserID(*s)
+	}
+	return aluo
+}
+
+// ClearCallerUserID clears the value of the "caller_user_id" field.
+func (aluo *AuditLogUpdateOne) ClearCallerUserID() *AuditLogUpdateOne {
+	aluo.mutation.ClearCallerUserID()
+	return aluo
+}
+
+// SetRemoteAddr sets the "remote_addr" field.
+func (aluo *AuditLogUpdateOne) SetRemoteAddr(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetRemoteAddr(s)
+	return aluo
+}
+
+// SetNillableRemoteAddr sets the "remote_addr" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRemoteAddr(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetRemoteAddr(*s)
+	}
+	return aluo
+}
+
+// ClearRemoteAddr clears the value of the "remote_addr" field.
+func (aluo *AuditLogUpdateOne) ClearRemoteAddr() *AuditLogUpdateOne {
+	aluo.mutation.ClearRemoteAddr()
+	return aluo
+}
+
+// SetMethod sets the "method" field.
+func (aluo *AuditLogUpdateOne) SetMethod(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetMethod(s)
+	return aluo
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (aluo *AuditLogUpdateOne) SetEndpoint(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEndpoint(s)
+	return aluo
+}
+
+// SetOperation sets the "operation" field.
+func (aluo *AuditLogUpdateOne) SetOperation(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetOperation(s)
+	return aluo
+}
+
+// SetTarget sets the "target" field.
+func (aluo *AuditLogUpdateOne) SetTarget(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTarget(s)
+	return aluo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTarget(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTarget(*s)
+	}
+	return aluo
+}
+
+// ClearTarget clears the value of the "target" field.
+func (aluo *AuditLogUpdateOne) ClearTarget() *AuditLogUpdateOne {
+	aluo.mutation.ClearTarget()
+	return aluo
+}
+
+// SetActionID sets the "action_id" field.
+func (aluo *AuditLogUpdateOne) SetActionID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetActionID(s)
+	return aluo
+}
+
+// SetNillableActionID sets the "action_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActionID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetActionID(*s)
+	}
+	return aluo
+}
+
+// ClearActionID clears the value of the "action_id" field.
+func (aluo *AuditLogUpdateOne) ClearActionID() *AuditLogUpdateOne {
+	aluo.mutation.ClearActionID()
+	return aluo
+}
+
+// SetAppIds sets the "app_ids" field.
+func (aluo *AuditLogUpdateOne) SetAppIds(s []string) *AuditLogUpdateOne {
+	aluo.mutation.SetAppIds(s)
+	return aluo
+}
+
+// ClearAppIds clears the value of the "app_ids" field.
+func (aluo *AuditLogUpdateOne) ClearAppIds() *AuditLogUpdateOne {
+	aluo.mutation.ClearAppIds()
+	return aluo
+}
+
+// SetAudienceSize sets the "audience_size" field.
+func (aluo *AuditLogUpdateOne) SetAudienceSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetAudienceSize()
+	aluo.mutation.SetAudienceSize(i)
+	return aluo
+}
+
+// SetNillableAudienceSize sets the "audience_size" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAudienceSize(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetAudienceSize(*i)
+	}
+	return aluo
+}
+
+// AddAudienceSize adds i to the "audience_size" field.
+func (aluo *AuditLogUpdateOne) AddAudienceSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddAudienceSize(i)
+	return aluo
+}
+
+// SetMessageSummary sets the "message_summary" field.
+func (aluo *AuditLogUpdateOne) SetMessageSummary(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetMessageSummary(s)
+	return aluo
+}
+
+// SetNillableMessageSummary sets the "message_summary" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableMessageSummary(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetMessageSummary(*s)
+	}
+	return aluo
+}
+
+// ClearMessageSummary clears the value of the "message_summary" field.
+func (aluo *AuditLogUpdateOne) ClearMessageSummary() *AuditLogUpdateOne {
+	aluo.mutation.ClearMessageSummary()
+	return aluo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (aluo *AuditLogUpdateOne) SetStatusCode(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetStatusCode()
+	aluo.mutation.SetStatusCode(i)
+	return aluo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (aluo *AuditLogUpdateOne) AddStatusCode(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddStatusCode(i)
+	return aluo
+}
+
+// SetResult sets the "result" field.
+func (aluo *AuditLogUpdateOne) SetResult(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetResult(s)
+	return aluo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableResult(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetResult(*s)
+	}
+	return aluo
+}
+
+// ClearResult clears the value of the "result" field.
+func (aluo *AuditLogUpdateOne) ClearResult() *AuditLogUpdateOne {
+	aluo.mutation.ClearResult()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	var (
+		err  error
+		node *AuditLog
+	)
+	if len(aluo.hooks) == 0 {
+		if err = aluo.check(); err != nil {
+			return nil, err
+		}
+		node, err = aluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aluo.check(); err != nil {
+				return nil, err
+			}
+			aluo.mutation = mutation
+			node, err = aluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aluo.hooks) - 1; i >= 0; i-- {
+			if aluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aluo *AuditLogUpdateOne) check() error {
+	if v, ok := aluo.mutation.MessageSummary(); ok {
+		if err := auditlog.MessageSummaryValidator(v); err != nil {
+			return &ValidationError{Name: "message_summary", err: fmt.Errorf(`ent: validator failed for field "AuditLog.message_summary": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.CallerKeyID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldCallerKeyID,
+		})
+	}
+	if value, ok := aluo.mutation.AddedCallerKeyID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldCallerKeyID,
+		})
+	}
+	if value, ok := aluo.mutation.CallerName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerName,
+		})
+	}
+	if value, ok := aluo.mutation.CallerUserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldCallerUserID,
+		})
+	}
+	if aluo.mutation.CallerUserIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldCallerUserID,
+		})
+	}
+	if value, ok := aluo.mutation.RemoteAddr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRemoteAddr,
+		})
+	}
+	if aluo.mutation.RemoteAddrCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRemoteAddr,
+		})
+	}
+	if value, ok := aluo.mutation.Method(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMethod,
+		})
+	}
+	if value, ok := aluo.mutation.Endpoint(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldEndpoint,
+		})
+	}
+	if value, ok := aluo.mutation.Operation(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldOperation,
+		})
+	}
+	if value, ok := aluo.mutation.Target(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldTarget,
+		})
+	}
+	if aluo.mutation.TargetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldTarget,
+		})
+	}
+	if value, ok := aluo.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldActionID,
+		})
+	}
+	if aluo.mutation.ActionIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldActionID,
+		})
+	}
+	if value, ok := aluo.mutation.AppIds(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditlog.FieldAppIds,
+		})
+	}
+	if aluo.mutation.AppIdsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditlog.FieldAppIds,
+		})
+	}
+	if value, ok := aluo.mutation.AudienceSize(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldAudienceSize,
+		})
+	}
+	if value, ok := aluo.mutation.AddedAudienceSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: auditlog.FieldAudienceSize,
+		})
+	}
+	if value, ok := aluo.mutation.MessageSummary(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldMessageSummary,
+		})
+	}
+	if aluo.mutation.MessageSummaryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldMessageSummary,
+		})
+	}
+	if value, ok := aluo.mutation.StatusCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldStatusCode,
+		})
+	}
+	if value, ok := aluo.mutation.AddedStatusCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditlog.FieldStatusCode,
+		})
+	}
+	if value, ok := aluo.mutation.Result(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldResult,
+		})
+	}
+	if aluo.mutation.ResultCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldResult,
+		})
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -9,8 +9,19 @@ import (
 
 	"github.com/shitamachi/push-service/ent/migrate"
 
+	"github.com/shitamachi/push-service/ent/apikey"
+	"github.com/shitamachi/push-service/ent/app"
+	"github.com/shitamachi/push-service/ent/audience"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+	"github.com/shitamachi/push-service/ent/auditlog"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/ent/pushevent"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
 	"github.com/shitamachi/push-service/ent/userplatformtokens"
 	"github.com/shitamachi/push-service/ent/userpushtoken"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+	"github.com/shitamachi/push-service/ent/webhookdelivery"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -21,10 +32,32 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// App is the client for interacting with the App builders.
+	App *AppClient
+	// Audience is the client for interacting with the Audience builders.
+	Audience *AudienceClient
+	// AudienceMember is the client for interacting with the AudienceMember builders.
+	AudienceMember *AudienceMemberClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// DeliveryResult is the client for interacting with the DeliveryResult builders.
+	DeliveryResult *DeliveryResultClient
+	// PushEvent is the client for interacting with the PushEvent builders.
+	PushEvent *PushEventClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// UserPlatformTokens is the client for interacting with the UserPlatformTokens builders.
 	UserPlatformTokens *UserPlatformTokensClient
 	// UserPushToken is the client for interacting with the UserPushToken builders.
 	UserPushToken *UserPushTokenClient
+	// UserQuietHours is the client for interacting with the UserQuietHours builders.
+	UserQuietHours *UserQuietHoursClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -38,8 +71,19 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiKey = NewApiKeyClient(c.config)
+	c.App = NewAppClient(c.config)
+	c.Audience = NewAudienceClient(c.config)
+	c.AudienceMember = NewAudienceMemberClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.DeliveryResult = NewDeliveryResultClient(c.config)
+	c.PushEvent = NewPushEventClient(c.config)
+	c.UserNotificationPreference = NewUserNotificationPreferenceClient(c.config)
 	c.UserPlatformTokens = NewUserPlatformTokensClient(c.config)
 	c.UserPushToken = NewUserPushTokenClient(c.config)
+	c.UserQuietHours = NewUserQuietHoursClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -71,10 +115,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                ctx,
-		config:             cfg,
-		UserPlatformTokens: NewUserPlatformTokensClient(cfg),
-		UserPushToken:      NewUserPushTokenClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		ApiKey:                     NewApiKeyClient(cfg),
+		App:                        NewAppClient(cfg),
+		Audience:                   NewAudienceClient(cfg),
+		AudienceMember:             NewAudienceMemberClient(cfg),
+		AuditLog:                   NewAuditLogClient(cfg),
+		DeliveryResult:             NewDeliveryResultClient(cfg),
+		PushEvent:                  NewPushEventClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		UserPlatformTokens:         NewUserPlatformTokensClient(cfg),
+		UserPushToken:              NewUserPushTokenClient(cfg),
+		UserQuietHours:             NewUserQuietHoursClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:        NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -92,40 +147,782 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config:             cfg,
-		UserPlatformTokens: NewUserPlatformTokensClient(cfg),
-		UserPushToken:      NewUserPushTokenClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		ApiKey:                     NewApiKeyClient(cfg),
+		App:                        NewAppClient(cfg),
+		Audience:                   NewAudienceClient(cfg),
+		AudienceMember:             NewAudienceMemberClient(cfg),
+		AuditLog:                   NewAuditLogClient(cfg),
+		DeliveryResult:             NewDeliveryResultClient(cfg),
+		PushEvent:                  NewPushEventClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		UserPlatformTokens:         NewUserPlatformTokensClient(cfg),
+		UserPushToken:              NewUserPushTokenClient(cfg),
+		UserQuietHours:             NewUserQuietHoursClient(cfg),
+		WebhookDelivery:            NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:        NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		UserPlatformTokens.
+//		ApiKey.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := c.config
-	cfg.driver = dialect.Debug(c.driver, c.log)
-	client := &Client{config: cfg}
-	client.init()
-	return client
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.ApiKey.Use(hooks...)
+	c.App.Use(hooks...)
+	c.Audience.Use(hooks...)
+	c.AudienceMember.Use(hooks...)
+	c.AuditLog.Use(hooks...)
+	c.DeliveryResult.Use(hooks...)
+	c.PushEvent.Use(hooks...)
+	c.UserNotificationPreference.Use(hooks...)
+	c.UserPlatformTokens.Use(hooks...)
+	c.UserPushToken.Use(hooks...)
+	c.UserQuietHours.Use(hooks...)
+	c.WebhookDelivery.Use(hooks...)
+	c.WebhookSubscription.Use(hooks...)
+}
+
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
+	config
+}
+
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
+}
+
+// Create returns a create builder for ApiKey.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiKeyClient) UpdateOne(ak *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(ak))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiKeyClient) UpdateOneID(id int) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ApiKeyClient) DeleteOne(ak *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ApiKeyClient) DeleteOneID(id int) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id int) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiKeyClient) GetX(ctx context.Context, id int) *ApiKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
+}
+
+// AppClient is a client for the App schema.
+type AppClient struct {
+	config
+}
+
+// NewAppClient returns a client for the App from the given config.
+func NewAppClient(c config) *AppClient {
+	return &AppClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `app.Hooks(f(g(h())))`.
+func (c *AppClient) Use(hooks ...Hook) {
+	c.hooks.App = append(c.hooks.App, hooks...)
+}
+
+// Create returns a create builder for App.
+func (c *AppClient) Create() *AppCreate {
+	mutation := newAppMutation(c.config, OpCreate)
+	return &AppCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of App entities.
+func (c *AppClient) CreateBulk(builders ...*AppCreate) *AppCreateBulk {
+	return &AppCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for App.
+func (c *AppClient) Update() *AppUpdate {
+	mutation := newAppMutation(c.config, OpUpdate)
+	return &AppUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AppClient) UpdateOne(a *App) *AppUpdateOne {
+	mutation := newAppMutation(c.config, OpUpdateOne, withApp(a))
+	return &AppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AppClient) UpdateOneID(id int) *AppUpdateOne {
+	mutation := newAppMutation(c.config, OpUpdateOne, withAppID(id))
+	return &AppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for App.
+func (c *AppClient) Delete() *AppDelete {
+	mutation := newAppMutation(c.config, OpDelete)
+	return &AppDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AppClient) DeleteOne(a *App) *AppDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AppClient) DeleteOneID(id int) *AppDeleteOne {
+	builder := c.Delete().Where(app.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AppDeleteOne{builder}
+}
+
+// Query returns a query builder for App.
+func (c *AppClient) Query() *AppQuery {
+	return &AppQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a App entity by its id.
+func (c *AppClient) Get(ctx context.Context, id int) (*App, error) {
+	return c.Query().Where(app.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AppClient) GetX(ctx context.Context, id int) *App {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AppClient) Hooks() []Hook {
+	return c.hooks.App
+}
+
+// AudienceClient is a client for the Audience schema.
+type AudienceClient struct {
+	config
+}
+
+// NewAudienceClient returns a client for the Audience from the given config.
+func NewAudienceClient(c config) *AudienceClient {
+	return &AudienceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `audience.Hooks(f(g(h())))`.
+func (c *AudienceClient) Use(hooks ...Hook) {
+	c.hooks.Audience = append(c.hooks.Audience, hooks...)
+}
+
+// Create returns a create builder for Audience.
+func (c *AudienceClient) Create() *AudienceCreate {
+	mutation := newAudienceMutation(c.config, OpCreate)
+	return &AudienceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Audience entities.
+func (c *AudienceClient) CreateBulk(builders ...*AudienceCreate) *AudienceCreateBulk {
+	return &AudienceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Audience.
+func (c *AudienceClient) Update() *AudienceUpdate {
+	mutation := newAudienceMutation(c.config, OpUpdate)
+	return &AudienceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AudienceClient) UpdateOne(a *Audience) *AudienceUpdateOne {
+	mutation := newAudienceMutation(c.config, OpUpdateOne, withAudience(a))
+	return &AudienceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AudienceClient) UpdateOneID(id int) *AudienceUpdateOne {
+	mutation := newAudienceMutation(c.config, OpUpdateOne, withAudienceID(id))
+	return &AudienceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Audience.
+func (c *AudienceClient) Delete() *AudienceDelete {
+	mutation := newAudienceMutation(c.config, OpDelete)
+	return &AudienceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AudienceClient) DeleteOne(a *Audience) *AudienceDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AudienceClient) DeleteOneID(id int) *AudienceDeleteOne {
+	builder := c.Delete().Where(audience.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AudienceDeleteOne{builder}
+}
+
+// Query returns a query builder for Audience.
+func (c *AudienceClient) Query() *AudienceQuery {
+	return &AudienceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Audience entity by its id.
+func (c *AudienceClient) Get(ctx context.Context, id int) (*Audience, error) {
+	return c.Query().Where(audience.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AudienceClient) GetX(ctx context.Context, id int) *Audience {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AudienceClient) Hooks() []Hook {
+	return c.hooks.Audience
+}
+
+// AudienceMemberClient is a client for the AudienceMember schema.
+type AudienceMemberClient struct {
+	config
+}
+
+// NewAudienceMemberClient returns a client for the AudienceMember from the given config.
+func NewAudienceMemberClient(c config) *AudienceMemberClient {
+	return &AudienceMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `audiencemember.Hooks(f(g(h())))`.
+func (c *AudienceMemberClient) Use(hooks ...Hook) {
+	c.hooks.AudienceMember = append(c.hooks.AudienceMember, hooks...)
+}
+
+// Create returns a create builder for AudienceMember.
+func (c *AudienceMemberClient) Create() *AudienceMemberCreate {
+	mutation := newAudienceMemberMutation(c.config, OpCreate)
+	return &AudienceMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AudienceMember entities.
+func (c *AudienceMemberClient) CreateBulk(builders ...*AudienceMemberCreate) *AudienceMemberCreateBulk {
+	return &AudienceMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AudienceMember.
+func (c *AudienceMemberClient) Update() *AudienceMemberUpdate {
+	mutation := newAudienceMemberMutation(c.config, OpUpdate)
+	return &AudienceMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AudienceMemberClient) UpdateOne(am *AudienceMember) *AudienceMemberUpdateOne {
+	mutation := newAudienceMemberMutation(c.config, OpUpdateOne, withAudienceMember(am))
+	return &AudienceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AudienceMemberClient) UpdateOneID(id int) *AudienceMemberUpdateOne {
+	mutation := newAudienceMemberMutation(c.config, OpUpdateOne, withAudienceMemberID(id))
+	return &AudienceMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AudienceMember.
+func (c *AudienceMemberClient) Delete() *AudienceMemberDelete {
+	mutation := newAudienceMemberMutation(c.config, OpDelete)
+	return &AudienceMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AudienceMemberClient) DeleteOne(am *AudienceMember) *AudienceMemberDeleteOne {
+	return c.DeleteOneID(am.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AudienceMemberClient) DeleteOneID(id int) *AudienceMemberDeleteOne {
+	builder := c.Delete().Where(audiencemember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AudienceMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for AudienceMember.
+func (c *AudienceMemberClient) Query() *AudienceMemberQuery {
+	return &AudienceMemberQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AudienceMember entity by its id.
+func (c *AudienceMemberClient) Get(ctx context.Context, id int) (*AudienceMember, error) {
+	return c.Query().Where(audiencemember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AudienceMemberClient) GetX(ctx context.Context, id int) *AudienceMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AudienceMemberClient) Hooks() []Hook {
+	return c.hooks.AudienceMember
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Create returns a create builder for AuditLog.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// DeliveryResultClient is a client for the DeliveryResult schema.
+type DeliveryResultClient struct {
+	config
+}
+
+// NewDeliveryResultClient returns a client for the DeliveryResult from the given config.
+func NewDeliveryResultClient(c config) *DeliveryResultClient {
+	return &DeliveryResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deliveryresult.Hooks(f(g(h())))`.
+func (c *DeliveryResultClient) Use(hooks ...Hook) {
+	c.hooks.DeliveryResult = append(c.hooks.DeliveryResult, hooks...)
+}
+
+// Create returns a create builder for DeliveryResult.
+func (c *DeliveryResultClient) Create() *DeliveryResultCreate {
+	mutation := newDeliveryResultMutation(c.config, OpCreate)
+	return &DeliveryResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeliveryResult entities.
+func (c *DeliveryResultClient) CreateBulk(builders ...*DeliveryResultCreate) *DeliveryResultCreateBulk {
+	return &DeliveryResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeliveryResult.
+func (c *DeliveryResultClient) Update() *DeliveryResultUpdate {
+	mutation := newDeliveryResultMutation(c.config, OpUpdate)
+	return &DeliveryResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeliveryResultClient) UpdateOne(dr *DeliveryResult) *DeliveryResultUpdateOne {
+	mutation := newDeliveryResultMutation(c.config, OpUpdateOne, withDeliveryResult(dr))
+	return &DeliveryResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeliveryResultClient) UpdateOneID(id int) *DeliveryResultUpdateOne {
+	mutation := newDeliveryResultMutation(c.config, OpUpdateOne, withDeliveryResultID(id))
+	return &DeliveryResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeliveryResult.
+func (c *DeliveryResultClient) Delete() *DeliveryResultDelete {
+	mutation := newDeliveryResultMutation(c.config, OpDelete)
+	return &DeliveryResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *DeliveryResultClient) DeleteOne(dr *DeliveryResult) *DeliveryResultDeleteOne {
+	return c.DeleteOneID(dr.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *DeliveryResultClient) DeleteOneID(id int) *DeliveryResultDeleteOne {
+	builder := c.Delete().Where(deliveryresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeliveryResultDeleteOne{builder}
+}
+
+// Query returns a query builder for DeliveryResult.
+func (c *DeliveryResultClient) Query() *DeliveryResultQuery {
+	return &DeliveryResultQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a DeliveryResult entity by its id.
+func (c *DeliveryResultClient) Get(ctx context.Context, id int) (*DeliveryResult, error) {
+	return c.Query().Where(deliveryresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeliveryResultClient) GetX(ctx context.Context, id int) *DeliveryResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// Close closes the database connection and prevents new queries from starting.
-func (c *Client) Close() error {
-	return c.driver.Close()
+// Hooks returns the client hooks.
+func (c *DeliveryResultClient) Hooks() []Hook {
+	return c.hooks.DeliveryResult
 }
 
-// Use adds the mutation hooks to all the entity clients.
-// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
-func (c *Client) Use(hooks ...Hook) {
-	c.UserPlatformTokens.Use(hooks...)
-	c.UserPushToken.Use(hooks...)
+// PushEventClient is a client for the PushEvent schema.
+type PushEventClient struct {
+	config
+}
+
+// NewPushEventClient returns a client for the PushEvent from the given config.
+func NewPushEventClient(c config) *PushEventClient {
+	return &PushEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushevent.Hooks(f(g(h())))`.
+func (c *PushEventClient) Use(hooks ...Hook) {
+	c.hooks.PushEvent = append(c.hooks.PushEvent, hooks...)
+}
+
+// Create returns a create builder for PushEvent.
+func (c *PushEventClient) Create() *PushEventCreate {
+	mutation := newPushEventMutation(c.config, OpCreate)
+	return &PushEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushEvent entities.
+func (c *PushEventClient) CreateBulk(builders ...*PushEventCreate) *PushEventCreateBulk {
+	return &PushEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushEvent.
+func (c *PushEventClient) Update() *PushEventUpdate {
+	mutation := newPushEventMutation(c.config, OpUpdate)
+	return &PushEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushEventClient) UpdateOne(pe *PushEvent) *PushEventUpdateOne {
+	mutation := newPushEventMutation(c.config, OpUpdateOne, withPushEvent(pe))
+	return &PushEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushEventClient) UpdateOneID(id int) *PushEventUpdateOne {
+	mutation := newPushEventMutation(c.config, OpUpdateOne, withPushEventID(id))
+	return &PushEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushEvent.
+func (c *PushEventClient) Delete() *PushEventDelete {
+	mutation := newPushEventMutation(c.config, OpDelete)
+	return &PushEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *PushEventClient) DeleteOne(pe *PushEvent) *PushEventDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *PushEventClient) DeleteOneID(id int) *PushEventDeleteOne {
+	builder := c.Delete().Where(pushevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PushEvent.
+func (c *PushEventClient) Query() *PushEventQuery {
+	return &PushEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PushEvent entity by its id.
+func (c *PushEventClient) Get(ctx context.Context, id int) (*PushEvent, error) {
+	return c.Query().Where(pushevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushEventClient) GetX(ctx context.Context, id int) *PushEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PushEventClient) Hooks() []Hook {
+	return c.hooks.PushEvent
+}
+
+// UserNotificationPreferenceClient is a client for the UserNotificationPreference schema.
+type UserNotificationPreferenceClient struct {
+	config
+}
+
+// NewUserNotificationPreferenceClient returns a client for the UserNotificationPreference from the given config.
+func NewUserNotificationPreferenceClient(c config) *UserNotificationPreferenceClient {
+	return &UserNotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usernotificationpreference.Hooks(f(g(h())))`.
+func (c *UserNotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.UserNotificationPreference = append(c.hooks.UserNotificationPreference, hooks...)
+}
+
+// Create returns a create builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Create() *UserNotificationPreferenceCreate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpCreate)
+	return &UserNotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserNotificationPreference entities.
+func (c *UserNotificationPreferenceClient) CreateBulk(builders ...*UserNotificationPreferenceCreate) *UserNotificationPreferenceCreateBulk {
+	return &UserNotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Update() *UserNotificationPreferenceUpdate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdate)
+	return &UserNotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserNotificationPreferenceClient) UpdateOne(unp *UserNotificationPreference) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreference(unp))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserNotificationPreferenceClient) UpdateOneID(id int) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreferenceID(id))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Delete() *UserNotificationPreferenceDelete {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpDelete)
+	return &UserNotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *UserNotificationPreferenceClient) DeleteOne(unp *UserNotificationPreference) *UserNotificationPreferenceDeleteOne {
+	return c.DeleteOneID(unp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *UserNotificationPreferenceClient) DeleteOneID(id int) *UserNotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(usernotificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserNotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Query() *UserNotificationPreferenceQuery {
+	return &UserNotificationPreferenceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a UserNotificationPreference entity by its id.
+func (c *UserNotificationPreferenceClient) Get(ctx context.Context, id int) (*UserNotificationPreference, error) {
+	return c.Query().Where(usernotificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserNotificationPreferenceClient) GetX(ctx context.Context, id int) *UserNotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserNotificationPreferenceClient) Hooks() []Hook {
+	return c.hooks.UserNotificationPreference
 }
 
 // UserPlatformTokensClient is a client for the UserPlatformTokens schema.
@@ -307,3 +1104,273 @@ func (c *UserPushTokenClient) GetX(ctx context.Context, id int) *UserPushToken {
 func (c *UserPushTokenClient) Hooks() []Hook {
 	return c.hooks.UserPushToken
 }
+
+// UserQuietHoursClient is a client for the UserQuietHours schema.
+type UserQuietHoursClient struct {
+	config
+}
+
+// NewUserQuietHoursClient returns a client for the UserQuietHours from the given config.
+func NewUserQuietHoursClient(c config) *UserQuietHoursClient {
+	return &UserQuietHoursClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userquiethours.Hooks(f(g(h())))`.
+func (c *UserQuietHoursClient) Use(hooks ...Hook) {
+	c.hooks.UserQuietHours = append(c.hooks.UserQuietHours, hooks...)
+}
+
+// Create returns a create builder for UserQuietHours.
+func (c *UserQuietHoursClient) Create() *UserQuietHoursCreate {
+	mutation := newUserQuietHoursMutation(c.config, OpCreate)
+	return &UserQuietHoursCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserQuietHours entities.
+func (c *UserQuietHoursClient) CreateBulk(builders ...*UserQuietHoursCreate) *UserQuietHoursCreateBulk {
+	return &UserQuietHoursCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserQuietHours.
+func (c *UserQuietHoursClient) Update() *UserQuietHoursUpdate {
+	mutation := newUserQuietHoursMutation(c.config, OpUpdate)
+	return &UserQuietHoursUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserQuietHoursClient) UpdateOne(uqh *UserQuietHours) *UserQuietHoursUpdateOne {
+	mutation := newUserQuietHoursMutation(c.config, OpUpdateOne, withUserQuietHours(uqh))
+	return &UserQuietHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserQuietHoursClient) UpdateOneID(id int) *UserQuietHoursUpdateOne {
+	mutation := newUserQuietHoursMutation(c.config, OpUpdateOne, withUserQuietHoursID(id))
+	return &UserQuietHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserQuietHours.
+func (c *UserQuietHoursClient) Delete() *UserQuietHoursDelete {
+	mutation := newUserQuietHoursMutation(c.config, OpDelete)
+	return &UserQuietHoursDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *UserQuietHoursClient) DeleteOne(uqh *UserQuietHours) *UserQuietHoursDeleteOne {
+	return c.DeleteOneID(uqh.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *UserQuietHoursClient) DeleteOneID(id int) *UserQuietHoursDeleteOne {
+	builder := c.Delete().Where(userquiethours.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserQuietHoursDeleteOne{builder}
+}
+
+// Query returns a query builder for UserQuietHours.
+func (c *UserQuietHoursClient) Query() *UserQuietHoursQuery {
+	return &UserQuietHoursQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a UserQuietHours entity by its id.
+func (c *UserQuietHoursClient) Get(ctx context.Context, id int) (*UserQuietHours, error) {
+	return c.Query().Where(userquiethours.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserQuietHoursClient) GetX(ctx context.Context, id int) *UserQuietHours {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserQuietHoursClient) Hooks() []Hook {
+	return c.hooks.UserQuietHours
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Create returns a create builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
+	config
+}
+
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
+}
+
+// Create returns a create builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookSubscriptionClient) UpdateOne(ws *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(ws))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookSubscriptionClient) UpdateOneID(id int) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookSubscriptionClient) DeleteOne(ws *WebhookSubscription) *WebhookSubscriptionDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookSubscriptionClient) DeleteOneID(id int) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id int) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id int) *WebhookSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebhookSubscription
+}
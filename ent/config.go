@@ -24,8 +24,19 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	UserPlatformTokens []ent.Hook
-	UserPushToken      []ent.Hook
+	ApiKey                     []ent.Hook
+	App                        []ent.Hook
+	Audience                   []ent.Hook
+	AudienceMember             []ent.Hook
+	AuditLog                   []ent.Hook
+	DeliveryResult             []ent.Hook
+	PushEvent                  []ent.Hook
+	UserNotificationPreference []ent.Hook
+	UserPlatformTokens         []ent.Hook
+	UserPushToken              []ent.Hook
+	UserQuietHours             []ent.Hook
+	WebhookDelivery            []ent.Hook
+	WebhookSubscription        []ent.Hook
 }
 
 // Options applies the options on the config object.
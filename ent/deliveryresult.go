@@ -0,0 +1,222 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+)
+
+// DeliveryResult is the model entity for the DeliveryResult schema.
+type DeliveryResult struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ActionID holds the value of the "action_id" field.
+	ActionID string `json:"action_id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant string `json:"variant,omitempty"`
+	// TemplateID holds the value of the "template_id" field.
+	TemplateID string `json:"template_id,omitempty"`
+	// TrackingID holds the value of the "tracking_id" field.
+	TrackingID string `json:"tracking_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// PlatformResp holds the value of the "platform_resp" field.
+	PlatformResp string `json:"platform_resp,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeliveryResult) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deliveryresult.FieldID:
+			values[i] = new(sql.NullInt64)
+		case deliveryresult.FieldActionID, deliveryresult.FieldAppID, deliveryresult.FieldUserID, deliveryresult.FieldToken, deliveryresult.FieldCategory, deliveryresult.FieldVariant, deliveryresult.FieldTemplateID, deliveryresult.FieldTrackingID, deliveryresult.FieldStatus, deliveryresult.FieldReason, deliveryresult.FieldPlatformResp:
+			values[i] = new(sql.NullString)
+		case deliveryresult.FieldCreatedAt, deliveryresult.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type DeliveryResult", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeliveryResult fields.
+func (dr *DeliveryResult) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deliveryresult.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dr.ID = int(value.Int64)
+		case deliveryresult.FieldActionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_id", values[i])
+			} else if value.Valid {
+				dr.ActionID = value.String
+			}
+		case deliveryresult.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				dr.AppID = value.String
+			}
+		case deliveryresult.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				dr.UserID = value.String
+			}
+		case deliveryresult.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				dr.Token = value.String
+			}
+		case deliveryresult.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				dr.Category = value.String
+			}
+		case deliveryresult.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				dr.Variant = value.String
+			}
+		case deliveryresult.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				dr.TemplateID = value.String
+			}
+		case deliveryresult.FieldTrackingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tracking_id", values[i])
+			} else if value.Valid {
+				dr.TrackingID = value.String
+			}
+		case deliveryresult.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dr.Status = value.String
+			}
+		case deliveryresult.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				dr.Reason = value.String
+			}
+		case deliveryresult.FieldPlatformResp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_resp", values[i])
+			} else if value.Valid {
+				dr.PlatformResp = value.String
+			}
+		case deliveryresult.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dr.CreatedAt = value.Time
+			}
+		case deliveryresult.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dr.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this DeliveryResult.
+// Note that you need to call DeliveryResult.Unwrap() before calling this method if this DeliveryResult
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dr *DeliveryResult) Update() *DeliveryResultUpdateOne {
+	return (&DeliveryResultClient{config: dr.config}).UpdateOne(dr)
+}
+
+// Unwrap unwraps the DeliveryResult entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dr *DeliveryResult) Unwrap() *DeliveryResult {
+	tx, ok := dr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeliveryResult is not a transactional entity")
+	}
+	dr.config.driver = tx.drv
+	return dr
+}
+
+// String implements the fmt.Stringer.
+func (dr *DeliveryResult) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeliveryResult(")
+	builder.WriteString(fmt.Sprintf("id=%v", dr.ID))
+	builder.WriteString(", action_id=")
+	builder.WriteString(dr.ActionID)
+	builder.WriteString(", app_id=")
+	builder.WriteString(dr.AppID)
+	builder.WriteString(", user_id=")
+	builder.WriteString(dr.UserID)
+	builder.WriteString(", token=")
+	builder.WriteString(dr.Token)
+	builder.WriteString(", category=")
+	builder.WriteString(dr.Category)
+	builder.WriteString(", variant=")
+	builder.WriteString(dr.Variant)
+	builder.WriteString(", template_id=")
+	builder.WriteString(dr.TemplateID)
+	builder.WriteString(", tracking_id=")
+	builder.WriteString(dr.TrackingID)
+	builder.WriteString(", status=")
+	builder.WriteString(dr.Status)
+	builder.WriteString(", reason=")
+	builder.WriteString(dr.Reason)
+	builder.WriteString(", platform_resp=")
+	builder.WriteString(dr.PlatformResp)
+	builder.WriteString(", created_at=")
+	builder.WriteString(dr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(dr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeliveryResults is a parsable slice of DeliveryResult.
+type DeliveryResults []*DeliveryResult
+
+func (dr DeliveryResults) config(cfg config) {
+	for _i := range dr {
+		dr[_i].config = cfg
+	}
+}
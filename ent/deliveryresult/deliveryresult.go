@@ -0,0 +1,91 @@
+// Code generated by entc, DO NOT EDIT.
+
+package deliveryresult
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the deliveryresult type in the database.
+	Label = "delivery_result"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldActionID holds the string denoting the action_id field in the database.
+	FieldActionID = "action_id"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldTemplateID holds the string denoting the template_id field in the database.
+	FieldTemplateID = "template_id"
+	// FieldTrackingID holds the string denoting the tracking_id field in the database.
+	FieldTrackingID = "tracking_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldPlatformResp holds the string denoting the platform_resp field in the database.
+	FieldPlatformResp = "platform_resp"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the deliveryresult in the database.
+	Table = "delivery_results"
+)
+
+// Columns holds all SQL columns for deliveryresult fields.
+var Columns = []string{
+	FieldID,
+	FieldActionID,
+	FieldAppID,
+	FieldUserID,
+	FieldToken,
+	FieldCategory,
+	FieldVariant,
+	FieldTemplateID,
+	FieldTrackingID,
+	FieldStatus,
+	FieldReason,
+	FieldPlatformResp,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUserID holds the default value on creation for the "user_id" field.
+	DefaultUserID string
+	// DefaultCategory holds the default value on creation for the "category" field.
+	DefaultCategory string
+	// DefaultVariant holds the default value on creation for the "variant" field.
+	DefaultVariant string
+	// DefaultTemplateID holds the default value on creation for the "template_id" field.
+	DefaultTemplateID string
+	// DefaultTrackingID holds the default value on creation for the "tracking_id" field.
+	DefaultTrackingID string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
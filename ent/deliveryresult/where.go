@@ -0,0 +1,1603 @@
+// Code generated by entc, DO NOT EDIT.
+
+package deliveryresult
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ActionID applies equality check predicate on the "action_id" field. It's identical to ActionIDEQ.
+func ActionID(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToken), v))
+	})
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVariant), v))
+	})
+}
+
+// TemplateID applies equality check predicate on the "template_id" field. It's identical to TemplateIDEQ.
+func TemplateID(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// TrackingID applies equality check predicate on the "tracking_id" field. It's identical to TrackingIDEQ.
+func TrackingID(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// PlatformResp applies equality check predicate on the "platform_resp" field. It's identical to PlatformRespEQ.
+func PlatformResp(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlatformResp), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// ActionIDEQ applies the EQ predicate on the "action_id" field.
+func ActionIDEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDNEQ applies the NEQ predicate on the "action_id" field.
+func ActionIDNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDIn applies the In predicate on the "action_id" field.
+func ActionIDIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDNotIn applies the NotIn predicate on the "action_id" field.
+func ActionIDNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDGT applies the GT predicate on the "action_id" field.
+func ActionIDGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDGTE applies the GTE predicate on the "action_id" field.
+func ActionIDGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLT applies the LT predicate on the "action_id" field.
+func ActionIDLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLTE applies the LTE predicate on the "action_id" field.
+func ActionIDLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContains applies the Contains predicate on the "action_id" field.
+func ActionIDContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasPrefix applies the HasPrefix predicate on the "action_id" field.
+func ActionIDHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasSuffix applies the HasSuffix predicate on the "action_id" field.
+func ActionIDHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDEqualFold applies the EqualFold predicate on the "action_id" field.
+func ActionIDEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContainsFold applies the ContainsFold predicate on the "action_id" field.
+func ActionIDContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActionID), v))
+	})
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDContains applies the Contains predicate on the "app_id" field.
+func AppIDContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDHasPrefix applies the HasPrefix predicate on the "app_id" field.
+func AppIDHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDHasSuffix applies the HasSuffix predicate on the "app_id" field.
+func AppIDHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDEqualFold applies the EqualFold predicate on the "app_id" field.
+func AppIDEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDContainsFold applies the ContainsFold predicate on the "app_id" field.
+func AppIDContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAppID), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserID), v))
+	})
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToken), v))
+	})
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldToken), v))
+	})
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldToken), v...))
+	})
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldToken), v...))
+	})
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldToken), v))
+	})
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldToken), v))
+	})
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldToken), v))
+	})
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldToken), v))
+	})
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldToken), v))
+	})
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldToken), v))
+	})
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldToken), v))
+	})
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldToken), v))
+	})
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldToken), v))
+	})
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCategory), v...))
+	})
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCategory), v))
+	})
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCategory), v))
+	})
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVariant), v))
+	})
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVariant), v))
+	})
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVariant), v...))
+	})
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVariant), v...))
+	})
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVariant), v))
+	})
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVariant), v))
+	})
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVariant), v))
+	})
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVariant), v))
+	})
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVariant), v))
+	})
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVariant), v))
+	})
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVariant), v))
+	})
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVariant), v))
+	})
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVariant), v))
+	})
+}
+
+// TemplateIDEQ applies the EQ predicate on the "template_id" field.
+func TemplateIDEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDNEQ applies the NEQ predicate on the "template_id" field.
+func TemplateIDNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDIn applies the In predicate on the "template_id" field.
+func TemplateIDIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTemplateID), v...))
+	})
+}
+
+// TemplateIDNotIn applies the NotIn predicate on the "template_id" field.
+func TemplateIDNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTemplateID), v...))
+	})
+}
+
+// TemplateIDGT applies the GT predicate on the "template_id" field.
+func TemplateIDGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDGTE applies the GTE predicate on the "template_id" field.
+func TemplateIDGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDLT applies the LT predicate on the "template_id" field.
+func TemplateIDLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDLTE applies the LTE predicate on the "template_id" field.
+func TemplateIDLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDContains applies the Contains predicate on the "template_id" field.
+func TemplateIDContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDHasPrefix applies the HasPrefix predicate on the "template_id" field.
+func TemplateIDHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDHasSuffix applies the HasSuffix predicate on the "template_id" field.
+func TemplateIDHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDEqualFold applies the EqualFold predicate on the "template_id" field.
+func TemplateIDEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDContainsFold applies the ContainsFold predicate on the "template_id" field.
+func TemplateIDContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTemplateID), v))
+	})
+}
+
+// TrackingIDEQ applies the EQ predicate on the "tracking_id" field.
+func TrackingIDEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDNEQ applies the NEQ predicate on the "tracking_id" field.
+func TrackingIDNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDIn applies the In predicate on the "tracking_id" field.
+func TrackingIDIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTrackingID), v...))
+	})
+}
+
+// TrackingIDNotIn applies the NotIn predicate on the "tracking_id" field.
+func TrackingIDNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTrackingID), v...))
+	})
+}
+
+// TrackingIDGT applies the GT predicate on the "tracking_id" field.
+func TrackingIDGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDGTE applies the GTE predicate on the "tracking_id" field.
+func TrackingIDGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDLT applies the LT predicate on the "tracking_id" field.
+func TrackingIDLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDLTE applies the LTE predicate on the "tracking_id" field.
+func TrackingIDLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDContains applies the Contains predicate on the "tracking_id" field.
+func TrackingIDContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDHasPrefix applies the HasPrefix predicate on the "tracking_id" field.
+func TrackingIDHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDHasSuffix applies the HasSuffix predicate on the "tracking_id" field.
+func TrackingIDHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDEqualFold applies the EqualFold predicate on the "tracking_id" field.
+func TrackingIDEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDContainsFold applies the ContainsFold predicate on the "tracking_id" field.
+func TrackingIDContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTrackingID), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStatus), v))
+	})
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStatus), v))
+	})
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStatus), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReason), v))
+	})
+}
+
+// PlatformRespEQ applies the EQ predicate on the "platform_resp" field.
+func PlatformRespEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespNEQ applies the NEQ predicate on the "platform_resp" field.
+func PlatformRespNEQ(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespIn applies the In predicate on the "platform_resp" field.
+func PlatformRespIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPlatformResp), v...))
+	})
+}
+
+// PlatformRespNotIn applies the NotIn predicate on the "platform_resp" field.
+func PlatformRespNotIn(vs ...string) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPlatformResp), v...))
+	})
+}
+
+// PlatformRespGT applies the GT predicate on the "platform_resp" field.
+func PlatformRespGT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespGTE applies the GTE predicate on the "platform_resp" field.
+func PlatformRespGTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespLT applies the LT predicate on the "platform_resp" field.
+func PlatformRespLT(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespLTE applies the LTE predicate on the "platform_resp" field.
+func PlatformRespLTE(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespContains applies the Contains predicate on the "platform_resp" field.
+func PlatformRespContains(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespHasPrefix applies the HasPrefix predicate on the "platform_resp" field.
+func PlatformRespHasPrefix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespHasSuffix applies the HasSuffix predicate on the "platform_resp" field.
+func PlatformRespHasSuffix(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespIsNil applies the IsNil predicate on the "platform_resp" field.
+func PlatformRespIsNil() predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPlatformResp)))
+	})
+}
+
+// PlatformRespNotNil applies the NotNil predicate on the "platform_resp" field.
+func PlatformRespNotNil() predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPlatformResp)))
+	})
+}
+
+// PlatformRespEqualFold applies the EqualFold predicate on the "platform_resp" field.
+func PlatformRespEqualFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPlatformResp), v))
+	})
+}
+
+// PlatformRespContainsFold applies the ContainsFold predicate on the "platform_resp" field.
+func PlatformRespContainsFold(v string) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPlatformResp), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeliveryResult {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeliveryResult) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeliveryResult) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeliveryResult) predicate.DeliveryResult {
+	return predicate.DeliveryResult(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,532 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+)
+
+// DeliveryResultCreate is the builder for creating a DeliveryResult entity.
+type DeliveryResultCreate struct {
+	config
+	mutation *DeliveryResultMutation
+	hooks    []Hook
+}
+
+// SetActionID sets the "action_id" field.
+func (drc *DeliveryResultCreate) SetActionID(s string) *DeliveryResultCreate {
+	drc.mutation.SetActionID(s)
+	return drc
+}
+
+// SetAppID sets the "app_id" field.
+func (drc *DeliveryResultCreate) SetAppID(s string) *DeliveryResultCreate {
+	drc.mutation.SetAppID(s)
+	return drc
+}
+
+// SetUserID sets the "user_id" field.
+func (drc *DeliveryResultCreate) SetUserID(s string) *DeliveryResultCreate {
+	drc.mutation.SetUserID(s)
+	return drc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableUserID(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetUserID(*s)
+	}
+	return drc
+}
+
+// SetToken sets the "token" field.
+func (drc *DeliveryResultCreate) SetToken(s string) *DeliveryResultCreate {
+	drc.mutation.SetToken(s)
+	return drc
+}
+
+// SetCategory sets the "category" field.
+func (drc *DeliveryResultCreate) SetCategory(s string) *DeliveryResultCreate {
+	drc.mutation.SetCategory(s)
+	return drc
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableCategory(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetCategory(*s)
+	}
+	return drc
+}
+
+// SetVariant sets the "variant" field.
+func (drc *DeliveryResultCreate) SetVariant(s string) *DeliveryResultCreate {
+	drc.mutation.SetVariant(s)
+	return drc
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableVariant(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetVariant(*s)
+	}
+	return drc
+}
+
+// SetTemplateID sets the "template_id" field.
+func (drc *DeliveryResultCreate) SetTemplateID(s string) *DeliveryResultCreate {
+	drc.mutation.SetTemplateID(s)
+	return drc
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableTemplateID(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetTemplateID(*s)
+	}
+	return drc
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (drc *DeliveryResultCreate) SetTrackingID(s string) *DeliveryResultCreate {
+	drc.mutation.SetTrackingID(s)
+	return drc
+}
+
+// SetNillableTrackingID sets the "tracking_id" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableTrackingID(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetTrackingID(*s)
+	}
+	return drc
+}
+
+// SetStatus sets the "status" field.
+func (drc *DeliveryResultCreate) SetStatus(s string) *DeliveryResultCreate {
+	drc.mutation.SetStatus(s)
+	return drc
+}
+
+// SetReason sets the "reason" field.
+func (drc *DeliveryResultCreate) SetReason(s string) *DeliveryResultCreate {
+	drc.mutation.SetReason(s)
+	return drc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableReason(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetReason(*s)
+	}
+	return drc
+}
+
+// SetPlatformResp sets the "platform_resp" field.
+func (drc *DeliveryResultCreate) SetPlatformResp(s string) *DeliveryResultCreate {
+	drc.mutation.SetPlatformResp(s)
+	return drc
+}
+
+// SetNillablePlatformResp sets the "platform_resp" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillablePlatformResp(s *string) *DeliveryResultCreate {
+	if s != nil {
+		drc.SetPlatformResp(*s)
+	}
+	return drc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (drc *DeliveryResultCreate) SetCreatedAt(t time.Time) *DeliveryResultCreate {
+	drc.mutation.SetCreatedAt(t)
+	return drc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableCreatedAt(t *time.Time) *DeliveryResultCreate {
+	if t != nil {
+		drc.SetCreatedAt(*t)
+	}
+	return drc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (drc *DeliveryResultCreate) SetUpdatedAt(t time.Time) *DeliveryResultCreate {
+	drc.mutation.SetUpdatedAt(t)
+	return drc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (drc *DeliveryResultCreate) SetNillableUpdatedAt(t *time.Time) *DeliveryResultCreate {
+	if t != nil {
+		drc.SetUpdatedAt(*t)
+	}
+	return drc
+}
+
+// Mutation returns the DeliveryResultMutation object of the builder.
+func (drc *DeliveryResultCreate) Mutation() *DeliveryResultMutation {
+	return drc.mutation
+}
+
+// Save creates the DeliveryResult in the database.
+func (drc *DeliveryResultCreate) Save(ctx context.Context) (*DeliveryResult, error) {
+	var (
+		err  error
+		node *DeliveryResult
+	)
+	drc.defaults()
+	if len(drc.hooks) == 0 {
+		if err = drc.check(); err != nil {
+			return nil, err
+		}
+		node, err = drc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeliveryResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = drc.check(); err != nil {
+				return nil, err
+			}
+			drc.mutation = mutation
+			if node, err = drc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(drc.hooks) - 1; i >= 0; i-- {
+			if drc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = drc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, drc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (drc *DeliveryResultCreate) SaveX(ctx context.Context) *DeliveryResult {
+	v, err := drc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drc *DeliveryResultCreate) Exec(ctx context.Context) error {
+	_, err := drc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drc *DeliveryResultCreate) ExecX(ctx context.Context) {
+	if err := drc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (drc *DeliveryResultCreate) defaults() {
+	if _, ok := drc.mutation.UserID(); !ok {
+		v := deliveryresult.DefaultUserID
+		drc.mutation.SetUserID(v)
+	}
+	if _, ok := drc.mutation.Category(); !ok {
+		v := deliveryresult.DefaultCategory
+		drc.mutation.SetCategory(v)
+	}
+	if _, ok := drc.mutation.Variant(); !ok {
+		v := deliveryresult.DefaultVariant
+		drc.mutation.SetVariant(v)
+	}
+	if _, ok := drc.mutation.TemplateID(); !ok {
+		v := deliveryresult.DefaultTemplateID
+		drc.mutation.SetTemplateID(v)
+	}
+	if _, ok := drc.mutation.TrackingID(); !ok {
+		v := deliveryresult.DefaultTrackingID
+		drc.mutation.SetTrackingID(v)
+	}
+	if _, ok := drc.mutation.Reason(); !ok {
+		v := deliveryresult.DefaultReason
+		drc.mutation.SetReason(v)
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		v := deliveryresult.DefaultCreatedAt()
+		drc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := drc.mutation.UpdatedAt(); !ok {
+		v := deliveryresult.DefaultUpdatedAt()
+		drc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (drc *DeliveryResultCreate) check() error {
+	if _, ok := drc.mutation.ActionID(); !ok {
+		return &ValidationError{Name: "action_id", err: errors.New(`ent: missing required field "DeliveryResult.action_id"`)}
+	}
+	if _, ok := drc.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "DeliveryResult.app_id"`)}
+	}
+	if _, ok := drc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DeliveryResult.user_id"`)}
+	}
+	if _, ok := drc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "DeliveryResult.token"`)}
+	}
+	if _, ok := drc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "DeliveryResult.category"`)}
+	}
+	if _, ok := drc.mutation.Variant(); !ok {
+		return &ValidationError{Name: "variant", err: errors.New(`ent: missing required field "DeliveryResult.variant"`)}
+	}
+	if _, ok := drc.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`ent: missing required field "DeliveryResult.template_id"`)}
+	}
+	if _, ok := drc.mutation.TrackingID(); !ok {
+		return &ValidationError{Name: "tracking_id", err: errors.New(`ent: missing required field "DeliveryResult.tracking_id"`)}
+	}
+	if _, ok := drc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeliveryResult.status"`)}
+	}
+	if _, ok := drc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "DeliveryResult.reason"`)}
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeliveryResult.created_at"`)}
+	}
+	if _, ok := drc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeliveryResult.updated_at"`)}
+	}
+	return nil
+}
+
+func (drc *DeliveryResultCreate) sqlSave(ctx context.Context) (*DeliveryResult, error) {
+	_node, _spec := drc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, drc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (drc *DeliveryResultCreate) createSpec() (*DeliveryResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeliveryResult{config: drc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: deliveryresult.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deliveryresult.FieldID,
+			},
+		}
+	)
+	if value, ok := drc.mutation.ActionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldActionID,
+		})
+		_node.ActionID = value
+	}
+	if value, ok := drc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := drc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := drc.mutation.Token(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldToken,
+		})
+		_node.Token = value
+	}
+	if value, ok := drc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := drc.mutation.Variant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldVariant,
+		})
+		_node.Variant = value
+	}
+	if value, ok := drc.mutation.TemplateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTemplateID,
+		})
+		_node.TemplateID = value
+	}
+	if value, ok := drc.mutation.TrackingID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTrackingID,
+		})
+		_node.TrackingID = value
+	}
+	if value, ok := drc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := drc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := drc.mutation.PlatformResp(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldPlatformResp,
+		})
+		_node.PlatformResp = value
+	}
+	if value, ok := drc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := drc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// DeliveryResultCreateBulk is the builder for creating many DeliveryResult entities in bulk.
+type DeliveryResultCreateBulk struct {
+	config
+	builders []*DeliveryResultCreate
+}
+
+// Save creates the DeliveryResult entities in the database.
+func (drcb *DeliveryResultCreateBulk) Save(ctx context.Context) ([]*DeliveryResult, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(drcb.builders))
+	nodes := make([]*DeliveryResult, len(drcb.builders))
+	mutators := make([]Mutator, len(drcb.builders))
+	for i := range drcb.builders {
+		func(i int, root context.Context) {
+			builder := drcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeliveryResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, drcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, drcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, drcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (drcb *DeliveryResultCreateBulk) SaveX(ctx context.Context) []*DeliveryResult {
+	v, err := drcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drcb *DeliveryResultCreateBulk) Exec(ctx context.Context) error {
+	_, err := drcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drcb *DeliveryResultCreateBulk) ExecX(ctx context.Context) {
+	if err := drcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
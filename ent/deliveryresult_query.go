@@ -0,0 +1,526 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// DeliveryResultQuery is the builder for querying DeliveryResult entities.
+type DeliveryResultQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.DeliveryResult
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeliveryResultQuery builder.
+func (drq *DeliveryResultQuery) Where(ps ...predicate.DeliveryResult) *DeliveryResultQuery {
+	drq.predicates = append(drq.predicates, ps...)
+	return drq
+}
+
+// Limit adds a limit step to the query.
+func (drq *DeliveryResultQuery) Limit(limit int) *DeliveryResultQuery {
+	drq.limit = &limit
+	return drq
+}
+
+// Offset adds an offset step to the query.
+func (drq *DeliveryResultQuery) Offset(offset int) *DeliveryResultQuery {
+	drq.offset = &offset
+	return drq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (drq *DeliveryResultQuery) Unique(unique bool) *DeliveryResultQuery {
+	drq.unique = &unique
+	return drq
+}
+
+// Order adds an order step to the query.
+func (drq *DeliveryResultQuery) Order(o ...OrderFunc) *DeliveryResultQuery {
+	drq.order = append(drq.order, o...)
+	return drq
+}
+
+// First returns the first DeliveryResult entity from the query.
+// Returns a *NotFoundError when no DeliveryResult was found.
+func (drq *DeliveryResultQuery) First(ctx context.Context) (*DeliveryResult, error) {
+	nodes, err := drq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deliveryresult.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (drq *DeliveryResultQuery) FirstX(ctx context.Context) *DeliveryResult {
+	node, err := drq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeliveryResult ID from the query.
+// Returns a *NotFoundError when no DeliveryResult ID was found.
+func (drq *DeliveryResultQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = drq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deliveryresult.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (drq *DeliveryResultQuery) FirstIDX(ctx context.Context) int {
+	id, err := drq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeliveryResult entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeliveryResult entity is found.
+// Returns a *NotFoundError when no DeliveryResult entities are found.
+func (drq *DeliveryResultQuery) Only(ctx context.Context) (*DeliveryResult, error) {
+	nodes, err := drq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deliveryresult.Label}
+	default:
+		return nil, &NotSingularError{deliveryresult.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (drq *DeliveryResultQuery) OnlyX(ctx context.Context) *DeliveryResult {
+	node, err := drq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeliveryResult ID in the query.
+// Returns a *NotSingularError when more than one DeliveryResult ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (drq *DeliveryResultQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = drq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deliveryresult.Label}
+	default:
+		err = &NotSingularError{deliveryresult.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (drq *DeliveryResultQuery) OnlyIDX(ctx context.Context) int {
+	id, err := drq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeliveryResults.
+func (drq *DeliveryResultQuery) All(ctx context.Context) ([]*DeliveryResult, error) {
+	if err := drq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return drq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (drq *DeliveryResultQuery) AllX(ctx context.Context) []*DeliveryResult {
+	nodes, err := drq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeliveryResult IDs.
+func (drq *DeliveryResultQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := drq.Select(deliveryresult.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (drq *DeliveryResultQuery) IDsX(ctx context.Context) []int {
+	ids, err := drq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (drq *DeliveryResultQuery) Count(ctx context.Context) (int, error) {
+	if err := drq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return drq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (drq *DeliveryResultQuery) CountX(ctx context.Context) int {
+	count, err := drq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (drq *DeliveryResultQuery) Exist(ctx context.Context) (bool, error) {
+	if err := drq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return drq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (drq *DeliveryResultQuery) ExistX(ctx context.Context) bool {
+	exist, err := drq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeliveryResultQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (drq *DeliveryResultQuery) Clone() *DeliveryResultQuery {
+	if drq == nil {
+		return nil
+	}
+	return &DeliveryResultQuery{
+		config:     drq.config,
+		limit:      drq.limit,
+		offset:     drq.offset,
+		order:      append([]OrderFunc{}, drq.order...),
+		predicates: append([]predicate.DeliveryResult{}, drq.predicates...),
+		// clone intermediate query.
+		sql:    drq.sql.Clone(),
+		path:   drq.path,
+		unique: drq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ActionID string `json:"action_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeliveryResult.Query().
+//		GroupBy(deliveryresult.FieldActionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (drq *DeliveryResultQuery) GroupBy(field string, fields ...string) *DeliveryResultGroupBy {
+	grbuild := &DeliveryResultGroupBy{config: drq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := drq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return drq.sqlQuery(ctx), nil
+	}
+	grbuild.label = deliveryresult.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ActionID string `json:"action_id,omitempty"`
+//	}
+//
+//	client.DeliveryResult.Query().
+//		Select(deliveryresult.FieldActionID).
+//		Scan(ctx, &v)
+func (drq *DeliveryResultQuery) Select(fields ...string) *DeliveryResultSelect {
+	drq.fields = append(drq.fields, fields...)
+	selbuild := &DeliveryResultSelect{DeliveryResultQuery: drq}
+	selbuild.label = deliveryresult.Label
+	selbuild.flds, selbuild.scan = &drq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (drq *DeliveryResultQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range drq.fields {
+		if !deliveryresult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if drq.path != nil {
+		prev, err := drq.path(ctx)
+		if err != nil {
+			return err
+		}
+		drq.sql = prev
+	}
+	return nil
+}
+
+func (drq *DeliveryResultQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeliveryResult, error) {
+	var (
+		nodes = []*DeliveryResult{}
+		_spec = drq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*DeliveryResult).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &DeliveryResult{config: drq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, drq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (drq *DeliveryResultQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := drq.querySpec()
+	_spec.Node.Columns = drq.fields
+	if len(drq.fields) > 0 {
+		_spec.Unique = drq.unique != nil && *drq.unique
+	}
+	return sqlgraph.CountNodes(ctx, drq.driver, _spec)
+}
+
+func (drq *DeliveryResultQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := drq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (drq *DeliveryResultQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   deliveryresult.Table,
+			Columns: deliveryresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deliveryresult.FieldID,
+			},
+		},
+		From:   drq.sql,
+		Unique: true,
+	}
+	if unique := drq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := drq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryresult.FieldID)
+		for i := range fields {
+			if fields[i] != deliveryresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := drq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := drq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := drq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := drq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (drq *DeliveryResultQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(drq.driver.Dialect())
+	t1 := builder.Table(deliveryresult.Table)
+	columns := drq.fields
+	if len(columns) == 0 {
+		columns = deliveryresult.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if drq.sql != nil {
+		selector = drq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if drq.unique != nil && *drq.unique {
+		selector.Distinct()
+	}
+	for _, p := range drq.predicates {
+		p(selector)
+	}
+	for _, p := range drq.order {
+		p(selector)
+	}
+	if offset := drq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := drq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DeliveryResultGroupBy is the group-by builder for DeliveryResult entities.
+type DeliveryResultGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (drgb *DeliveryResultGroupBy) Aggregate(fns ...AggregateFunc) *DeliveryResultGroupBy {
+	drgb.fns = append(drgb.fns, fns...)
+	return drgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (drgb *DeliveryResultGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := drgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	drgb.sql = query
+	return drgb.sqlScan(ctx, v)
+}
+
+func (drgb *DeliveryResultGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range drgb.fields {
+		if !deliveryresult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := drgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := drgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (drgb *DeliveryResultGroupBy) sqlQuery() *sql.Selector {
+	selector := drgb.sql.Select()
+	aggregation := make([]string, 0, len(drgb.fns))
+	for _, fn := range drgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(drgb.fields)+len(drgb.fns))
+		for _, f := range drgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(drgb.fields...)...)
+}
+
+// DeliveryResultSelect is the builder for selecting fields of DeliveryResult entities.
+type DeliveryResultSelect struct {
+	*DeliveryResultQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (drs *DeliveryResultSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := drs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	drs.sql = drs.DeliveryResultQuery.sqlQuery(ctx)
+	return drs.sqlScan(ctx, v)
+}
+
+func (drs *DeliveryResultSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := drs.sql.Query()
+	if err := drs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,748 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// DeliveryResultUpdate is the builder for updating DeliveryResult entities.
+type DeliveryResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeliveryResultMutation
+}
+
+// Where appends a list predicates to the DeliveryResultUpdate builder.
+func (dru *DeliveryResultUpdate) Where(ps ...predicate.DeliveryResult) *DeliveryResultUpdate {
+	dru.mutation.Where(ps...)
+	return dru
+}
+
+// SetActionID sets the "action_id" field.
+func (dru *DeliveryResultUpdate) SetActionID(s string) *DeliveryResultUpdate {
+	dru.mutation.SetActionID(s)
+	return dru
+}
+
+// SetAppID sets the "app_id" field.
+func (dru *DeliveryResultUpdate) SetAppID(s string) *DeliveryResultUpdate {
+	dru.mutation.SetAppID(s)
+	return dru
+}
+
+// SetUserID sets the "user_id" field.
+func (dru *DeliveryResultUpdate) SetUserID(s string) *DeliveryResultUpdate {
+	dru.mutation.SetUserID(s)
+	return dru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableUserID(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetUserID(*s)
+	}
+	return dru
+}
+
+// SetToken sets the "token" field.
+func (dru *DeliveryResultUpdate) SetToken(s string) *DeliveryResultUpdate {
+	dru.mutation.SetToken(s)
+	return dru
+}
+
+// SetCategory sets the "category" field.
+func (dru *DeliveryResultUpdate) SetCategory(s string) *DeliveryResultUpdate {
+	dru.mutation.SetCategory(s)
+	return dru
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableCategory(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetCategory(*s)
+	}
+	return dru
+}
+
+// SetVariant sets the "variant" field.
+func (dru *DeliveryResultUpdate) SetVariant(s string) *DeliveryResultUpdate {
+	dru.mutation.SetVariant(s)
+	return dru
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableVariant(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetVariant(*s)
+	}
+	return dru
+}
+
+// SetTemplateID sets the "template_id" field.
+func (dru *DeliveryResultUpdate) SetTemplateID(s string) *DeliveryResultUpdate {
+	dru.mutation.SetTemplateID(s)
+	return dru
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableTemplateID(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetTemplateID(*s)
+	}
+	return dru
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (dru *DeliveryResultUpdate) SetTrackingID(s string) *DeliveryResultUpdate {
+	dru.mutation.SetTrackingID(s)
+	return dru
+}
+
+// SetNillableTrackingID sets the "tracking_id" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableTrackingID(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetTrackingID(*s)
+	}
+	return dru
+}
+
+// SetStatus sets the "status" field.
+func (dru *DeliveryResultUpdate) SetStatus(s string) *DeliveryResultUpdate {
+	dru.mutation.SetStatus(s)
+	return dru
+}
+
+// SetReason sets the "reason" field.
+func (dru *DeliveryResultUpdate) SetReason(s string) *DeliveryResultUpdate {
+	dru.mutation.SetReason(s)
+	return dru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableReason(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetReason(*s)
+	}
+	return dru
+}
+
+// SetPlatformResp sets the "platform_resp" field.
+func (dru *DeliveryResultUpdate) SetPlatformResp(s string) *DeliveryResultUpdate {
+	dru.mutation.SetPlatformResp(s)
+	return dru
+}
+
+// SetNillablePlatformResp sets the "platform_resp" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillablePlatformResp(s *string) *DeliveryResultUpdate {
+	if s != nil {
+		dru.SetPlatformResp(*s)
+	}
+	return dru
+}
+
+// ClearPlatformResp clears the value of the "platform_resp" field.
+func (dru *DeliveryResultUpdate) ClearPlatformResp() *DeliveryResultUpdate {
+	dru.mutation.ClearPlatformResp()
+	return dru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dru *DeliveryResultUpdate) SetCreatedAt(t time.Time) *DeliveryResultUpdate {
+	dru.mutation.SetCreatedAt(t)
+	return dru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dru *DeliveryResultUpdate) SetNillableCreatedAt(t *time.Time) *DeliveryResultUpdate {
+	if t != nil {
+		dru.SetCreatedAt(*t)
+	}
+	return dru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dru *DeliveryResultUpdate) SetUpdatedAt(t time.Time) *DeliveryResultUpdate {
+	dru.mutation.SetUpdatedAt(t)
+	return dru
+}
+
+// Mutation returns the DeliveryResultMutation object of the builder.
+func (dru *DeliveryResultUpdate) Mutation() *DeliveryResultMutation {
+	return dru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dru *DeliveryResultUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	dru.defaults()
+	if len(dru.hooks) == 0 {
+		affected, err = dru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeliveryResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			dru.mutation = mutation
+			affected, err = dru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(dru.hooks) - 1; i >= 0; i-- {
+			if dru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = dru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dru *DeliveryResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := dru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dru *DeliveryResultUpdate) Exec(ctx context.Context) error {
+	_, err := dru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dru *DeliveryResultUpdate) ExecX(ctx context.Context) {
+	if err := dru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dru *DeliveryResultUpdate) defaults() {
+	if _, ok := dru.mutation.UpdatedAt(); !ok {
+		v := deliveryresult.UpdateDefaultUpdatedAt()
+		dru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (dru *DeliveryResultUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   deliveryresult.Table,
+			Columns: deliveryresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deliveryresult.FieldID,
+			},
+		},
+	}
+	if ps := dru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dru.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldActionID,
+		})
+	}
+	if value, ok := dru.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldAppID,
+		})
+	}
+	if value, ok := dru.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldUserID,
+		})
+	}
+	if value, ok := dru.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldToken,
+		})
+	}
+	if value, ok := dru.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldCategory,
+		})
+	}
+	if value, ok := dru.mutation.Variant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldVariant,
+		})
+	}
+	if value, ok := dru.mutation.TemplateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTemplateID,
+		})
+	}
+	if value, ok := dru.mutation.TrackingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTrackingID,
+		})
+	}
+	if value, ok := dru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldStatus,
+		})
+	}
+	if value, ok := dru.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldReason,
+		})
+	}
+	if value, ok := dru.mutation.PlatformResp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldPlatformResp,
+		})
+	}
+	if dru.mutation.PlatformRespCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: deliveryresult.FieldPlatformResp,
+		})
+	}
+	if value, ok := dru.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldCreatedAt,
+		})
+	}
+	if value, ok := dru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeliveryResultUpdateOne is the builder for updating a single DeliveryResult entity.
+type DeliveryResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeliveryResultMutation
+}
+
+// SetActionID sets the "action_id" field.
+func (druo *DeliveryResultUpdateOne) SetActionID(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetActionID(s)
+	return druo
+}
+
+// SetAppID sets the "app_id" field.
+func (druo *DeliveryResultUpdateOne) SetAppID(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetAppID(s)
+	return druo
+}
+
+// SetUserID sets the "user_id" field.
+func (druo *DeliveryResultUpdateOne) SetUserID(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetUserID(s)
+	return druo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableUserID(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetUserID(*s)
+	}
+	return druo
+}
+
+// SetToken sets the "token" field.
+func (druo *DeliveryResultUpdateOne) SetToken(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetToken(s)
+	return druo
+}
+
+// SetCategory sets the "category" field.
+func (druo *DeliveryResultUpdateOne) SetCategory(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetCategory(s)
+	return druo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableCategory(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetCategory(*s)
+	}
+	return druo
+}
+
+// SetVariant sets the "variant" field.
+func (druo *DeliveryResultUpdateOne) SetVariant(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetVariant(s)
+	return druo
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableVariant(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetVariant(*s)
+	}
+	return druo
+}
+
+// SetTemplateID sets the "template_id" field.
+func (druo *DeliveryResultUpdateOne) SetTemplateID(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetTemplateID(s)
+	return druo
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableTemplateID(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetTemplateID(*s)
+	}
+	return druo
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (druo *DeliveryResultUpdateOne) SetTrackingID(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetTrackingID(s)
+	return druo
+}
+
+// SetNillableTrackingID sets the "tracking_id" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableTrackingID(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetTrackingID(*s)
+	}
+	return druo
+}
+
+// SetStatus sets the "status" field.
+func (druo *DeliveryResultUpdateOne) SetStatus(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetStatus(s)
+	return druo
+}
+
+// SetReason sets the "reason" field.
+func (druo *DeliveryResultUpdateOne) SetReason(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetReason(s)
+	return druo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableReason(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetReason(*s)
+	}
+	return druo
+}
+
+// SetPlatformResp sets the "platform_resp" field.
+func (druo *DeliveryResultUpdateOne) SetPlatformResp(s string) *DeliveryResultUpdateOne {
+	druo.mutation.SetPlatformResp(s)
+	return druo
+}
+
+// SetNillablePlatformResp sets the "platform_resp" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillablePlatformResp(s *string) *DeliveryResultUpdateOne {
+	if s != nil {
+		druo.SetPlatformResp(*s)
+	}
+	return druo
+}
+
+// ClearPlatformResp clears the value of the "platform_resp" field.
+func (druo *DeliveryResultUpdateOne) ClearPlatformResp() *DeliveryResultUpdateOne {
+	druo.mutation.ClearPlatformResp()
+	return druo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (druo *DeliveryResultUpdateOne) SetCreatedAt(t time.Time) *DeliveryResultUpdateOne {
+	druo.mutation.SetCreatedAt(t)
+	return druo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (druo *DeliveryResultUpdateOne) SetNillableCreatedAt(t *time.Time) *DeliveryResultUpdateOne {
+	if t != nil {
+		druo.SetCreatedAt(*t)
+	}
+	return druo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (druo *DeliveryResultUpdateOne) SetUpdatedAt(t time.Time) *DeliveryResultUpdateOne {
+	druo.mutation.SetUpdatedAt(t)
+	return druo
+}
+
+// Mutation returns the DeliveryResultMutation object of the builder.
+func (druo *DeliveryResultUpdateOne) Mutation() *DeliveryResultMutation {
+	return druo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (druo *DeliveryResultUpdateOne) Select(field string, fields ...string) *DeliveryResultUpdateOne {
+	druo.fields = append([]string{field}, fields...)
+	return druo
+}
+
+// Save executes the query and returns the updated DeliveryResult entity.
+func (druo *DeliveryResultUpdateOne) Save(ctx context.Context) (*DeliveryResult, error) {
+	var (
+		err  error
+		node *DeliveryResult
+	)
+	druo.defaults()
+	if len(druo.hooks) == 0 {
+		node, err = druo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeliveryResultMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			druo.mutation = mutation
+			node, err = druo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(druo.hooks) - 1; i >= 0; i-- {
+			if druo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = druo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, druo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (druo *DeliveryResultUpdateOne) SaveX(ctx context.Context) *DeliveryResult {
+	node, err := druo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (druo *DeliveryResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := druo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (druo *DeliveryResultUpdateOne) ExecX(ctx context.Context) {
+	if err := druo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (druo *DeliveryResultUpdateOne) defaults() {
+	if _, ok := druo.mutation.UpdatedAt(); !ok {
+		v := deliveryresult.UpdateDefaultUpdatedAt()
+		druo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (druo *DeliveryResultUpdateOne) sqlSave(ctx context.Context) (_node *DeliveryResult, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   deliveryresult.Table,
+			Columns: deliveryresult.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deliveryresult.FieldID,
+			},
+		},
+	}
+	id, ok := druo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeliveryResult.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := druo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deliveryresult.FieldID)
+		for _, f := range fields {
+			if !deliveryresult.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deliveryresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := druo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := druo.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldActionID,
+		})
+	}
+	if value, ok := druo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldAppID,
+		})
+	}
+	if value, ok := druo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldUserID,
+		})
+	}
+	if value, ok := druo.mutation.Token(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldToken,
+		})
+	}
+	if value, ok := druo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldCategory,
+		})
+	}
+	if value, ok := druo.mutation.Variant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldVariant,
+		})
+	}
+	if value, ok := druo.mutation.TemplateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTemplateID,
+		})
+	}
+	if value, ok := druo.mutation.TrackingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldTrackingID,
+		})
+	}
+	if value, ok := druo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldStatus,
+		})
+	}
+	if value, ok := druo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldReason,
+		})
+	}
+	if value, ok := druo.mutation.PlatformResp(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deliveryresult.FieldPlatformResp,
+		})
+	}
+	if druo.mutation.PlatformRespCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: deliveryresult.FieldPlatformResp,
+		})
+	}
+	if value, ok := druo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldCreatedAt,
+		})
+	}
+	if value, ok := druo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deliveryresult.FieldUpdatedAt,
+		})
+	}
+	_node = &DeliveryResult{config: druo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, druo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deliveryresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
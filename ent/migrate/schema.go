@@ -8,6 +8,256 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "app_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "previous_signing_secret_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// AppsColumns holds the columns for the "apps" table.
+	AppsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "app_id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "push_type", Type: field.TypeEnum, Enums: []string{"apple", "firebase"}},
+		{Name: "credentials", Type: field.TypeBytes},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "revision", Type: field.TypeInt64, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AppsTable holds the schema information for the "apps" table.
+	AppsTable = &schema.Table{
+		Name:       "apps",
+		Columns:    AppsColumns,
+		PrimaryKey: []*schema.Column{AppsColumns[0]},
+	}
+	// AudiencesColumns holds the columns for the "audiences" table.
+	AudiencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "type", Type: field.TypeString},
+		{Name: "segment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "app_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "member_count", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AudiencesTable holds the schema information for the "audiences" table.
+	AudiencesTable = &schema.Table{
+		Name:       "audiences",
+		Columns:    AudiencesColumns,
+		PrimaryKey: []*schema.Column{AudiencesColumns[0]},
+	}
+	// AudienceMembersColumns holds the columns for the "audience_members" table.
+	AudienceMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "audience_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AudienceMembersTable holds the schema information for the "audience_members" table.
+	AudienceMembersTable = &schema.Table{
+		Name:       "audience_members",
+		Columns:    AudienceMembersColumns,
+		PrimaryKey: []*schema.Column{AudienceMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "audiencemember_audience_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{AudienceMembersColumns[1], AudienceMembersColumns[2]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "caller_key_id", Type: field.TypeInt, Default: 0},
+		{Name: "caller_name", Type: field.TypeString},
+		{Name: "caller_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "remote_addr", Type: field.TypeString, Nullable: true},
+		{Name: "method", Type: field.TypeString},
+		{Name: "endpoint", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "target", Type: field.TypeString, Nullable: true},
+		{Name: "action_id", Type: field.TypeString, Nullable: true},
+		{Name: "app_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "audience_size", Type: field.TypeInt64, Default: 0},
+		{Name: "message_summary", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "status_code", Type: field.TypeInt},
+		{Name: "result", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[15]},
+			},
+			{
+				Name:    "auditlog_operation_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7], AuditLogsColumns[15]},
+			},
+			{
+				Name:    "auditlog_caller_key_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[15]},
+			},
+			{
+				Name:    "auditlog_action_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+		},
+	}
+	// DeliveryResultsColumns holds the columns for the "delivery_results" table.
+	DeliveryResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action_id", Type: field.TypeString},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Default: ""},
+		{Name: "token", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Default: ""},
+		{Name: "variant", Type: field.TypeString, Default: ""},
+		{Name: "template_id", Type: field.TypeString, Default: ""},
+		{Name: "tracking_id", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "platform_resp", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DeliveryResultsTable holds the schema information for the "delivery_results" table.
+	DeliveryResultsTable = &schema.Table{
+		Name:       "delivery_results",
+		Columns:    DeliveryResultsColumns,
+		PrimaryKey: []*schema.Column{DeliveryResultsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deliveryresult_action_id_token",
+				Unique:  true,
+				Columns: []*schema.Column{DeliveryResultsColumns[1], DeliveryResultsColumns[4]},
+			},
+			{
+				Name:    "deliveryresult_action_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryResultsColumns[1], DeliveryResultsColumns[9]},
+			},
+			{
+				Name:    "deliveryresult_action_id_variant",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryResultsColumns[1], DeliveryResultsColumns[6]},
+			},
+			{
+				Name:    "deliveryresult_tracking_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryResultsColumns[8]},
+			},
+			{
+				Name:    "deliveryresult_app_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryResultsColumns[2], DeliveryResultsColumns[12]},
+			},
+			{
+				Name:    "deliveryresult_template_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DeliveryResultsColumns[7], DeliveryResultsColumns[12]},
+			},
+		},
+	}
+	// PushEventsColumns holds the columns for the "push_events" table.
+	PushEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tracking_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "action_id", Type: field.TypeString},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Default: ""},
+		{Name: "template_id", Type: field.TypeString, Default: ""},
+		{Name: "variant", Type: field.TypeString, Default: ""},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PushEventsTable holds the schema information for the "push_events" table.
+	PushEventsTable = &schema.Table{
+		Name:       "push_events",
+		Columns:    PushEventsColumns,
+		PrimaryKey: []*schema.Column{PushEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pushevent_tracking_id_event_type",
+				Unique:  true,
+				Columns: []*schema.Column{PushEventsColumns[1], PushEventsColumns[2]},
+			},
+			{
+				Name:    "pushevent_action_id_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{PushEventsColumns[3], PushEventsColumns[2]},
+			},
+			{
+				Name:    "pushevent_app_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PushEventsColumns[4], PushEventsColumns[9]},
+			},
+			{
+				Name:    "pushevent_template_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PushEventsColumns[6], PushEventsColumns[9]},
+			},
+		},
+	}
+	// UserNotificationPreferencesColumns holds the columns for the "user_notification_preferences" table.
+	UserNotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserNotificationPreferencesTable holds the schema information for the "user_notification_preferences" table.
+	UserNotificationPreferencesTable = &schema.Table{
+		Name:       "user_notification_preferences",
+		Columns:    UserNotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{UserNotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernotificationpreference_user_id_app_id_category",
+				Unique:  true,
+				Columns: []*schema.Column{UserNotificationPreferencesColumns[1], UserNotificationPreferencesColumns[2], UserNotificationPreferencesColumns[3]},
+			},
+			{
+				Name:    "usernotificationpreference_app_id_category_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{UserNotificationPreferencesColumns[2], UserNotificationPreferencesColumns[3], UserNotificationPreferencesColumns[4]},
+			},
+		},
+	}
 	// UserPlatformTokensColumns holds the columns for the "user_platform_tokens" table.
 	UserPlatformTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,15 +266,30 @@ var (
 		{Name: "device_id", Type: field.TypeString},
 		{Name: "token", Type: field.TypeString},
 		{Name: "app_id", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Nullable: true},
+		{Name: "app_version", Type: field.TypeString, Nullable: true},
+		{Name: "app_version_num", Type: field.TypeInt64, Nullable: true},
+		{Name: "os_version", Type: field.TypeString, Nullable: true},
+		{Name: "os_version_num", Type: field.TypeInt64, Nullable: true},
+		{Name: "locale", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "last_active_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
 	// UserPlatformTokensTable holds the schema information for the "user_platform_tokens" table.
 	UserPlatformTokensTable = &schema.Table{
-		Name:        "user_platform_tokens",
-		Columns:     UserPlatformTokensColumns,
-		PrimaryKey:  []*schema.Column{UserPlatformTokensColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{},
+		Name:       "user_platform_tokens",
+		Columns:    UserPlatformTokensColumns,
+		PrimaryKey: []*schema.Column{UserPlatformTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userplatformtokens_app_id_device_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserPlatformTokensColumns[5], UserPlatformTokensColumns[3]},
+			},
+		},
 	}
 	// UserPushTokensColumns holds the columns for the "user_push_tokens" table.
 	UserPushTokensColumns = []*schema.Column{
@@ -37,15 +302,106 @@ var (
 	}
 	// UserPushTokensTable holds the schema information for the "user_push_tokens" table.
 	UserPushTokensTable = &schema.Table{
-		Name:        "user_push_tokens",
-		Columns:     UserPushTokensColumns,
-		PrimaryKey:  []*schema.Column{UserPushTokensColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{},
+		Name:       "user_push_tokens",
+		Columns:    UserPushTokensColumns,
+		PrimaryKey: []*schema.Column{UserPushTokensColumns[0]},
+	}
+	// UserQuietHoursColumns holds the columns for the "user_quiet_hours" table.
+	UserQuietHoursColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "start", Type: field.TypeString},
+		{Name: "end", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UserQuietHoursTable holds the schema information for the "user_quiet_hours" table.
+	UserQuietHoursTable = &schema.Table{
+		Name:       "user_quiet_hours",
+		Columns:    UserQuietHoursColumns,
+		PrimaryKey: []*schema.Column{UserQuietHoursColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userquiethours_user_id_app_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserQuietHoursColumns[1], UserQuietHoursColumns[2]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "subscription_id", Type: field.TypeInt},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_subscription_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[9]},
+			},
+			{
+				Name:    "webhookdelivery_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[2]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "app_id", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooksubscription_app_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookSubscriptionsColumns[1], WebhookSubscriptionsColumns[5]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AppsTable,
+		AudiencesTable,
+		AudienceMembersTable,
+		AuditLogsTable,
+		DeliveryResultsTable,
+		PushEventsTable,
+		UserNotificationPreferencesTable,
 		UserPlatformTokensTable,
 		UserPushTokensTable,
+		UserQuietHoursTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
 	}
 )
 
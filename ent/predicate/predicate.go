@@ -6,8 +6,41 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// App is the predicate function for app builders.
+type App func(*sql.Selector)
+
+// Audience is the predicate function for audience builders.
+type Audience func(*sql.Selector)
+
+// AudienceMember is the predicate function for audiencemember builders.
+type AudienceMember func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// DeliveryResult is the predicate function for deliveryresult builders.
+type DeliveryResult func(*sql.Selector)
+
+// PushEvent is the predicate function for pushevent builders.
+type PushEvent func(*sql.Selector)
+
+// UserNotificationPreference is the predicate function for usernotificationpreference builders.
+type UserNotificationPreference func(*sql.Selector)
+
 // UserPlatformTokens is the predicate function for userplatformtokens builders.
 type UserPlatformTokens func(*sql.Selector)
 
 // UserPushToken is the predicate function for userpushtoken builders.
 type UserPushToken func(*sql.Selector)
+
+// UserQuietHours is the predicate function for userquiethours builders.
+type UserQuietHours func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
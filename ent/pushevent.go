@@ -0,0 +1,182 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/pushevent"
+)
+
+// PushEvent is the model entity for the PushEvent schema.
+type PushEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TrackingID holds the value of the "tracking_id" field.
+	TrackingID string `json:"tracking_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// ActionID holds the value of the "action_id" field.
+	ActionID string `json:"action_id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// TemplateID holds the value of the "template_id" field.
+	TemplateID string `json:"template_id,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant string `json:"variant,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PushEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pushevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case pushevent.FieldTrackingID, pushevent.FieldEventType, pushevent.FieldActionID, pushevent.FieldAppID, pushevent.FieldUserID, pushevent.FieldTemplateID, pushevent.FieldVariant:
+			values[i] = new(sql.NullString)
+		case pushevent.FieldOccurredAt, pushevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PushEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PushEvent fields.
+func (pe *PushEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pushevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pe.ID = int(value.Int64)
+		case pushevent.FieldTrackingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tracking_id", values[i])
+			} else if value.Valid {
+				pe.TrackingID = value.String
+			}
+		case pushevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				pe.EventType = value.String
+			}
+		case pushevent.FieldActionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_id", values[i])
+			} else if value.Valid {
+				pe.ActionID = value.String
+			}
+		case pushevent.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				pe.AppID = value.String
+			}
+		case pushevent.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pe.UserID = value.String
+			}
+		case pushevent.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				pe.TemplateID = value.String
+			}
+		case pushevent.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				pe.Variant = value.String
+			}
+		case pushevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				pe.OccurredAt = value.Time
+			}
+		case pushevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pe.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PushEvent.
+// Note that you need to call PushEvent.Unwrap() before calling this method if this PushEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pe *PushEvent) Update() *PushEventUpdateOne {
+	return (&PushEventClient{config: pe.config}).UpdateOne(pe)
+}
+
+// Unwrap unwraps the PushEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pe *PushEvent) Unwrap() *PushEvent {
+	tx, ok := pe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PushEvent is not a transactional entity")
+	}
+	pe.config.driver = tx.drv
+	return pe
+}
+
+// String implements the fmt.Stringer.
+func (pe *PushEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PushEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v", pe.ID))
+	builder.WriteString(", tracking_id=")
+	builder.WriteString(pe.TrackingID)
+	builder.WriteString(", event_type=")
+	builder.WriteString(pe.EventType)
+	builder.WriteString(", action_id=")
+	builder.WriteString(pe.ActionID)
+	builder.WriteString(", app_id=")
+	builder.WriteString(pe.AppID)
+	builder.WriteString(", user_id=")
+	builder.WriteString(pe.UserID)
+	builder.WriteString(", template_id=")
+	builder.WriteString(pe.TemplateID)
+	builder.WriteString(", variant=")
+	builder.WriteString(pe.Variant)
+	builder.WriteString(", occurred_at=")
+	builder.WriteString(pe.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(pe.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PushEvents is a parsable slice of PushEvent.
+type PushEvents []*PushEvent
+
+func (pe PushEvents) config(cfg config) {
+	for _i := range pe {
+		pe[_i].config = cfg
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by entc, DO NOT EDIT.
+
+package pushevent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the pushevent type in the database.
+	Label = "push_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTrackingID holds the string denoting the tracking_id field in the database.
+	FieldTrackingID = "tracking_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldActionID holds the string denoting the action_id field in the database.
+	FieldActionID = "action_id"
+	// FieldAppID holds the string denoting the app_id field in the database.
+	FieldAppID = "app_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTemplateID holds the string denoting the template_id field in the database.
+	FieldTemplateID = "template_id"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the pushevent in the database.
+	Table = "push_events"
+)
+
+// Columns holds all SQL columns for pushevent fields.
+var Columns = []string{
+	FieldID,
+	FieldTrackingID,
+	FieldEventType,
+	FieldActionID,
+	FieldAppID,
+	FieldUserID,
+	FieldTemplateID,
+	FieldVariant,
+	FieldOccurredAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUserID holds the default value on creation for the "user_id" field.
+	DefaultUserID string
+	// DefaultTemplateID holds the default value on creation for the "template_id" field.
+	DefaultTemplateID string
+	// DefaultVariant holds the default value on creation for the "variant" field.
+	DefaultVariant string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
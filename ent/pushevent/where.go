@@ -0,0 +1,1117 @@
+// Code generated by entc, DO NOT EDIT.
+
+package pushevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TrackingID applies equality check predicate on the "tracking_id" field. It's identical to TrackingIDEQ.
+func TrackingID(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventType), v))
+	})
+}
+
+// ActionID applies equality check predicate on the "action_id" field. It's identical to ActionIDEQ.
+func ActionID(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// TemplateID applies equality check predicate on the "template_id" field. It's identical to TemplateIDEQ.
+func TemplateID(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVariant), v))
+	})
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// TrackingIDEQ applies the EQ predicate on the "tracking_id" field.
+func TrackingIDEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDNEQ applies the NEQ predicate on the "tracking_id" field.
+func TrackingIDNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDIn applies the In predicate on the "tracking_id" field.
+func TrackingIDIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTrackingID), v...))
+	})
+}
+
+// TrackingIDNotIn applies the NotIn predicate on the "tracking_id" field.
+func TrackingIDNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTrackingID), v...))
+	})
+}
+
+// TrackingIDGT applies the GT predicate on the "tracking_id" field.
+func TrackingIDGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDGTE applies the GTE predicate on the "tracking_id" field.
+func TrackingIDGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDLT applies the LT predicate on the "tracking_id" field.
+func TrackingIDLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDLTE applies the LTE predicate on the "tracking_id" field.
+func TrackingIDLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDContains applies the Contains predicate on the "tracking_id" field.
+func TrackingIDContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDHasPrefix applies the HasPrefix predicate on the "tracking_id" field.
+func TrackingIDHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDHasSuffix applies the HasSuffix predicate on the "tracking_id" field.
+func TrackingIDHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDEqualFold applies the EqualFold predicate on the "tracking_id" field.
+func TrackingIDEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTrackingID), v))
+	})
+}
+
+// TrackingIDContainsFold applies the ContainsFold predicate on the "tracking_id" field.
+func TrackingIDContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTrackingID), v))
+	})
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldEventType), v...))
+	})
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldEventType), v...))
+	})
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldEventType), v))
+	})
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldEventType), v))
+	})
+}
+
+// ActionIDEQ applies the EQ predicate on the "action_id" field.
+func ActionIDEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDNEQ applies the NEQ predicate on the "action_id" field.
+func ActionIDNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDIn applies the In predicate on the "action_id" field.
+func ActionIDIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDNotIn applies the NotIn predicate on the "action_id" field.
+func ActionIDNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActionID), v...))
+	})
+}
+
+// ActionIDGT applies the GT predicate on the "action_id" field.
+func ActionIDGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDGTE applies the GTE predicate on the "action_id" field.
+func ActionIDGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLT applies the LT predicate on the "action_id" field.
+func ActionIDLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDLTE applies the LTE predicate on the "action_id" field.
+func ActionIDLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContains applies the Contains predicate on the "action_id" field.
+func ActionIDContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasPrefix applies the HasPrefix predicate on the "action_id" field.
+func ActionIDHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDHasSuffix applies the HasSuffix predicate on the "action_id" field.
+func ActionIDHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDEqualFold applies the EqualFold predicate on the "action_id" field.
+func ActionIDEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActionID), v))
+	})
+}
+
+// ActionIDContainsFold applies the ContainsFold predicate on the "action_id" field.
+func ActionIDContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActionID), v))
+	})
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppID), v...))
+	})
+}
+
+// AppIDGT applies the GT predicate on the "app_id" field.
+func AppIDGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDGTE applies the GTE predicate on the "app_id" field.
+func AppIDGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLT applies the LT predicate on the "app_id" field.
+func AppIDLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDLTE applies the LTE predicate on the "app_id" field.
+func AppIDLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDContains applies the Contains predicate on the "app_id" field.
+func AppIDContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDHasPrefix applies the HasPrefix predicate on the "app_id" field.
+func AppIDHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDHasSuffix applies the HasSuffix predicate on the "app_id" field.
+func AppIDHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDEqualFold applies the EqualFold predicate on the "app_id" field.
+func AppIDEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAppID), v))
+	})
+}
+
+// AppIDContainsFold applies the ContainsFold predicate on the "app_id" field.
+func AppIDContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAppID), v))
+	})
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUserID), v...))
+	})
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldUserID), v))
+	})
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldUserID), v))
+	})
+}
+
+// TemplateIDEQ applies the EQ predicate on the "template_id" field.
+func TemplateIDEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDNEQ applies the NEQ predicate on the "template_id" field.
+func TemplateIDNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDIn applies the In predicate on the "template_id" field.
+func TemplateIDIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTemplateID), v...))
+	})
+}
+
+// TemplateIDNotIn applies the NotIn predicate on the "template_id" field.
+func TemplateIDNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTemplateID), v...))
+	})
+}
+
+// TemplateIDGT applies the GT predicate on the "template_id" field.
+func TemplateIDGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDGTE applies the GTE predicate on the "template_id" field.
+func TemplateIDGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDLT applies the LT predicate on the "template_id" field.
+func TemplateIDLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDLTE applies the LTE predicate on the "template_id" field.
+func TemplateIDLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDContains applies the Contains predicate on the "template_id" field.
+func TemplateIDContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDHasPrefix applies the HasPrefix predicate on the "template_id" field.
+func TemplateIDHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDHasSuffix applies the HasSuffix predicate on the "template_id" field.
+func TemplateIDHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDEqualFold applies the EqualFold predicate on the "template_id" field.
+func TemplateIDEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTemplateID), v))
+	})
+}
+
+// TemplateIDContainsFold applies the ContainsFold predicate on the "template_id" field.
+func TemplateIDContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTemplateID), v))
+	})
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldVariant), v))
+	})
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldVariant), v))
+	})
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldVariant), v...))
+	})
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldVariant), v...))
+	})
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldVariant), v))
+	})
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldVariant), v))
+	})
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldVariant), v))
+	})
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldVariant), v))
+	})
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldVariant), v))
+	})
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldVariant), v))
+	})
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldVariant), v))
+	})
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldVariant), v))
+	})
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldVariant), v))
+	})
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOccurredAt), v...))
+	})
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOccurredAt), v...))
+	})
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOccurredAt), v))
+	})
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOccurredAt), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PushEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PushEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PushEvent) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PushEvent) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PushEvent) predicate.PushEvent {
+	return predicate.PushEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,411 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/pushevent"
+)
+
+// PushEventCreate is the builder for creating a PushEvent entity.
+type PushEventCreate struct {
+	config
+	mutation *PushEventMutation
+	hooks    []Hook
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (pec *PushEventCreate) SetTrackingID(s string) *PushEventCreate {
+	pec.mutation.SetTrackingID(s)
+	return pec
+}
+
+// SetEventType sets the "event_type" field.
+func (pec *PushEventCreate) SetEventType(s string) *PushEventCreate {
+	pec.mutation.SetEventType(s)
+	return pec
+}
+
+// SetActionID sets the "action_id" field.
+func (pec *PushEventCreate) SetActionID(s string) *PushEventCreate {
+	pec.mutation.SetActionID(s)
+	return pec
+}
+
+// SetAppID sets the "app_id" field.
+func (pec *PushEventCreate) SetAppID(s string) *PushEventCreate {
+	pec.mutation.SetAppID(s)
+	return pec
+}
+
+// SetUserID sets the "user_id" field.
+func (pec *PushEventCreate) SetUserID(s string) *PushEventCreate {
+	pec.mutation.SetUserID(s)
+	return pec
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pec *PushEventCreate) SetNillableUserID(s *string) *PushEventCreate {
+	if s != nil {
+		pec.SetUserID(*s)
+	}
+	return pec
+}
+
+// SetTemplateID sets the "template_id" field.
+func (pec *PushEventCreate) SetTemplateID(s string) *PushEventCreate {
+	pec.mutation.SetTemplateID(s)
+	return pec
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (pec *PushEventCreate) SetNillableTemplateID(s *string) *PushEventCreate {
+	if s != nil {
+		pec.SetTemplateID(*s)
+	}
+	return pec
+}
+
+// SetVariant sets the "variant" field.
+func (pec *PushEventCreate) SetVariant(s string) *PushEventCreate {
+	pec.mutation.SetVariant(s)
+	return pec
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (pec *PushEventCreate) SetNillableVariant(s *string) *PushEventCreate {
+	if s != nil {
+		pec.SetVariant(*s)
+	}
+	return pec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (pec *PushEventCreate) SetOccurredAt(t time.Time) *PushEventCreate {
+	pec.mutation.SetOccurredAt(t)
+	return pec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pec *PushEventCreate) SetCreatedAt(t time.Time) *PushEventCreate {
+	pec.mutation.SetCreatedAt(t)
+	return pec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pec *PushEventCreate) SetNillableCreatedAt(t *time.Time) *PushEventCreate {
+	if t != nil {
+		pec.SetCreatedAt(*t)
+	}
+	return pec
+}
+
+// Mutation returns the PushEventMutation object of the builder.
+func (pec *PushEventCreate) Mutation() *PushEventMutation {
+	return pec.mutation
+}
+
+// Save creates the PushEvent in the database.
+func (pec *PushEventCreate) Save(ctx context.Context) (*PushEvent, error) {
+	var (
+		err  error
+		node *PushEvent
+	)
+	pec.defaults()
+	if len(pec.hooks) == 0 {
+		if err = pec.check(); err != nil {
+			return nil, err
+		}
+		node, err = pec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PushEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pec.check(); err != nil {
+				return nil, err
+			}
+			pec.mutation = mutation
+			if node, err = pec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pec.hooks) - 1; i >= 0; i-- {
+			if pec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pec.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pec.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pec *PushEventCreate) SaveX(ctx context.Context) *PushEvent {
+	v, err := pec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pec *PushEventCreate) Exec(ctx context.Context) error {
+	_, err := pec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pec *PushEventCreate) ExecX(ctx context.Context) {
+	if err := pec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pec *PushEventCreate) defaults() {
+	if _, ok := pec.mutation.UserID(); !ok {
+		v := pushevent.DefaultUserID
+		pec.mutation.SetUserID(v)
+	}
+	if _, ok := pec.mutation.TemplateID(); !ok {
+		v := pushevent.DefaultTemplateID
+		pec.mutation.SetTemplateID(v)
+	}
+	if _, ok := pec.mutation.Variant(); !ok {
+		v := pushevent.DefaultVariant
+		pec.mutation.SetVariant(v)
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		v := pushevent.DefaultCreatedAt()
+		pec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pec *PushEventCreate) check() error {
+	if _, ok := pec.mutation.TrackingID(); !ok {
+		return &ValidationError{Name: "tracking_id", err: errors.New(`ent: missing required field "PushEvent.tracking_id"`)}
+	}
+	if _, ok := pec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "PushEvent.event_type"`)}
+	}
+	if _, ok := pec.mutation.ActionID(); !ok {
+		return &ValidationError{Name: "action_id", err: errors.New(`ent: missing required field "PushEvent.action_id"`)}
+	}
+	if _, ok := pec.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "PushEvent.app_id"`)}
+	}
+	if _, ok := pec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PushEvent.user_id"`)}
+	}
+	if _, ok := pec.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`ent: missing required field "PushEvent.template_id"`)}
+	}
+	if _, ok := pec.mutation.Variant(); !ok {
+		return &ValidationError{Name: "variant", err: errors.New(`ent: missing required field "PushEvent.variant"`)}
+	}
+	if _, ok := pec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "PushEvent.occurred_at"`)}
+	}
+	if _, ok := pec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PushEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (pec *PushEventCreate) sqlSave(ctx context.Context) (*PushEvent, error) {
+	_node, _spec := pec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pec *PushEventCreate) createSpec() (*PushEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PushEvent{config: pec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: pushevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pushevent.FieldID,
+			},
+		}
+	)
+	if value, ok := pec.mutation.TrackingID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTrackingID,
+		})
+		_node.TrackingID = value
+	}
+	if value, ok := pec.mutation.EventType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldEventType,
+		})
+		_node.EventType = value
+	}
+	if value, ok := pec.mutation.ActionID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldActionID,
+		})
+		_node.ActionID = value
+	}
+	if value, ok := pec.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := pec.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := pec.mutation.TemplateID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTemplateID,
+		})
+		_node.TemplateID = value
+	}
+	if value, ok := pec.mutation.Variant(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldVariant,
+		})
+		_node.Variant = value
+	}
+	if value, ok := pec.mutation.OccurredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldOccurredAt,
+		})
+		_node.OccurredAt = value
+	}
+	if value, ok := pec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PushEventCreateBulk is the builder for creating many PushEvent entities in bulk.
+type PushEventCreateBulk struct {
+	config
+	builders []*PushEventCreate
+}
+
+// Save creates the PushEvent entities in the database.
+func (pecb *PushEventCreateBulk) Save(ctx context.Context) ([]*PushEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pecb.builders))
+	nodes := make([]*PushEvent, len(pecb.builders))
+	mutators := make([]Mutator, len(pecb.builders))
+	for i := range pecb.builders {
+		func(i int, root context.Context) {
+			builder := pecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PushEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pecb *PushEventCreateBulk) SaveX(ctx context.Context) []*PushEvent {
+	v, err := pecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pecb *PushEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := pecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pecb *PushEventCreateBulk) ExecX(ctx context.Context) {
+	if err := pecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,538 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/pushevent"
+)
+
+// PushEventUpdate is the builder for updating PushEvent entities.
+type PushEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PushEventMutation
+}
+
+// Where appends a list predicates to the PushEventUpdate builder.
+func (peu *PushEventUpdate) Where(ps ...predicate.PushEvent) *PushEventUpdate {
+	peu.mutation.Where(ps...)
+	return peu
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (peu *PushEventUpdate) SetTrackingID(s string) *PushEventUpdate {
+	peu.mutation.SetTrackingID(s)
+	return peu
+}
+
+// SetEventType sets the "event_type" field.
+func (peu *PushEventUpdate) SetEventType(s string) *PushEventUpdate {
+	peu.mutation.SetEventType(s)
+	return peu
+}
+
+// SetActionID sets the "action_id" field.
+func (peu *PushEventUpdate) SetActionID(s string) *PushEventUpdate {
+	peu.mutation.SetActionID(s)
+	return peu
+}
+
+// SetAppID sets the "app_id" field.
+func (peu *PushEventUpdate) SetAppID(s string) *PushEventUpdate {
+	peu.mutation.SetAppID(s)
+	return peu
+}
+
+// SetUserID sets the "user_id" field.
+func (peu *PushEventUpdate) SetUserID(s string) *PushEventUpdate {
+	peu.mutation.SetUserID(s)
+	return peu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (peu *PushEventUpdate) SetNillableUserID(s *string) *PushEventUpdate {
+	if s != nil {
+		peu.SetUserID(*s)
+	}
+	return peu
+}
+
+// SetTemplateID sets the "template_id" field.
+func (peu *PushEventUpdate) SetTemplateID(s string) *PushEventUpdate {
+	peu.mutation.SetTemplateID(s)
+	return peu
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (peu *PushEventUpdate) SetNillableTemplateID(s *string) *PushEventUpdate {
+	if s != nil {
+		peu.SetTemplateID(*s)
+	}
+	return peu
+}
+
+// SetVariant sets the "variant" field.
+func (peu *PushEventUpdate) SetVariant(s string) *PushEventUpdate {
+	peu.mutation.SetVariant(s)
+	return peu
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (peu *PushEventUpdate) SetNillableVariant(s *string) *PushEventUpdate {
+	if s != nil {
+		peu.SetVariant(*s)
+	}
+	return peu
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (peu *PushEventUpdate) SetOccurredAt(t time.Time) *PushEventUpdate {
+	peu.mutation.SetOccurredAt(t)
+	return peu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (peu *PushEventUpdate) SetCreatedAt(t time.Time) *PushEventUpdate {
+	peu.mutation.SetCreatedAt(t)
+	return peu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (peu *PushEventUpdate) SetNillableCreatedAt(t *time.Time) *PushEventUpdate {
+	if t != nil {
+		peu.SetCreatedAt(*t)
+	}
+	return peu
+}
+
+// Mutation returns the PushEventMutation object of the builder.
+func (peu *PushEventUpdate) Mutation() *PushEventMutation {
+	return peu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (peu *PushEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(peu.hooks) == 0 {
+		affected, err = peu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PushEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			peu.mutation = mutation
+			affected, err = peu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(peu.hooks) - 1; i >= 0; i-- {
+			if peu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = peu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, peu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peu *PushEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := peu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (peu *PushEventUpdate) Exec(ctx context.Context) error {
+	_, err := peu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peu *PushEventUpdate) ExecX(ctx context.Context) {
+	if err := peu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (peu *PushEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pushevent.Table,
+			Columns: pushevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pushevent.FieldID,
+			},
+		},
+	}
+	if ps := peu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peu.mutation.TrackingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTrackingID,
+		})
+	}
+	if value, ok := peu.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldEventType,
+		})
+	}
+	if value, ok := peu.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldActionID,
+		})
+	}
+	if value, ok := peu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldAppID,
+		})
+	}
+	if value, ok := peu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldUserID,
+		})
+	}
+	if value, ok := peu.mutation.TemplateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTemplateID,
+		})
+	}
+	if value, ok := peu.mutation.Variant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldVariant,
+		})
+	}
+	if value, ok := peu.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldOccurredAt,
+		})
+	}
+	if value, ok := peu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldCreatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, peu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PushEventUpdateOne is the builder for updating a single PushEvent entity.
+type PushEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PushEventMutation
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (peuo *PushEventUpdateOne) SetTrackingID(s string) *PushEventUpdateOne {
+	peuo.mutation.SetTrackingID(s)
+	return peuo
+}
+
+// SetEventType sets the "event_type" field.
+func (peuo *PushEventUpdateOne) SetEventType(s string) *PushEventUpdateOne {
+	peuo.mutation.SetEventType(s)
+	return peuo
+}
+
+// SetActionID sets the "action_id" field.
+func (peuo *PushEventUpdateOne) SetActionID(s string) *PushEventUpdateOne {
+	peuo.mutation.SetActionID(s)
+	return peuo
+}
+
+// SetAppID sets the "app_id" field.
+func (peuo *PushEventUpdateOne) SetAppID(s string) *PushEventUpdateOne {
+	peuo.mutation.SetAppID(s)
+	return peuo
+}
+
+// SetUserID sets the "user_id" field.
+func (peuo *PushEventUpdateOne) SetUserID(s string) *PushEventUpdateOne {
+	peuo.mutation.SetUserID(s)
+	return peuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (peuo *PushEventUpdateOne) SetNillableUserID(s *string) *PushEventUpdateOne {
+	if s != nil {
+		peuo.SetUserID(*s)
+	}
+	return peuo
+}
+
+// SetTemplateID sets the "template_id" field.
+func (peuo *PushEventUpdateOne) SetTemplateID(s string) *PushEventUpdateOne {
+	peuo.mutation.SetTemplateID(s)
+	return peuo
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (peuo *PushEventUpdateOne) SetNillableTemplateID(s *string) *PushEventUpdateOne {
+	if s != nil {
+		peuo.SetTemplateID(*s)
+	}
+	return peuo
+}
+
+// SetVariant sets the "variant" field.
+func (peuo *PushEventUpdateOne) SetVariant(s string) *PushEventUpdateOne {
+	peuo.mutation.SetVariant(s)
+	return peuo
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (peuo *PushEventUpdateOne) SetNillableVariant(s *string) *PushEventUpdateOne {
+	if s != nil {
+		peuo.SetVariant(*s)
+	}
+	return peuo
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (peuo *PushEventUpdateOne) SetOccurredAt(t time.Time) *PushEventUpdateOne {
+	peuo.mutation.SetOccurredAt(t)
+	return peuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (peuo *PushEventUpdateOne) SetCreatedAt(t time.Time) *PushEventUpdateOne {
+	peuo.mutation.SetCreatedAt(t)
+	return peuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (peuo *PushEventUpdateOne) SetNillableCreatedAt(t *time.Time) *PushEventUpdateOne {
+	if t != nil {
+		peuo.SetCreatedAt(*t)
+	}
+	return peuo
+}
+
+// Mutation returns the PushEventMutation object of the builder.
+func (peuo *PushEventUpdateOne) Mutation() *PushEventMutation {
+	return peuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (peuo *PushEventUpdateOne) Select(field string, fields ...string) *PushEventUpdateOne {
+	peuo.fields = append([]string{field}, fields...)
+	return peuo
+}
+
+// Save executes the query and returns the updated PushEvent entity.
+func (peuo *PushEventUpdateOne) Save(ctx context.Context) (*PushEvent, error) {
+	var (
+		err  error
+		node *PushEvent
+	)
+	if len(peuo.hooks) == 0 {
+		node, err = peuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PushEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			peuo.mutation = mutation
+			node, err = peuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(peuo.hooks) - 1; i >= 0; i-- {
+			if peuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = peuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, peuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (peuo *PushEventUpdateOne) SaveX(ctx context.Context) *PushEvent {
+	node, err := peuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (peuo *PushEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := peuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (peuo *PushEventUpdateOne) ExecX(ctx context.Context) {
+	if err := peuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (peuo *PushEventUpdateOne) sqlSave(ctx context.Context) (_node *PushEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   pushevent.Table,
+			Columns: pushevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: pushevent.FieldID,
+			},
+		},
+	}
+	id, ok := peuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PushEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := peuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pushevent.FieldID)
+		for _, f := range fields {
+			if !pushevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pushevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := peuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := peuo.mutation.TrackingID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTrackingID,
+		})
+	}
+	if value, ok := peuo.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldEventType,
+		})
+	}
+	if value, ok := peuo.mutation.ActionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldActionID,
+		})
+	}
+	if value, ok := peuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldAppID,
+		})
+	}
+	if value, ok := peuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldUserID,
+		})
+	}
+	if value, ok := peuo.mutation.TemplateID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldTemplateID,
+		})
+	}
+	if value, ok := peuo.mutation.Variant(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pushevent.FieldVariant,
+		})
+	}
+	if value, ok := peuo.mutation.OccurredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldOccurredAt,
+		})
+	}
+	if value, ok := peuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: pushevent.FieldCreatedAt,
+		})
+	}
+	_node = &PushEvent{config: peuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, peuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pushevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -5,14 +5,175 @@ package ent
 import (
 	"time"
 
+	"github.com/shitamachi/push-service/ent/apikey"
+	"github.com/shitamachi/push-service/ent/app"
+	"github.com/shitamachi/push-service/ent/audience"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+	"github.com/shitamachi/push-service/ent/auditlog"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/ent/pushevent"
 	"github.com/shitamachi/push-service/ent/schema"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
 	"github.com/shitamachi/push-service/ent/userpushtoken"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+	"github.com/shitamachi/push-service/ent/webhookdelivery"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[11].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyFields[12].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	appFields := schema.App{}.Fields()
+	_ = appFields
+	// appDescEnabled is the schema descriptor for enabled field.
+	appDescEnabled := appFields[4].Descriptor()
+	// app.DefaultEnabled holds the default value on creation for the enabled field.
+	app.DefaultEnabled = appDescEnabled.Default.(bool)
+	// appDescRevision is the schema descriptor for revision field.
+	appDescRevision := appFields[5].Descriptor()
+	// app.DefaultRevision holds the default value on creation for the revision field.
+	app.DefaultRevision = appDescRevision.Default.(int64)
+	// appDescCreatedAt is the schema descriptor for created_at field.
+	appDescCreatedAt := appFields[6].Descriptor()
+	// app.DefaultCreatedAt holds the default value on creation for the created_at field.
+	app.DefaultCreatedAt = appDescCreatedAt.Default.(func() time.Time)
+	// appDescUpdatedAt is the schema descriptor for updated_at field.
+	appDescUpdatedAt := appFields[7].Descriptor()
+	// app.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	app.DefaultUpdatedAt = appDescUpdatedAt.Default.(func() time.Time)
+	// app.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	app.UpdateDefaultUpdatedAt = appDescUpdatedAt.UpdateDefault.(func() time.Time)
+	audienceFields := schema.Audience{}.Fields()
+	_ = audienceFields
+	// audienceDescName is the schema descriptor for name field.
+	audienceDescName := audienceFields[0].Descriptor()
+	// audience.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	audience.NameValidator = audienceDescName.Validators[0].(func(string) error)
+	// audienceDescDescription is the schema descriptor for description field.
+	audienceDescDescription := audienceFields[1].Descriptor()
+	// audience.DefaultDescription holds the default value on creation for the description field.
+	audience.DefaultDescription = audienceDescDescription.Default.(string)
+	// audienceDescMemberCount is the schema descriptor for member_count field.
+	audienceDescMemberCount := audienceFields[5].Descriptor()
+	// audience.DefaultMemberCount holds the default value on creation for the member_count field.
+	audience.DefaultMemberCount = audienceDescMemberCount.Default.(int64)
+	// audienceDescCreatedAt is the schema descriptor for created_at field.
+	audienceDescCreatedAt := audienceFields[6].Descriptor()
+	// audience.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audience.DefaultCreatedAt = audienceDescCreatedAt.Default.(func() time.Time)
+	// audienceDescUpdatedAt is the schema descriptor for updated_at field.
+	audienceDescUpdatedAt := audienceFields[7].Descriptor()
+	// audience.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	audience.DefaultUpdatedAt = audienceDescUpdatedAt.Default.(func() time.Time)
+	// audience.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	audience.UpdateDefaultUpdatedAt = audienceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	audiencememberFields := schema.AudienceMember{}.Fields()
+	_ = audiencememberFields
+	// audiencememberDescCreatedAt is the schema descriptor for created_at field.
+	audiencememberDescCreatedAt := audiencememberFields[2].Descriptor()
+	// audiencemember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	audiencemember.DefaultCreatedAt = audiencememberDescCreatedAt.Default.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCallerKeyID is the schema descriptor for caller_key_id field.
+	auditlogDescCallerKeyID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCallerKeyID holds the default value on creation for the caller_key_id field.
+	auditlog.DefaultCallerKeyID = auditlogDescCallerKeyID.Default.(int)
+	// auditlogDescAudienceSize is the schema descriptor for audience_size field.
+	auditlogDescAudienceSize := auditlogFields[10].Descriptor()
+	// auditlog.DefaultAudienceSize holds the default value on creation for the audience_size field.
+	auditlog.DefaultAudienceSize = auditlogDescAudienceSize.Default.(int64)
+	// auditlogDescMessageSummary is the schema descriptor for message_summary field.
+	auditlogDescMessageSummary := auditlogFields[11].Descriptor()
+	// auditlog.MessageSummaryValidator is a validator for the "message_summary" field. It is called by the builders before save.
+	auditlog.MessageSummaryValidator = auditlogDescMessageSummary.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[14].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	deliveryresultFields := schema.DeliveryResult{}.Fields()
+	_ = deliveryresultFields
+	// deliveryresultDescUserID is the schema descriptor for user_id field.
+	deliveryresultDescUserID := deliveryresultFields[2].Descriptor()
+	// deliveryresult.DefaultUserID holds the default value on creation for the user_id field.
+	deliveryresult.DefaultUserID = deliveryresultDescUserID.Default.(string)
+	// deliveryresultDescCategory is the schema descriptor for category field.
+	deliveryresultDescCategory := deliveryresultFields[4].Descriptor()
+	// deliveryresult.DefaultCategory holds the default value on creation for the category field.
+	deliveryresult.DefaultCategory = deliveryresultDescCategory.Default.(string)
+	// deliveryresultDescVariant is the schema descriptor for variant field.
+	deliveryresultDescVariant := deliveryresultFields[5].Descriptor()
+	// deliveryresult.DefaultVariant holds the default value on creation for the variant field.
+	deliveryresult.DefaultVariant = deliveryresultDescVariant.Default.(string)
+	// deliveryresultDescTemplateID is the schema descriptor for template_id field.
+	deliveryresultDescTemplateID := deliveryresultFields[6].Descriptor()
+	// deliveryresult.DefaultTemplateID holds the default value on creation for the template_id field.
+	deliveryresult.DefaultTemplateID = deliveryresultDescTemplateID.Default.(string)
+	// deliveryresultDescTrackingID is the schema descriptor for tracking_id field.
+	deliveryresultDescTrackingID := deliveryresultFields[7].Descriptor()
+	// deliveryresult.DefaultTrackingID holds the default value on creation for the tracking_id field.
+	deliveryresult.DefaultTrackingID = deliveryresultDescTrackingID.Default.(string)
+	// deliveryresultDescReason is the schema descriptor for reason field.
+	deliveryresultDescReason := deliveryresultFields[9].Descriptor()
+	// deliveryresult.DefaultReason holds the default value on creation for the reason field.
+	deliveryresult.DefaultReason = deliveryresultDescReason.Default.(string)
+	// deliveryresultDescCreatedAt is the schema descriptor for created_at field.
+	deliveryresultDescCreatedAt := deliveryresultFields[11].Descriptor()
+	// deliveryresult.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deliveryresult.DefaultCreatedAt = deliveryresultDescCreatedAt.Default.(func() time.Time)
+	// deliveryresultDescUpdatedAt is the schema descriptor for updated_at field.
+	deliveryresultDescUpdatedAt := deliveryresultFields[12].Descriptor()
+	// deliveryresult.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deliveryresult.DefaultUpdatedAt = deliveryresultDescUpdatedAt.Default.(func() time.Time)
+	// deliveryresult.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deliveryresult.UpdateDefaultUpdatedAt = deliveryresultDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pusheventFields := schema.PushEvent{}.Fields()
+	_ = pusheventFields
+	// pusheventDescUserID is the schema descriptor for user_id field.
+	pusheventDescUserID := pusheventFields[4].Descriptor()
+	// pushevent.DefaultUserID holds the default value on creation for the user_id field.
+	pushevent.DefaultUserID = pusheventDescUserID.Default.(string)
+	// pusheventDescTemplateID is the schema descriptor for template_id field.
+	pusheventDescTemplateID := pusheventFields[5].Descriptor()
+	// pushevent.DefaultTemplateID holds the default value on creation for the template_id field.
+	pushevent.DefaultTemplateID = pusheventDescTemplateID.Default.(string)
+	// pusheventDescVariant is the schema descriptor for variant field.
+	pusheventDescVariant := pusheventFields[6].Descriptor()
+	// pushevent.DefaultVariant holds the default value on creation for the variant field.
+	pushevent.DefaultVariant = pusheventDescVariant.Default.(string)
+	// pusheventDescCreatedAt is the schema descriptor for created_at field.
+	pusheventDescCreatedAt := pusheventFields[8].Descriptor()
+	// pushevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushevent.DefaultCreatedAt = pusheventDescCreatedAt.Default.(func() time.Time)
+	usernotificationpreferenceFields := schema.UserNotificationPreference{}.Fields()
+	_ = usernotificationpreferenceFields
+	// usernotificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	usernotificationpreferenceDescEnabled := usernotificationpreferenceFields[3].Descriptor()
+	// usernotificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	usernotificationpreference.DefaultEnabled = usernotificationpreferenceDescEnabled.Default.(bool)
+	// usernotificationpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	usernotificationpreferenceDescCreatedAt := usernotificationpreferenceFields[4].Descriptor()
+	// usernotificationpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usernotificationpreference.DefaultCreatedAt = usernotificationpreferenceDescCreatedAt.Default.(func() time.Time)
+	// usernotificationpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	usernotificationpreferenceDescUpdatedAt := usernotificationpreferenceFields[5].Descriptor()
+	// usernotificationpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usernotificationpreference.DefaultUpdatedAt = usernotificationpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// usernotificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usernotificationpreference.UpdateDefaultUpdatedAt = usernotificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userpushtokenFields := schema.UserPushToken{}.Fields()
 	_ = userpushtokenFields
 	// userpushtokenDescCreatedAt is the schema descriptor for created_at field.
@@ -25,4 +186,60 @@ func init() {
 	userpushtoken.DefaultUpdatedAt = userpushtokenDescUpdatedAt.Default.(func() time.Time)
 	// userpushtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	userpushtoken.UpdateDefaultUpdatedAt = userpushtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userquiethoursFields := schema.UserQuietHours{}.Fields()
+	_ = userquiethoursFields
+	// userquiethoursDescEnabled is the schema descriptor for enabled field.
+	userquiethoursDescEnabled := userquiethoursFields[2].Descriptor()
+	// userquiethours.DefaultEnabled holds the default value on creation for the enabled field.
+	userquiethours.DefaultEnabled = userquiethoursDescEnabled.Default.(bool)
+	// userquiethoursDescTimezone is the schema descriptor for timezone field.
+	userquiethoursDescTimezone := userquiethoursFields[5].Descriptor()
+	// userquiethours.DefaultTimezone holds the default value on creation for the timezone field.
+	userquiethours.DefaultTimezone = userquiethoursDescTimezone.Default.(string)
+	// userquiethoursDescCreatedAt is the schema descriptor for created_at field.
+	userquiethoursDescCreatedAt := userquiethoursFields[6].Descriptor()
+	// userquiethours.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userquiethours.DefaultCreatedAt = userquiethoursDescCreatedAt.Default.(func() time.Time)
+	// userquiethoursDescUpdatedAt is the schema descriptor for updated_at field.
+	userquiethoursDescUpdatedAt := userquiethoursFields[7].Descriptor()
+	// userquiethours.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userquiethours.DefaultUpdatedAt = userquiethoursDescUpdatedAt.Default.(func() time.Time)
+	// userquiethours.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userquiethours.UpdateDefaultUpdatedAt = userquiethoursDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultError holds the default value on creation for the error field.
+	webhookdelivery.DefaultError = webhookdeliveryDescError.Default.(string)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescEnabled is the schema descriptor for enabled field.
+	webhooksubscriptionDescEnabled := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooksubscription.DefaultEnabled = webhooksubscriptionDescEnabled.Default.(bool)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionFields[6].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
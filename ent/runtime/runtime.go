@@ -5,6 +5,6 @@ package runtime
 // The schema-stitching logic is generated in github.com/shitamachi/push-service/ent/runtime.go
 
 const (
-	Version = "v0.8.0"                                          // Version of ent codegen.
-	Sum     = "h1:xirrW//1oda7pp0bz+XssSOv4/C3nmgYQOxjIfljFt8=" // Sum of ent codegen.
+	Version = "v0.10.2-0.20220502113020-4ac82f5bb3f0"           // Version of ent codegen.
+	Sum     = "h1:qHA4+ANAzDj6BcDLxNgZuzKxFre/RI9r5wwsI2O+1M4=" // Sum of ent codegen.
 )
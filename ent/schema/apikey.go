@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		// the first characters of key, it is used to identify the key without exposing it
+		field.String("prefix"),
+		// the sha256 of key, the key itself is only returned when it is created
+		field.String("key_hash").Unique().Sensitive(),
+		// the apps which the key can access, empty means all the apps
+		field.Strings("app_ids").Optional(),
+		// e.g. send, broadcast, tokens, metrics, admin
+		field.Strings("permissions"),
+		// the shared secret of HMAC request signature, the callers which can not keep the key safely sign requests with it.
+		// It is encrypted by auth.signing_secret_encryption_key if the key is set, see auth.EncryptSigningSecret
+		field.String("signing_secret").Optional().Sensitive(),
+		// the secret before rotating, it is still accepted until previous_signing_secret_expires_at
+		field.String("previous_signing_secret").Optional().Sensitive(),
+		field.Time("previous_signing_secret_expires_at").Optional().Nillable(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// App holds the schema definition for the App entity.
+type App struct {
+	ent.Schema
+}
+
+// Fields of the App.
+func (App) Fields() []ent.Field {
+	return []ent.Field{
+		// the bundle id of ios app or the package name of android app
+		field.String("app_id").Unique(),
+		field.String("name").Optional(),
+		field.Enum("push_type").Values("apple", "firebase"),
+		// the push credentials encrypted by the encryption key of app registry, they are never returned by the api
+		field.Bytes("credentials").Sensitive(),
+		field.Bool("enabled").Default(true),
+		// increased on every update by the hook in db.InitDB, the push client of app is rebuilt once it is changed
+		field.Int64("revision").Default(1),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the App.
+func (App) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Audience holds the schema definition for the Audience entity.
+type Audience struct {
+	ent.Schema
+}
+
+// Fields of the Audience.
+func (Audience) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("description").Default(""),
+		// users or segment, the users of users audience are stored in AudienceMember
+		field.String("type"),
+		// the segment expression of segment audience
+		field.Text("segment").Optional(),
+		// the audience only contains the devices of these apps, it is required by segment audience
+		field.Strings("app_ids").Optional(),
+		field.Int64("member_count").Default(0),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Audience.
+func (Audience) Edges() []ent.Edge {
+	return nil
+}
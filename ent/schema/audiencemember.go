@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AudienceMember holds the schema definition for the AudienceMember entity.
+type AudienceMember struct {
+	ent.Schema
+}
+
+// Fields of the AudienceMember.
+func (AudienceMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("audience_id"),
+		field.String("user_id"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the AudienceMember.
+func (AudienceMember) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AudienceMember.
+func (AudienceMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("audience_id", "user_id").Unique(),
+	}
+}
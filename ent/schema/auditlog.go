@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// the id of API key, 0 for the root key
+		field.Int("caller_key_id").Default(0),
+		field.String("caller_name"),
+		// the subject of user JWT if the caller is a user of mobile clients
+		field.String("caller_user_id").Optional(),
+		field.String("remote_addr").Optional(),
+		field.String("method"),
+		// the route of request, e.g. /v1/actions/:action_id/cancel
+		field.String("endpoint"),
+		// e.g. push.broadcast, action.cancel, api_key.create
+		field.String("operation"),
+		// the id of the changed entity, e.g. the id of api key or webhook subscription
+		field.String("target").Optional(),
+		field.String("action_id").Optional(),
+		field.Strings("app_ids").Optional(),
+		// the number of messages which are enqueued by the send operation
+		field.Int64("audience_size").Default(0),
+		field.String("message_summary").Optional().MaxLen(1024),
+		field.Int("status_code"),
+		field.String("result").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("operation", "created_at"),
+		index.Fields("caller_key_id", "created_at"),
+		index.Fields("action_id"),
+	}
+}
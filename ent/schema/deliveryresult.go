@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// DeliveryResult holds the schema definition for the DeliveryResult entity.
+type DeliveryResult struct {
+	ent.Schema
+}
+
+// Fields of the DeliveryResult.
+func (DeliveryResult) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action_id"),
+		field.String("app_id"),
+		field.String("user_id").Default(""),
+		field.String("token"),
+		field.String("category").Default(""),
+		// the variant of message when the action is an A/B test
+		field.String("variant").Default(""),
+		// the template of message, used to report open rates by template
+		field.String("template_id").Default(""),
+		// the tracking id injected into the data of message, client apps report events with it
+		field.String("tracking_id").Default(""),
+		// succeeded, failed or suppressed
+		field.String("status"),
+		// the reason why the message is failed or suppressed, e.g. capped, cancelled, expired
+		field.String("reason").Default(""),
+		field.Text("platform_resp").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DeliveryResult.
+func (DeliveryResult) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DeliveryResult.
+func (DeliveryResult) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("action_id", "token").Unique(),
+		index.Fields("action_id", "status"),
+		index.Fields("action_id", "variant"),
+		index.Fields("tracking_id"),
+		index.Fields("app_id", "created_at"),
+		index.Fields("template_id", "created_at"),
+	}
+}
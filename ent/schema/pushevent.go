@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// PushEvent holds the schema definition for the PushEvent entity.
+type PushEvent struct {
+	ent.Schema
+}
+
+// Fields of the PushEvent.
+func (PushEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("tracking_id"),
+		// opened or dismissed
+		field.String("event_type"),
+		// the fields below are copied from the delivery result of tracking id
+		field.String("action_id"),
+		field.String("app_id"),
+		field.String("user_id").Default(""),
+		field.String("template_id").Default(""),
+		field.String("variant").Default(""),
+		// the time when the event happened on the device
+		field.Time("occurred_at"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the PushEvent.
+func (PushEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PushEvent.
+func (PushEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tracking_id", "event_type").Unique(),
+		index.Fields("action_id", "event_type"),
+		index.Fields("app_id", "created_at"),
+		index.Fields("template_id", "created_at"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// UserNotificationPreference holds the schema definition for the UserNotificationPreference entity.
+type UserNotificationPreference struct {
+	ent.Schema
+}
+
+// Fields of the UserNotificationPreference.
+func (UserNotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("app_id"),
+		// the category of message, e.g. promotions, order_updates
+		field.String("category"),
+		// false means the user has opted out the category
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the UserNotificationPreference.
+func (UserNotificationPreference) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserNotificationPreference.
+func (UserNotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "app_id", "category").Unique(),
+		index.Fields("app_id", "category", "enabled"),
+	}
+}
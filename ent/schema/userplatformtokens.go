@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // UserPlatformTokens holds the schema definition for the UserPlatformTokens entity.
@@ -18,6 +19,21 @@ func (UserPlatformTokens) Fields() []ent.Field {
 		field.String("device_id"),
 		field.String("token"),
 		field.String("app_id"),
+		// IANA time zone name of the device, e.g. Asia/Shanghai
+		field.String("timezone").Optional(),
+		field.String("app_version").Optional(),
+		// app_version normalized to a sortable number, see segment.NormalizeVersion
+		field.Int64("app_version_num").Optional(),
+		field.String("os_version").Optional(),
+		// os_version normalized to a sortable number, see segment.NormalizeVersion
+		field.Int64("os_version_num").Optional(),
+		// BCP 47 language tag of the device, e.g. ja-JP
+		field.String("locale").Optional(),
+		// ISO 3166-1 alpha-2 country code of the device, e.g. JP
+		field.String("country").Optional(),
+		field.Time("last_active_at").Optional().Nillable(),
+		// custom tags of the device which can be used to build segments
+		field.Strings("tags").Optional(),
 		field.Time("created_at"),
 		field.Time("updated_at"),
 	}
@@ -27,3 +43,11 @@ func (UserPlatformTokens) Fields() []ent.Field {
 func (UserPlatformTokens) Edges() []ent.Edge {
 	return nil
 }
+
+// Indexes of the UserPlatformTokens.
+func (UserPlatformTokens) Indexes() []ent.Index {
+	return []ent.Index{
+		// a device only has one token for each app
+		index.Fields("app_id", "device_id").Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// UserQuietHours holds the schema definition for the UserQuietHours entity.
+type UserQuietHours struct {
+	ent.Schema
+}
+
+// Fields of the UserQuietHours.
+func (UserQuietHours) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id"),
+		field.String("app_id"),
+		// the user can turn off the quiet hours of the app
+		field.Bool("enabled").Default(true),
+		// HH:MM in the time zone
+		field.String("start"),
+		field.String("end"),
+		// IANA time zone name, the time zone of device will be used if it is empty
+		field.String("timezone").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the UserQuietHours.
+func (UserQuietHours) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserQuietHours.
+func (UserQuietHours) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "app_id").Unique(),
+	}
+}
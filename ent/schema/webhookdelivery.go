@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("subscription_id"),
+		// the id of event, the same event delivered to different subscriptions has the same id
+		field.String("event_id"),
+		field.String("event_type"),
+		field.Text("payload"),
+		// pending, retrying, succeeded or failed
+		field.String("status"),
+		// the number of http requests sent for the delivery
+		field.Int("attempts").Default(0),
+		// the http status code of the last request
+		field.Int("response_code").Optional(),
+		// the error of the last request
+		field.String("error").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subscription_id", "created_at"),
+		index.Fields("event_id"),
+	}
+}
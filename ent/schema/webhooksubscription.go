@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WebhookSubscription holds the schema definition for the WebhookSubscription entity.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+// Fields of the WebhookSubscription.
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("app_id"),
+		field.String("url"),
+		// the key of HMAC signature of payload
+		field.String("secret").Sensitive(),
+		// the subscribed event types, e.g. delivery.failed, token.invalidated
+		field.Strings("events"),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookSubscription.
+func (WebhookSubscription) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the WebhookSubscription.
+func (WebhookSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "enabled"),
+	}
+}
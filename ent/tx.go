@@ -12,10 +12,32 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// App is the client for interacting with the App builders.
+	App *AppClient
+	// Audience is the client for interacting with the Audience builders.
+	Audience *AudienceClient
+	// AudienceMember is the client for interacting with the AudienceMember builders.
+	AudienceMember *AudienceMemberClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// DeliveryResult is the client for interacting with the DeliveryResult builders.
+	DeliveryResult *DeliveryResultClient
+	// PushEvent is the client for interacting with the PushEvent builders.
+	PushEvent *PushEventClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// UserPlatformTokens is the client for interacting with the UserPlatformTokens builders.
 	UserPlatformTokens *UserPlatformTokensClient
 	// UserPushToken is the client for interacting with the UserPushToken builders.
 	UserPushToken *UserPushTokenClient
+	// UserQuietHours is the client for interacting with the UserQuietHours builders.
+	UserQuietHours *UserQuietHoursClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -32,7 +54,7 @@ type Tx struct {
 }
 
 type (
-	// Committer is the interface that wraps the Committer method.
+	// Committer is the interface that wraps the Commit method.
 	Committer interface {
 		Commit(context.Context, *Tx) error
 	}
@@ -46,7 +68,7 @@ type (
 	// and returns a Committer. For example:
 	//
 	//	hook := func(next ent.Committer) ent.Committer {
-	//		return ent.CommitFunc(func(context.Context, tx *ent.Tx) error {
+	//		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
 	//			// Do some stuff before.
 	//			if err := next.Commit(ctx, tx); err != nil {
 	//				return err
@@ -87,7 +109,7 @@ func (tx *Tx) OnCommit(f CommitHook) {
 }
 
 type (
-	// Rollbacker is the interface that wraps the Rollbacker method.
+	// Rollbacker is the interface that wraps the Rollback method.
 	Rollbacker interface {
 		Rollback(context.Context, *Tx) error
 	}
@@ -101,7 +123,7 @@ type (
 	// and returns a Rollbacker. For example:
 	//
 	//	hook := func(next ent.Rollbacker) ent.Rollbacker {
-	//		return ent.RollbackFunc(func(context.Context, tx *ent.Tx) error {
+	//		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
 	//			// Do some stuff before.
 	//			if err := next.Rollback(ctx, tx); err != nil {
 	//				return err
@@ -151,8 +173,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.App = NewAppClient(tx.config)
+	tx.Audience = NewAudienceClient(tx.config)
+	tx.AudienceMember = NewAudienceMemberClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.DeliveryResult = NewDeliveryResultClient(tx.config)
+	tx.PushEvent = NewPushEventClient(tx.config)
+	tx.UserNotificationPreference = NewUserNotificationPreferenceClient(tx.config)
 	tx.UserPlatformTokens = NewUserPlatformTokensClient(tx.config)
 	tx.UserPushToken = NewUserPushTokenClient(tx.config)
+	tx.UserQuietHours = NewUserQuietHoursClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -162,7 +195,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: UserPlatformTokens.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
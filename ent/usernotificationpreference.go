@@ -0,0 +1,154 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
+)
+
+// UserNotificationPreference is the model entity for the UserNotificationPreference schema.
+type UserNotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserNotificationPreference) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usernotificationpreference.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case usernotificationpreference.FieldID:
+			values[i] = new(sql.NullInt64)
+		case usernotificationpreference.FieldUserID, usernotificationpreference.FieldAppID, usernotificationpreference.FieldCategory:
+			values[i] = new(sql.NullString)
+		case usernotificationpreference.FieldCreatedAt, usernotificationpreference.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type UserNotificationPreference", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserNotificationPreference fields.
+func (unp *UserNotificationPreference) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usernotificationpreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			unp.ID = int(value.Int64)
+		case usernotificationpreference.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				unp.UserID = value.String
+			}
+		case usernotificationpreference.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				unp.AppID = value.String
+			}
+		case usernotificationpreference.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				unp.Category = value.String
+			}
+		case usernotificationpreference.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				unp.Enabled = value.Bool
+			}
+		case usernotificationpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				unp.CreatedAt = value.Time
+			}
+		case usernotificationpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				unp.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this UserNotificationPreference.
+// Note that you need to call UserNotificationPreference.Unwrap() before calling this method if this UserNotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (unp *UserNotificationPreference) Update() *UserNotificationPreferenceUpdateOne {
+	return (&UserNotificationPreferenceClient{config: unp.config}).UpdateOne(unp)
+}
+
+// Unwrap unwraps the UserNotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (unp *UserNotificationPreference) Unwrap() *UserNotificationPreference {
+	tx, ok := unp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserNotificationPreference is not a transactional entity")
+	}
+	unp.config.driver = tx.drv
+	return unp
+}
+
+// String implements the fmt.Stringer.
+func (unp *UserNotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserNotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v", unp.ID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(unp.UserID)
+	builder.WriteString(", app_id=")
+	builder.WriteString(unp.AppID)
+	builder.WriteString(", category=")
+	builder.WriteString(unp.Category)
+	builder.WriteString(", enabled=")
+	builder.WriteString(fmt.Sprintf("%v", unp.Enabled))
+	builder.WriteString(", created_at=")
+	builder.WriteString(unp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(unp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserNotificationPreferences is a parsable slice of UserNotificationPreference.
+type UserNotificationPreferences []*UserNotificationPreference
+
+func (unp UserNotificationPreferences) config(cfg config) {
+	for _i := range unp {
+		unp[_i].config = cfg
+	}
+}
@@ -0,0 +1,348 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
+)
+
+// UserNotificationPreferenceCreate is the builder for creating a UserNotificationPreference entity.
+type UserNotificationPreferenceCreate struct {
+	config
+	mutation *UserNotificationPreferenceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (unpc *UserNotificationPreferenceCreate) SetUserID(s string) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetUserID(s)
+	return unpc
+}
+
+// SetAppID sets the "app_id" field.
+func (unpc *UserNotificationPreferenceCreate) SetAppID(s string) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetAppID(s)
+	return unpc
+}
+
+// SetCategory sets the "category" field.
+func (unpc *UserNotificationPreferenceCreate) SetCategory(s string) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetCategory(s)
+	return unpc
+}
+
+// SetEnabled sets the "enabled" field.
+func (unpc *UserNotificationPreferenceCreate) SetEnabled(b bool) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetEnabled(b)
+	return unpc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (unpc *UserNotificationPreferenceCreate) SetNillableEnabled(b *bool) *UserNotificationPreferenceCreate {
+	if b != nil {
+		unpc.SetEnabled(*b)
+	}
+	return unpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (unpc *UserNotificationPreferenceCreate) SetCreatedAt(t time.Time) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetCreatedAt(t)
+	return unpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (unpc *UserNotificationPreferenceCreate) SetNillableCreatedAt(t *time.Time) *UserNotificationPreferenceCreate {
+	if t != nil {
+		unpc.SetCreatedAt(*t)
+	}
+	return unpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (unpc *UserNotificationPreferenceCreate) SetUpdatedAt(t time.Time) *UserNotificationPreferenceCreate {
+	unpc.mutation.SetUpdatedAt(t)
+	return unpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (unpc *UserNotificationPreferenceCreate) SetNillableUpdatedAt(t *time.Time) *UserNotificationPreferenceCreate {
+	if t != nil {
+		unpc.SetUpdatedAt(*t)
+	}
+	return unpc
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (unpc *UserNotificationPreferenceCreate) Mutation() *UserNotificationPreferenceMutation {
+	return unpc.mutation
+}
+
+// Save creates the UserNotificationPreference in the database.
+func (unpc *UserNotificationPreferenceCreate) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	var (
+		err  error
+		node *UserNotificationPreference
+	)
+	unpc.defaults()
+	if len(unpc.hooks) == 0 {
+		if err = unpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = unpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserNotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = unpc.check(); err != nil {
+				return nil, err
+			}
+			unpc.mutation = mutation
+			if node, err = unpc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(unpc.hooks) - 1; i >= 0; i-- {
+			if unpc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = unpc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, unpc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (unpc *UserNotificationPreferenceCreate) SaveX(ctx context.Context) *UserNotificationPreference {
+	v, err := unpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (unpc *UserNotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := unpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (unpc *UserNotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := unpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (unpc *UserNotificationPreferenceCreate) defaults() {
+	if _, ok := unpc.mutation.Enabled(); !ok {
+		v := usernotificationpreference.DefaultEnabled
+		unpc.mutation.SetEnabled(v)
+	}
+	if _, ok := unpc.mutation.CreatedAt(); !ok {
+		v := usernotificationpreference.DefaultCreatedAt()
+		unpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := unpc.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.DefaultUpdatedAt()
+		unpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (unpc *UserNotificationPreferenceCreate) check() error {
+	if _, ok := unpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserNotificationPreference.user_id"`)}
+	}
+	if _, ok := unpc.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "UserNotificationPreference.app_id"`)}
+	}
+	if _, ok := unpc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "UserNotificationPreference.category"`)}
+	}
+	if _, ok := unpc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "UserNotificationPreference.enabled"`)}
+	}
+	if _, ok := unpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserNotificationPreference.created_at"`)}
+	}
+	if _, ok := unpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserNotificationPreference.updated_at"`)}
+	}
+	return nil
+}
+
+func (unpc *UserNotificationPreferenceCreate) sqlSave(ctx context.Context) (*UserNotificationPreference, error) {
+	_node, _spec := unpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, unpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (unpc *UserNotificationPreferenceCreate) createSpec() (*UserNotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserNotificationPreference{config: unpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: usernotificationpreference.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usernotificationpreference.FieldID,
+			},
+		}
+	)
+	if value, ok := unpc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := unpc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := unpc.mutation.Category(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldCategory,
+		})
+		_node.Category = value
+	}
+	if value, ok := unpc.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: usernotificationpreference.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := unpc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := unpc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserNotificationPreferenceCreateBulk is the builder for creating many UserNotificationPreference entities in bulk.
+type UserNotificationPreferenceCreateBulk struct {
+	config
+	builders []*UserNotificationPreferenceCreate
+}
+
+// Save creates the UserNotificationPreference entities in the database.
+func (unpcb *UserNotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*UserNotificationPreference, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(unpcb.builders))
+	nodes := make([]*UserNotificationPreference, len(unpcb.builders))
+	mutators := make([]Mutator, len(unpcb.builders))
+	for i := range unpcb.builders {
+		func(i int, root context.Context) {
+			builder := unpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserNotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, unpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, unpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, unpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (unpcb *UserNotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*UserNotificationPreference {
+	v, err := unpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (unpcb *UserNotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := unpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (unpcb *UserNotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := unpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
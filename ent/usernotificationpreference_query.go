@@ -0,0 +1,526 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
+)
+
+// UserNotificationPreferenceQuery is the builder for querying UserNotificationPreference entities.
+type UserNotificationPreferenceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UserNotificationPreference
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserNotificationPreferenceQuery builder.
+func (unpq *UserNotificationPreferenceQuery) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceQuery {
+	unpq.predicates = append(unpq.predicates, ps...)
+	return unpq
+}
+
+// Limit adds a limit step to the query.
+func (unpq *UserNotificationPreferenceQuery) Limit(limit int) *UserNotificationPreferenceQuery {
+	unpq.limit = &limit
+	return unpq
+}
+
+// Offset adds an offset step to the query.
+func (unpq *UserNotificationPreferenceQuery) Offset(offset int) *UserNotificationPreferenceQuery {
+	unpq.offset = &offset
+	return unpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (unpq *UserNotificationPreferenceQuery) Unique(unique bool) *UserNotificationPreferenceQuery {
+	unpq.unique = &unique
+	return unpq
+}
+
+// Order adds an order step to the query.
+func (unpq *UserNotificationPreferenceQuery) Order(o ...OrderFunc) *UserNotificationPreferenceQuery {
+	unpq.order = append(unpq.order, o...)
+	return unpq
+}
+
+// First returns the first UserNotificationPreference entity from the query.
+// Returns a *NotFoundError when no UserNotificationPreference was found.
+func (unpq *UserNotificationPreferenceQuery) First(ctx context.Context) (*UserNotificationPreference, error) {
+	nodes, err := unpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usernotificationpreference.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) FirstX(ctx context.Context) *UserNotificationPreference {
+	node, err := unpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserNotificationPreference ID from the query.
+// Returns a *NotFoundError when no UserNotificationPreference ID was found.
+func (unpq *UserNotificationPreferenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = unpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usernotificationpreference.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := unpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserNotificationPreference entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserNotificationPreference entity is found.
+// Returns a *NotFoundError when no UserNotificationPreference entities are found.
+func (unpq *UserNotificationPreferenceQuery) Only(ctx context.Context) (*UserNotificationPreference, error) {
+	nodes, err := unpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usernotificationpreference.Label}
+	default:
+		return nil, &NotSingularError{usernotificationpreference.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) OnlyX(ctx context.Context) *UserNotificationPreference {
+	node, err := unpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserNotificationPreference ID in the query.
+// Returns a *NotSingularError when more than one UserNotificationPreference ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (unpq *UserNotificationPreferenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = unpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usernotificationpreference.Label}
+	default:
+		err = &NotSingularError{usernotificationpreference.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := unpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserNotificationPreferences.
+func (unpq *UserNotificationPreferenceQuery) All(ctx context.Context) ([]*UserNotificationPreference, error) {
+	if err := unpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return unpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) AllX(ctx context.Context) []*UserNotificationPreference {
+	nodes, err := unpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserNotificationPreference IDs.
+func (unpq *UserNotificationPreferenceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := unpq.Select(usernotificationpreference.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := unpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (unpq *UserNotificationPreferenceQuery) Count(ctx context.Context) (int, error) {
+	if err := unpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return unpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) CountX(ctx context.Context) int {
+	count, err := unpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (unpq *UserNotificationPreferenceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := unpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return unpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (unpq *UserNotificationPreferenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := unpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserNotificationPreferenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (unpq *UserNotificationPreferenceQuery) Clone() *UserNotificationPreferenceQuery {
+	if unpq == nil {
+		return nil
+	}
+	return &UserNotificationPreferenceQuery{
+		config:     unpq.config,
+		limit:      unpq.limit,
+		offset:     unpq.offset,
+		order:      append([]OrderFunc{}, unpq.order...),
+		predicates: append([]predicate.UserNotificationPreference{}, unpq.predicates...),
+		// clone intermediate query.
+		sql:    unpq.sql.Clone(),
+		path:   unpq.path,
+		unique: unpq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserNotificationPreference.Query().
+//		GroupBy(usernotificationpreference.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (unpq *UserNotificationPreferenceQuery) GroupBy(field string, fields ...string) *UserNotificationPreferenceGroupBy {
+	grbuild := &UserNotificationPreferenceGroupBy{config: unpq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := unpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return unpq.sqlQuery(ctx), nil
+	}
+	grbuild.label = usernotificationpreference.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserNotificationPreference.Query().
+//		Select(usernotificationpreference.FieldUserID).
+//		Scan(ctx, &v)
+func (unpq *UserNotificationPreferenceQuery) Select(fields ...string) *UserNotificationPreferenceSelect {
+	unpq.fields = append(unpq.fields, fields...)
+	selbuild := &UserNotificationPreferenceSelect{UserNotificationPreferenceQuery: unpq}
+	selbuild.label = usernotificationpreference.Label
+	selbuild.flds, selbuild.scan = &unpq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (unpq *UserNotificationPreferenceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range unpq.fields {
+		if !usernotificationpreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if unpq.path != nil {
+		prev, err := unpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		unpq.sql = prev
+	}
+	return nil
+}
+
+func (unpq *UserNotificationPreferenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserNotificationPreference, error) {
+	var (
+		nodes = []*UserNotificationPreference{}
+		_spec = unpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*UserNotificationPreference).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &UserNotificationPreference{config: unpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, unpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (unpq *UserNotificationPreferenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := unpq.querySpec()
+	_spec.Node.Columns = unpq.fields
+	if len(unpq.fields) > 0 {
+		_spec.Unique = unpq.unique != nil && *unpq.unique
+	}
+	return sqlgraph.CountNodes(ctx, unpq.driver, _spec)
+}
+
+func (unpq *UserNotificationPreferenceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := unpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (unpq *UserNotificationPreferenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   usernotificationpreference.Table,
+			Columns: usernotificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usernotificationpreference.FieldID,
+			},
+		},
+		From:   unpq.sql,
+		Unique: true,
+	}
+	if unique := unpq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := unpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernotificationpreference.FieldID)
+		for i := range fields {
+			if fields[i] != usernotificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := unpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := unpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := unpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := unpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (unpq *UserNotificationPreferenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(unpq.driver.Dialect())
+	t1 := builder.Table(usernotificationpreference.Table)
+	columns := unpq.fields
+	if len(columns) == 0 {
+		columns = usernotificationpreference.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if unpq.sql != nil {
+		selector = unpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if unpq.unique != nil && *unpq.unique {
+		selector.Distinct()
+	}
+	for _, p := range unpq.predicates {
+		p(selector)
+	}
+	for _, p := range unpq.order {
+		p(selector)
+	}
+	if offset := unpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := unpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserNotificationPreferenceGroupBy is the group-by builder for UserNotificationPreference entities.
+type UserNotificationPreferenceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (unpgb *UserNotificationPreferenceGroupBy) Aggregate(fns ...AggregateFunc) *UserNotificationPreferenceGroupBy {
+	unpgb.fns = append(unpgb.fns, fns...)
+	return unpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (unpgb *UserNotificationPreferenceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := unpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	unpgb.sql = query
+	return unpgb.sqlScan(ctx, v)
+}
+
+func (unpgb *UserNotificationPreferenceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range unpgb.fields {
+		if !usernotificationpreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := unpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := unpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (unpgb *UserNotificationPreferenceGroupBy) sqlQuery() *sql.Selector {
+	selector := unpgb.sql.Select()
+	aggregation := make([]string, 0, len(unpgb.fns))
+	for _, fn := range unpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(unpgb.fields)+len(unpgb.fns))
+		for _, f := range unpgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(unpgb.fields...)...)
+}
+
+// UserNotificationPreferenceSelect is the builder for selecting fields of UserNotificationPreference entities.
+type UserNotificationPreferenceSelect struct {
+	*UserNotificationPreferenceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (unps *UserNotificationPreferenceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := unps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	unps.sql = unps.UserNotificationPreferenceQuery.sqlQuery(ctx)
+	return unps.sqlScan(ctx, v)
+}
+
+func (unps *UserNotificationPreferenceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := unps.sql.Query()
+	if err := unps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,446 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
+)
+
+// UserNotificationPreferenceUpdate is the builder for updating UserNotificationPreference entities.
+type UserNotificationPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserNotificationPreferenceMutation
+}
+
+// Where appends a list predicates to the UserNotificationPreferenceUpdate builder.
+func (unpu *UserNotificationPreferenceUpdate) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceUpdate {
+	unpu.mutation.Where(ps...)
+	return unpu
+}
+
+// SetUserID sets the "user_id" field.
+func (unpu *UserNotificationPreferenceUpdate) SetUserID(s string) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetUserID(s)
+	return unpu
+}
+
+// SetAppID sets the "app_id" field.
+func (unpu *UserNotificationPreferenceUpdate) SetAppID(s string) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetAppID(s)
+	return unpu
+}
+
+// SetCategory sets the "category" field.
+func (unpu *UserNotificationPreferenceUpdate) SetCategory(s string) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetCategory(s)
+	return unpu
+}
+
+// SetEnabled sets the "enabled" field.
+func (unpu *UserNotificationPreferenceUpdate) SetEnabled(b bool) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetEnabled(b)
+	return unpu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (unpu *UserNotificationPreferenceUpdate) SetNillableEnabled(b *bool) *UserNotificationPreferenceUpdate {
+	if b != nil {
+		unpu.SetEnabled(*b)
+	}
+	return unpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (unpu *UserNotificationPreferenceUpdate) SetCreatedAt(t time.Time) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetCreatedAt(t)
+	return unpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (unpu *UserNotificationPreferenceUpdate) SetNillableCreatedAt(t *time.Time) *UserNotificationPreferenceUpdate {
+	if t != nil {
+		unpu.SetCreatedAt(*t)
+	}
+	return unpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (unpu *UserNotificationPreferenceUpdate) SetUpdatedAt(t time.Time) *UserNotificationPreferenceUpdate {
+	unpu.mutation.SetUpdatedAt(t)
+	return unpu
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (unpu *UserNotificationPreferenceUpdate) Mutation() *UserNotificationPreferenceMutation {
+	return unpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (unpu *UserNotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	unpu.defaults()
+	if len(unpu.hooks) == 0 {
+		affected, err = unpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserNotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			unpu.mutation = mutation
+			affected, err = unpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(unpu.hooks) - 1; i >= 0; i-- {
+			if unpu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = unpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, unpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (unpu *UserNotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := unpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (unpu *UserNotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := unpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (unpu *UserNotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := unpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (unpu *UserNotificationPreferenceUpdate) defaults() {
+	if _, ok := unpu.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.UpdateDefaultUpdatedAt()
+		unpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (unpu *UserNotificationPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   usernotificationpreference.Table,
+			Columns: usernotificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usernotificationpreference.FieldID,
+			},
+		},
+	}
+	if ps := unpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := unpu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldUserID,
+		})
+	}
+	if value, ok := unpu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldAppID,
+		})
+	}
+	if value, ok := unpu.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldCategory,
+		})
+	}
+	if value, ok := unpu.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: usernotificationpreference.FieldEnabled,
+		})
+	}
+	if value, ok := unpu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldCreatedAt,
+		})
+	}
+	if value, ok := unpu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, unpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserNotificationPreferenceUpdateOne is the builder for updating a single UserNotificationPreference entity.
+type UserNotificationPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserNotificationPreferenceMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetUserID(s string) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetUserID(s)
+	return unpuo
+}
+
+// SetAppID sets the "app_id" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetAppID(s string) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetAppID(s)
+	return unpuo
+}
+
+// SetCategory sets the "category" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetCategory(s string) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetCategory(s)
+	return unpuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetEnabled(b bool) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetEnabled(b)
+	return unpuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetNillableEnabled(b *bool) *UserNotificationPreferenceUpdateOne {
+	if b != nil {
+		unpuo.SetEnabled(*b)
+	}
+	return unpuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetCreatedAt(t time.Time) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetCreatedAt(t)
+	return unpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetNillableCreatedAt(t *time.Time) *UserNotificationPreferenceUpdateOne {
+	if t != nil {
+		unpuo.SetCreatedAt(*t)
+	}
+	return unpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (unpuo *UserNotificationPreferenceUpdateOne) SetUpdatedAt(t time.Time) *UserNotificationPreferenceUpdateOne {
+	unpuo.mutation.SetUpdatedAt(t)
+	return unpuo
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (unpuo *UserNotificationPreferenceUpdateOne) Mutation() *UserNotificationPreferenceMutation {
+	return unpuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (unpuo *UserNotificationPreferenceUpdateOne) Select(field string, fields ...string) *UserNotificationPreferenceUpdateOne {
+	unpuo.fields = append([]string{field}, fields...)
+	return unpuo
+}
+
+// Save executes the query and returns the updated UserNotificationPreference entity.
+func (unpuo *UserNotificationPreferenceUpdateOne) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	var (
+		err  error
+		node *UserNotificationPreference
+	)
+	unpuo.defaults()
+	if len(unpuo.hooks) == 0 {
+		node, err = unpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserNotificationPreferenceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			unpuo.mutation = mutation
+			node, err = unpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(unpuo.hooks) - 1; i >= 0; i-- {
+			if unpuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = unpuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, unpuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (unpuo *UserNotificationPreferenceUpdateOne) SaveX(ctx context.Context) *UserNotificationPreference {
+	node, err := unpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (unpuo *UserNotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := unpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (unpuo *UserNotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := unpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (unpuo *UserNotificationPreferenceUpdateOne) defaults() {
+	if _, ok := unpuo.mutation.UpdatedAt(); !ok {
+		v := usernotificationpreference.UpdateDefaultUpdatedAt()
+		unpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (unpuo *UserNotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *UserNotificationPreference, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   usernotificationpreference.Table,
+			Columns: usernotificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usernotificationpreference.FieldID,
+			},
+		},
+	}
+	id, ok := unpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserNotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := unpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernotificationpreference.FieldID)
+		for _, f := range fields {
+			if !usernotificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usernotificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := unpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := unpuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldUserID,
+		})
+	}
+	if value, ok := unpuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldAppID,
+		})
+	}
+	if value, ok := unpuo.mutation.Category(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: usernotificationpreference.FieldCategory,
+		})
+	}
+	if value, ok := unpuo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: usernotificationpreference.FieldEnabled,
+		})
+	}
+	if value, ok := unpuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldCreatedAt,
+		})
+	}
+	if value, ok := unpuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: usernotificationpreference.FieldUpdatedAt,
+		})
+	}
+	_node = &UserNotificationPreference{config: unpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, unpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
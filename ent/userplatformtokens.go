@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,24 @@ type UserPlatformTokens struct {
 	Token string `json:"token,omitempty"`
 	// AppID holds the value of the "app_id" field.
 	AppID string `json:"app_id,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// AppVersion holds the value of the "app_version" field.
+	AppVersion string `json:"app_version,omitempty"`
+	// AppVersionNum holds the value of the "app_version_num" field.
+	AppVersionNum int64 `json:"app_version_num,omitempty"`
+	// OsVersion holds the value of the "os_version" field.
+	OsVersion string `json:"os_version,omitempty"`
+	// OsVersionNum holds the value of the "os_version_num" field.
+	OsVersionNum int64 `json:"os_version_num,omitempty"`
+	// Locale holds the value of the "locale" field.
+	Locale string `json:"locale,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// LastActiveAt holds the value of the "last_active_at" field.
+	LastActiveAt *time.Time `json:"last_active_at,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -37,11 +56,13 @@ func (*UserPlatformTokens) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case userplatformtokens.FieldID, userplatformtokens.FieldType:
+		case userplatformtokens.FieldTags:
+			values[i] = new([]byte)
+		case userplatformtokens.FieldID, userplatformtokens.FieldType, userplatformtokens.FieldAppVersionNum, userplatformtokens.FieldOsVersionNum:
 			values[i] = new(sql.NullInt64)
-		case userplatformtokens.FieldUserID, userplatformtokens.FieldDeviceID, userplatformtokens.FieldToken, userplatformtokens.FieldAppID:
+		case userplatformtokens.FieldUserID, userplatformtokens.FieldDeviceID, userplatformtokens.FieldToken, userplatformtokens.FieldAppID, userplatformtokens.FieldTimezone, userplatformtokens.FieldAppVersion, userplatformtokens.FieldOsVersion, userplatformtokens.FieldLocale, userplatformtokens.FieldCountry:
 			values[i] = new(sql.NullString)
-		case userplatformtokens.FieldCreatedAt, userplatformtokens.FieldUpdatedAt:
+		case userplatformtokens.FieldLastActiveAt, userplatformtokens.FieldCreatedAt, userplatformtokens.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type UserPlatformTokens", columns[i])
@@ -94,6 +115,63 @@ func (upt *UserPlatformTokens) assignValues(columns []string, values []interface
 			} else if value.Valid {
 				upt.AppID = value.String
 			}
+		case userplatformtokens.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				upt.Timezone = value.String
+			}
+		case userplatformtokens.FieldAppVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_version", values[i])
+			} else if value.Valid {
+				upt.AppVersion = value.String
+			}
+		case userplatformtokens.FieldAppVersionNum:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field app_version_num", values[i])
+			} else if value.Valid {
+				upt.AppVersionNum = value.Int64
+			}
+		case userplatformtokens.FieldOsVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os_version", values[i])
+			} else if value.Valid {
+				upt.OsVersion = value.String
+			}
+		case userplatformtokens.FieldOsVersionNum:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field os_version_num", values[i])
+			} else if value.Valid {
+				upt.OsVersionNum = value.Int64
+			}
+		case userplatformtokens.FieldLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field locale", values[i])
+			} else if value.Valid {
+				upt.Locale = value.String
+			}
+		case userplatformtokens.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				upt.Country = value.String
+			}
+		case userplatformtokens.FieldLastActiveAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_active_at", values[i])
+			} else if value.Valid {
+				upt.LastActiveAt = new(time.Time)
+				*upt.LastActiveAt = value.Time
+			}
+		case userplatformtokens.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &upt.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
 		case userplatformtokens.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -144,6 +222,26 @@ func (upt *UserPlatformTokens) String() string {
 	builder.WriteString(upt.Token)
 	builder.WriteString(", app_id=")
 	builder.WriteString(upt.AppID)
+	builder.WriteString(", timezone=")
+	builder.WriteString(upt.Timezone)
+	builder.WriteString(", app_version=")
+	builder.WriteString(upt.AppVersion)
+	builder.WriteString(", app_version_num=")
+	builder.WriteString(fmt.Sprintf("%v", upt.AppVersionNum))
+	builder.WriteString(", os_version=")
+	builder.WriteString(upt.OsVersion)
+	builder.WriteString(", os_version_num=")
+	builder.WriteString(fmt.Sprintf("%v", upt.OsVersionNum))
+	builder.WriteString(", locale=")
+	builder.WriteString(upt.Locale)
+	builder.WriteString(", country=")
+	builder.WriteString(upt.Country)
+	if v := upt.LastActiveAt; v != nil {
+		builder.WriteString(", last_active_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", tags=")
+	builder.WriteString(fmt.Sprintf("%v", upt.Tags))
 	builder.WriteString(", created_at=")
 	builder.WriteString(upt.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", updated_at=")
@@ -17,6 +17,24 @@ const (
 	FieldToken = "token"
 	// FieldAppID holds the string denoting the app_id field in the database.
 	FieldAppID = "app_id"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldAppVersion holds the string denoting the app_version field in the database.
+	FieldAppVersion = "app_version"
+	// FieldAppVersionNum holds the string denoting the app_version_num field in the database.
+	FieldAppVersionNum = "app_version_num"
+	// FieldOsVersion holds the string denoting the os_version field in the database.
+	FieldOsVersion = "os_version"
+	// FieldOsVersionNum holds the string denoting the os_version_num field in the database.
+	FieldOsVersionNum = "os_version_num"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldLastActiveAt holds the string denoting the last_active_at field in the database.
+	FieldLastActiveAt = "last_active_at"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -33,6 +51,15 @@ var Columns = []string{
 	FieldDeviceID,
 	FieldToken,
 	FieldAppID,
+	FieldTimezone,
+	FieldAppVersion,
+	FieldAppVersionNum,
+	FieldOsVersion,
+	FieldOsVersionNum,
+	FieldLocale,
+	FieldCountry,
+	FieldLastActiveAt,
+	FieldTags,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -127,6 +127,62 @@ func AppID(v string) predicate.UserPlatformTokens {
 	})
 }
 
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimezone), v))
+	})
+}
+
+// AppVersion applies equality check predicate on the "app_version" field. It's identical to AppVersionEQ.
+func AppVersion(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionNum applies equality check predicate on the "app_version_num" field. It's identical to AppVersionNumEQ.
+func AppVersionNum(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// OsVersion applies equality check predicate on the "os_version" field. It's identical to OsVersionEQ.
+func OsVersion(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionNum applies equality check predicate on the "os_version_num" field. It's identical to OsVersionNumEQ.
+func OsVersionNum(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// Locale applies equality check predicate on the "locale" field. It's identical to LocaleEQ.
+func Locale(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocale), v))
+	})
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountry), v))
+	})
+}
+
+// LastActiveAt applies equality check predicate on the "last_active_at" field. It's identical to LastActiveAtEQ.
+func LastActiveAt(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastActiveAt), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserPlatformTokens {
 	return predicate.UserPlatformTokens(func(s *sql.Selector) {
@@ -661,6 +717,915 @@ func AppIDContainsFold(v string) predicate.UserPlatformTokens {
 	})
 }
 
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimezone), v...))
+	})
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimezone), v...))
+	})
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneIsNil applies the IsNil predicate on the "timezone" field.
+func TimezoneIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimezone)))
+	})
+}
+
+// TimezoneNotNil applies the NotNil predicate on the "timezone" field.
+func TimezoneNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimezone)))
+	})
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTimezone), v))
+	})
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTimezone), v))
+	})
+}
+
+// AppVersionEQ applies the EQ predicate on the "app_version" field.
+func AppVersionEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionNEQ applies the NEQ predicate on the "app_version" field.
+func AppVersionNEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionIn applies the In predicate on the "app_version" field.
+func AppVersionIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppVersion), v...))
+	})
+}
+
+// AppVersionNotIn applies the NotIn predicate on the "app_version" field.
+func AppVersionNotIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppVersion), v...))
+	})
+}
+
+// AppVersionGT applies the GT predicate on the "app_version" field.
+func AppVersionGT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionGTE applies the GTE predicate on the "app_version" field.
+func AppVersionGTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionLT applies the LT predicate on the "app_version" field.
+func AppVersionLT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionLTE applies the LTE predicate on the "app_version" field.
+func AppVersionLTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionContains applies the Contains predicate on the "app_version" field.
+func AppVersionContains(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionHasPrefix applies the HasPrefix predicate on the "app_version" field.
+func AppVersionHasPrefix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionHasSuffix applies the HasSuffix predicate on the "app_version" field.
+func AppVersionHasSuffix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionIsNil applies the IsNil predicate on the "app_version" field.
+func AppVersionIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppVersion)))
+	})
+}
+
+// AppVersionNotNil applies the NotNil predicate on the "app_version" field.
+func AppVersionNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppVersion)))
+	})
+}
+
+// AppVersionEqualFold applies the EqualFold predicate on the "app_version" field.
+func AppVersionEqualFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionContainsFold applies the ContainsFold predicate on the "app_version" field.
+func AppVersionContainsFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAppVersion), v))
+	})
+}
+
+// AppVersionNumEQ applies the EQ predicate on the "app_version_num" field.
+func AppVersionNumEQ(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumNEQ applies the NEQ predicate on the "app_version_num" field.
+func AppVersionNumNEQ(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumIn applies the In predicate on the "app_version_num" field.
+func AppVersionNumIn(vs ...int64) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAppVersionNum), v...))
+	})
+}
+
+// AppVersionNumNotIn applies the NotIn predicate on the "app_version_num" field.
+func AppVersionNumNotIn(vs ...int64) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAppVersionNum), v...))
+	})
+}
+
+// AppVersionNumGT applies the GT predicate on the "app_version_num" field.
+func AppVersionNumGT(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumGTE applies the GTE predicate on the "app_version_num" field.
+func AppVersionNumGTE(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumLT applies the LT predicate on the "app_version_num" field.
+func AppVersionNumLT(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumLTE applies the LTE predicate on the "app_version_num" field.
+func AppVersionNumLTE(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAppVersionNum), v))
+	})
+}
+
+// AppVersionNumIsNil applies the IsNil predicate on the "app_version_num" field.
+func AppVersionNumIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAppVersionNum)))
+	})
+}
+
+// AppVersionNumNotNil applies the NotNil predicate on the "app_version_num" field.
+func AppVersionNumNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAppVersionNum)))
+	})
+}
+
+// OsVersionEQ applies the EQ predicate on the "os_version" field.
+func OsVersionEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionNEQ applies the NEQ predicate on the "os_version" field.
+func OsVersionNEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionIn applies the In predicate on the "os_version" field.
+func OsVersionIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOsVersion), v...))
+	})
+}
+
+// OsVersionNotIn applies the NotIn predicate on the "os_version" field.
+func OsVersionNotIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOsVersion), v...))
+	})
+}
+
+// OsVersionGT applies the GT predicate on the "os_version" field.
+func OsVersionGT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionGTE applies the GTE predicate on the "os_version" field.
+func OsVersionGTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionLT applies the LT predicate on the "os_version" field.
+func OsVersionLT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionLTE applies the LTE predicate on the "os_version" field.
+func OsVersionLTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionContains applies the Contains predicate on the "os_version" field.
+func OsVersionContains(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionHasPrefix applies the HasPrefix predicate on the "os_version" field.
+func OsVersionHasPrefix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionHasSuffix applies the HasSuffix predicate on the "os_version" field.
+func OsVersionHasSuffix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionIsNil applies the IsNil predicate on the "os_version" field.
+func OsVersionIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOsVersion)))
+	})
+}
+
+// OsVersionNotNil applies the NotNil predicate on the "os_version" field.
+func OsVersionNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOsVersion)))
+	})
+}
+
+// OsVersionEqualFold applies the EqualFold predicate on the "os_version" field.
+func OsVersionEqualFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionContainsFold applies the ContainsFold predicate on the "os_version" field.
+func OsVersionContainsFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOsVersion), v))
+	})
+}
+
+// OsVersionNumEQ applies the EQ predicate on the "os_version_num" field.
+func OsVersionNumEQ(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumNEQ applies the NEQ predicate on the "os_version_num" field.
+func OsVersionNumNEQ(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumIn applies the In predicate on the "os_version_num" field.
+func OsVersionNumIn(vs ...int64) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOsVersionNum), v...))
+	})
+}
+
+// OsVersionNumNotIn applies the NotIn predicate on the "os_version_num" field.
+func OsVersionNumNotIn(vs ...int64) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOsVersionNum), v...))
+	})
+}
+
+// OsVersionNumGT applies the GT predicate on the "os_version_num" field.
+func OsVersionNumGT(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumGTE applies the GTE predicate on the "os_version_num" field.
+func OsVersionNumGTE(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumLT applies the LT predicate on the "os_version_num" field.
+func OsVersionNumLT(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumLTE applies the LTE predicate on the "os_version_num" field.
+func OsVersionNumLTE(v int64) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOsVersionNum), v))
+	})
+}
+
+// OsVersionNumIsNil applies the IsNil predicate on the "os_version_num" field.
+func OsVersionNumIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOsVersionNum)))
+	})
+}
+
+// OsVersionNumNotNil applies the NotNil predicate on the "os_version_num" field.
+func OsVersionNumNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOsVersionNum)))
+	})
+}
+
+// LocaleEQ applies the EQ predicate on the "locale" field.
+func LocaleEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleNEQ applies the NEQ predicate on the "locale" field.
+func LocaleNEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleIn applies the In predicate on the "locale" field.
+func LocaleIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLocale), v...))
+	})
+}
+
+// LocaleNotIn applies the NotIn predicate on the "locale" field.
+func LocaleNotIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLocale), v...))
+	})
+}
+
+// LocaleGT applies the GT predicate on the "locale" field.
+func LocaleGT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleGTE applies the GTE predicate on the "locale" field.
+func LocaleGTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleLT applies the LT predicate on the "locale" field.
+func LocaleLT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleLTE applies the LTE predicate on the "locale" field.
+func LocaleLTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleContains applies the Contains predicate on the "locale" field.
+func LocaleContains(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleHasPrefix applies the HasPrefix predicate on the "locale" field.
+func LocaleHasPrefix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleHasSuffix applies the HasSuffix predicate on the "locale" field.
+func LocaleHasSuffix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleIsNil applies the IsNil predicate on the "locale" field.
+func LocaleIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLocale)))
+	})
+}
+
+// LocaleNotNil applies the NotNil predicate on the "locale" field.
+func LocaleNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLocale)))
+	})
+}
+
+// LocaleEqualFold applies the EqualFold predicate on the "locale" field.
+func LocaleEqualFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLocale), v))
+	})
+}
+
+// LocaleContainsFold applies the ContainsFold predicate on the "locale" field.
+func LocaleContainsFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLocale), v))
+	})
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCountry), v))
+	})
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCountry), v))
+	})
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCountry), v...))
+	})
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCountry), v...))
+	})
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCountry), v))
+	})
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCountry), v))
+	})
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCountry), v))
+	})
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCountry), v))
+	})
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCountry), v))
+	})
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCountry), v))
+	})
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCountry), v))
+	})
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCountry)))
+	})
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCountry)))
+	})
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCountry), v))
+	})
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCountry), v))
+	})
+}
+
+// LastActiveAtEQ applies the EQ predicate on the "last_active_at" field.
+func LastActiveAtEQ(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtNEQ applies the NEQ predicate on the "last_active_at" field.
+func LastActiveAtNEQ(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtIn applies the In predicate on the "last_active_at" field.
+func LastActiveAtIn(vs ...time.Time) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastActiveAt), v...))
+	})
+}
+
+// LastActiveAtNotIn applies the NotIn predicate on the "last_active_at" field.
+func LastActiveAtNotIn(vs ...time.Time) predicate.UserPlatformTokens {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastActiveAt), v...))
+	})
+}
+
+// LastActiveAtGT applies the GT predicate on the "last_active_at" field.
+func LastActiveAtGT(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtGTE applies the GTE predicate on the "last_active_at" field.
+func LastActiveAtGTE(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtLT applies the LT predicate on the "last_active_at" field.
+func LastActiveAtLT(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtLTE applies the LTE predicate on the "last_active_at" field.
+func LastActiveAtLTE(v time.Time) predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastActiveAt), v))
+	})
+}
+
+// LastActiveAtIsNil applies the IsNil predicate on the "last_active_at" field.
+func LastActiveAtIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastActiveAt)))
+	})
+}
+
+// LastActiveAtNotNil applies the NotNil predicate on the "last_active_at" field.
+func LastActiveAtNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastActiveAt)))
+	})
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTags)))
+	})
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.UserPlatformTokens {
+	return predicate.UserPlatformTokens(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTags)))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserPlatformTokens {
 	return predicate.UserPlatformTokens(func(s *sql.Selector) {
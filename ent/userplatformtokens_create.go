@@ -50,6 +50,124 @@ func (uptc *UserPlatformTokensCreate) SetAppID(s string) *UserPlatformTokensCrea
 	return uptc
 }
 
+// SetTimezone sets the "timezone" field.
+func (uptc *UserPlatformTokensCreate) SetTimezone(s string) *UserPlatformTokensCreate {
+	uptc.mutation.SetTimezone(s)
+	return uptc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableTimezone(s *string) *UserPlatformTokensCreate {
+	if s != nil {
+		uptc.SetTimezone(*s)
+	}
+	return uptc
+}
+
+// SetAppVersion sets the "app_version" field.
+func (uptc *UserPlatformTokensCreate) SetAppVersion(s string) *UserPlatformTokensCreate {
+	uptc.mutation.SetAppVersion(s)
+	return uptc
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableAppVersion(s *string) *UserPlatformTokensCreate {
+	if s != nil {
+		uptc.SetAppVersion(*s)
+	}
+	return uptc
+}
+
+// SetAppVersionNum sets the "app_version_num" field.
+func (uptc *UserPlatformTokensCreate) SetAppVersionNum(i int64) *UserPlatformTokensCreate {
+	uptc.mutation.SetAppVersionNum(i)
+	return uptc
+}
+
+// SetNillableAppVersionNum sets the "app_version_num" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableAppVersionNum(i *int64) *UserPlatformTokensCreate {
+	if i != nil {
+		uptc.SetAppVersionNum(*i)
+	}
+	return uptc
+}
+
+// SetOsVersion sets the "os_version" field.
+func (uptc *UserPlatformTokensCreate) SetOsVersion(s string) *UserPlatformTokensCreate {
+	uptc.mutation.SetOsVersion(s)
+	return uptc
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableOsVersion(s *string) *UserPlatformTokensCreate {
+	if s != nil {
+		uptc.SetOsVersion(*s)
+	}
+	return uptc
+}
+
+// SetOsVersionNum sets the "os_version_num" field.
+func (uptc *UserPlatformTokensCreate) SetOsVersionNum(i int64) *UserPlatformTokensCreate {
+	uptc.mutation.SetOsVersionNum(i)
+	return uptc
+}
+
+// SetNillableOsVersionNum sets the "os_version_num" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableOsVersionNum(i *int64) *UserPlatformTokensCreate {
+	if i != nil {
+		uptc.SetOsVersionNum(*i)
+	}
+	return uptc
+}
+
+// SetLocale sets the "locale" field.
+func (uptc *UserPlatformTokensCreate) SetLocale(s string) *UserPlatformTokensCreate {
+	uptc.mutation.SetLocale(s)
+	return uptc
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableLocale(s *string) *UserPlatformTokensCreate {
+	if s != nil {
+		uptc.SetLocale(*s)
+	}
+	return uptc
+}
+
+// SetCountry sets the "country" field.
+func (uptc *UserPlatformTokensCreate) SetCountry(s string) *UserPlatformTokensCreate {
+	uptc.mutation.SetCountry(s)
+	return uptc
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableCountry(s *string) *UserPlatformTokensCreate {
+	if s != nil {
+		uptc.SetCountry(*s)
+	}
+	return uptc
+}
+
+// SetLastActiveAt sets the "last_active_at" field.
+func (uptc *UserPlatformTokensCreate) SetLastActiveAt(t time.Time) *UserPlatformTokensCreate {
+	uptc.mutation.SetLastActiveAt(t)
+	return uptc
+}
+
+// SetNillableLastActiveAt sets the "last_active_at" field if the given value is not nil.
+func (uptc *UserPlatformTokensCreate) SetNillableLastActiveAt(t *time.Time) *UserPlatformTokensCreate {
+	if t != nil {
+		uptc.SetLastActiveAt(*t)
+	}
+	return uptc
+}
+
+// SetTags sets the "tags" field.
+func (uptc *UserPlatformTokensCreate) SetTags(s []string) *UserPlatformTokensCreate {
+	uptc.mutation.SetTags(s)
+	return uptc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uptc *UserPlatformTokensCreate) SetCreatedAt(t time.Time) *UserPlatformTokensCreate {
 	uptc.mutation.SetCreatedAt(t)
@@ -88,11 +206,17 @@ func (uptc *UserPlatformTokensCreate) Save(ctx context.Context) (*UserPlatformTo
 				return nil, err
 			}
 			uptc.mutation = mutation
-			node, err = uptc.sqlSave(ctx)
+			if node, err = uptc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
 			mutation.done = true
 			return node, err
 		})
 		for i := len(uptc.hooks) - 1; i >= 0; i-- {
+			if uptc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptc.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptc.mutation); err != nil {
@@ -111,28 +235,41 @@ func (uptc *UserPlatformTokensCreate) SaveX(ctx context.Context) *UserPlatformTo
 	return v
 }
 
+// Exec executes the query.
+func (uptc *UserPlatformTokensCreate) Exec(ctx context.Context) error {
+	_, err := uptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uptc *UserPlatformTokensCreate) ExecX(ctx context.Context) {
+	if err := uptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (uptc *UserPlatformTokensCreate) check() error {
 	if _, ok := uptc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New("ent: missing required field \"type\"")}
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "UserPlatformTokens.type"`)}
 	}
 	if _, ok := uptc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New("ent: missing required field \"user_id\"")}
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserPlatformTokens.user_id"`)}
 	}
 	if _, ok := uptc.mutation.DeviceID(); !ok {
-		return &ValidationError{Name: "device_id", err: errors.New("ent: missing required field \"device_id\"")}
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "UserPlatformTokens.device_id"`)}
 	}
 	if _, ok := uptc.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New("ent: missing required field \"token\"")}
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "UserPlatformTokens.token"`)}
 	}
 	if _, ok := uptc.mutation.AppID(); !ok {
-		return &ValidationError{Name: "app_id", err: errors.New("ent: missing required field \"app_id\"")}
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "UserPlatformTokens.app_id"`)}
 	}
 	if _, ok := uptc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserPlatformTokens.created_at"`)}
 	}
 	if _, ok := uptc.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserPlatformTokens.updated_at"`)}
 	}
 	return nil
 }
@@ -140,8 +277,8 @@ func (uptc *UserPlatformTokensCreate) check() error {
 func (uptc *UserPlatformTokensCreate) sqlSave(ctx context.Context) (*UserPlatformTokens, error) {
 	_node, _spec := uptc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uptc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return nil, err
 	}
@@ -201,6 +338,78 @@ func (uptc *UserPlatformTokensCreate) createSpec() (*UserPlatformTokens, *sqlgra
 		})
 		_node.AppID = value
 	}
+	if value, ok := uptc.mutation.Timezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldTimezone,
+		})
+		_node.Timezone = value
+	}
+	if value, ok := uptc.mutation.AppVersion(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersion,
+		})
+		_node.AppVersion = value
+	}
+	if value, ok := uptc.mutation.AppVersionNum(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+		_node.AppVersionNum = value
+	}
+	if value, ok := uptc.mutation.OsVersion(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersion,
+		})
+		_node.OsVersion = value
+	}
+	if value, ok := uptc.mutation.OsVersionNum(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+		_node.OsVersionNum = value
+	}
+	if value, ok := uptc.mutation.Locale(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldLocale,
+		})
+		_node.Locale = value
+	}
+	if value, ok := uptc.mutation.Country(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldCountry,
+		})
+		_node.Country = value
+	}
+	if value, ok := uptc.mutation.LastActiveAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userplatformtokens.FieldLastActiveAt,
+		})
+		_node.LastActiveAt = &value
+	}
+	if value, ok := uptc.mutation.Tags(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: userplatformtokens.FieldTags,
+		})
+		_node.Tags = value
+	}
 	if value, ok := uptc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -248,19 +457,23 @@ func (uptcb *UserPlatformTokensCreateBulk) Save(ctx context.Context) ([]*UserPla
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, uptcb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, uptcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
-						if cerr, ok := isSQLConstraintError(err); ok {
-							err = cerr
+					if err = sqlgraph.BatchCreate(ctx, uptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
 						}
 					}
 				}
-				mutation.done = true
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -285,3 +498,16 @@ func (uptcb *UserPlatformTokensCreateBulk) SaveX(ctx context.Context) []*UserPla
 	}
 	return v
 }
+
+// Exec executes the query.
+func (uptcb *UserPlatformTokensCreateBulk) Exec(ctx context.Context) error {
+	_, err := uptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uptcb *UserPlatformTokensCreateBulk) ExecX(ctx context.Context) {
+	if err := uptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,7 +4,6 @@ package ent
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 
@@ -106,7 +105,7 @@ func (uptq *UserPlatformTokensQuery) FirstIDX(ctx context.Context) int {
 }
 
 // Only returns a single UserPlatformTokens entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when exactly one UserPlatformTokens entity is not found.
+// Returns a *NotSingularError when more than one UserPlatformTokens entity is found.
 // Returns a *NotFoundError when no UserPlatformTokens entities are found.
 func (uptq *UserPlatformTokensQuery) Only(ctx context.Context) (*UserPlatformTokens, error) {
 	nodes, err := uptq.Limit(2).All(ctx)
@@ -133,7 +132,7 @@ func (uptq *UserPlatformTokensQuery) OnlyX(ctx context.Context) *UserPlatformTok
 }
 
 // OnlyID is like Only, but returns the only UserPlatformTokens ID in the query.
-// Returns a *NotSingularError when exactly one UserPlatformTokens ID is not found.
+// Returns a *NotSingularError when more than one UserPlatformTokens ID is found.
 // Returns a *NotFoundError when no entities are found.
 func (uptq *UserPlatformTokensQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
@@ -242,8 +241,9 @@ func (uptq *UserPlatformTokensQuery) Clone() *UserPlatformTokensQuery {
 		order:      append([]OrderFunc{}, uptq.order...),
 		predicates: append([]predicate.UserPlatformTokens{}, uptq.predicates...),
 		// clone intermediate query.
-		sql:  uptq.sql.Clone(),
-		path: uptq.path,
+		sql:    uptq.sql.Clone(),
+		path:   uptq.path,
+		unique: uptq.unique,
 	}
 }
 
@@ -261,17 +261,18 @@ func (uptq *UserPlatformTokensQuery) Clone() *UserPlatformTokensQuery {
 //		GroupBy(userplatformtokens.FieldType).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (uptq *UserPlatformTokensQuery) GroupBy(field string, fields ...string) *UserPlatformTokensGroupBy {
-	group := &UserPlatformTokensGroupBy{config: uptq.config}
-	group.fields = append([]string{field}, fields...)
-	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+	grbuild := &UserPlatformTokensGroupBy{config: uptq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := uptq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
 		return uptq.sqlQuery(ctx), nil
 	}
-	return group
+	grbuild.label = userplatformtokens.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
 }
 
 // Select allows the selection one or more fields/columns for the given query,
@@ -286,10 +287,12 @@ func (uptq *UserPlatformTokensQuery) GroupBy(field string, fields ...string) *Us
 //	client.UserPlatformTokens.Query().
 //		Select(userplatformtokens.FieldType).
 //		Scan(ctx, &v)
-//
-func (uptq *UserPlatformTokensQuery) Select(field string, fields ...string) *UserPlatformTokensSelect {
-	uptq.fields = append([]string{field}, fields...)
-	return &UserPlatformTokensSelect{UserPlatformTokensQuery: uptq}
+func (uptq *UserPlatformTokensQuery) Select(fields ...string) *UserPlatformTokensSelect {
+	uptq.fields = append(uptq.fields, fields...)
+	selbuild := &UserPlatformTokensSelect{UserPlatformTokensQuery: uptq}
+	selbuild.label = userplatformtokens.Label
+	selbuild.flds, selbuild.scan = &uptq.fields, selbuild.Scan
+	return selbuild
 }
 
 func (uptq *UserPlatformTokensQuery) prepareQuery(ctx context.Context) error {
@@ -308,23 +311,22 @@ func (uptq *UserPlatformTokensQuery) prepareQuery(ctx context.Context) error {
 	return nil
 }
 
-func (uptq *UserPlatformTokensQuery) sqlAll(ctx context.Context) ([]*UserPlatformTokens, error) {
+func (uptq *UserPlatformTokensQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserPlatformTokens, error) {
 	var (
 		nodes = []*UserPlatformTokens{}
 		_spec = uptq.querySpec()
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
-		node := &UserPlatformTokens{config: uptq.config}
-		nodes = append(nodes, node)
-		return node.scanValues(columns)
+		return (*UserPlatformTokens).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
-		if len(nodes) == 0 {
-			return fmt.Errorf("ent: Assign called without calling ScanValues")
-		}
-		node := nodes[len(nodes)-1]
+		node := &UserPlatformTokens{config: uptq.config}
+		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
 	if err := sqlgraph.QueryNodes(ctx, uptq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -336,6 +338,10 @@ func (uptq *UserPlatformTokensQuery) sqlAll(ctx context.Context) ([]*UserPlatfor
 
 func (uptq *UserPlatformTokensQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uptq.querySpec()
+	_spec.Node.Columns = uptq.fields
+	if len(uptq.fields) > 0 {
+		_spec.Unique = uptq.unique != nil && *uptq.unique
+	}
 	return sqlgraph.CountNodes(ctx, uptq.driver, _spec)
 }
 
@@ -398,10 +404,17 @@ func (uptq *UserPlatformTokensQuery) querySpec() *sqlgraph.QuerySpec {
 func (uptq *UserPlatformTokensQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(uptq.driver.Dialect())
 	t1 := builder.Table(userplatformtokens.Table)
-	selector := builder.Select(t1.Columns(userplatformtokens.Columns...)...).From(t1)
+	columns := uptq.fields
+	if len(columns) == 0 {
+		columns = userplatformtokens.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
 	if uptq.sql != nil {
 		selector = uptq.sql
-		selector.Select(selector.Columns(userplatformtokens.Columns...)...)
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uptq.unique != nil && *uptq.unique {
+		selector.Distinct()
 	}
 	for _, p := range uptq.predicates {
 		p(selector)
@@ -423,6 +436,7 @@ func (uptq *UserPlatformTokensQuery) sqlQuery(ctx context.Context) *sql.Selector
 // UserPlatformTokensGroupBy is the group-by builder for UserPlatformTokens entities.
 type UserPlatformTokensGroupBy struct {
 	config
+	selector
 	fields []string
 	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
@@ -446,209 +460,6 @@ func (uptgb *UserPlatformTokensGroupBy) Scan(ctx context.Context, v interface{})
 	return uptgb.sqlScan(ctx, v)
 }
 
-// ScanX is like Scan, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) ScanX(ctx context.Context, v interface{}) {
-	if err := uptgb.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Strings(ctx context.Context) ([]string, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensGroupBy.Strings is not achievable when grouping more than 1 field")
-	}
-	var v []string
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) StringsX(ctx context.Context) []string {
-	v, err := uptgb.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = uptgb.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensGroupBy.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) StringX(ctx context.Context) string {
-	v, err := uptgb.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Ints(ctx context.Context) ([]int, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensGroupBy.Ints is not achievable when grouping more than 1 field")
-	}
-	var v []int
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) IntsX(ctx context.Context) []int {
-	v, err := uptgb.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Int returns a single int from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = uptgb.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensGroupBy.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) IntX(ctx context.Context) int {
-	v, err := uptgb.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Float64s(ctx context.Context) ([]float64, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensGroupBy.Float64s is not achievable when grouping more than 1 field")
-	}
-	var v []float64
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) Float64sX(ctx context.Context) []float64 {
-	v, err := uptgb.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = uptgb.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensGroupBy.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) Float64X(ctx context.Context) float64 {
-	v, err := uptgb.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Bools(ctx context.Context) ([]bool, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensGroupBy.Bools is not achievable when grouping more than 1 field")
-	}
-	var v []bool
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) BoolsX(ctx context.Context) []bool {
-	v, err := uptgb.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPlatformTokensGroupBy) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = uptgb.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensGroupBy.Bools returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (uptgb *UserPlatformTokensGroupBy) BoolX(ctx context.Context) bool {
-	v, err := uptgb.Bool(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
 func (uptgb *UserPlatformTokensGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	for _, f := range uptgb.fields {
 		if !userplatformtokens.ValidColumn(f) {
@@ -669,18 +480,28 @@ func (uptgb *UserPlatformTokensGroupBy) sqlScan(ctx context.Context, v interface
 }
 
 func (uptgb *UserPlatformTokensGroupBy) sqlQuery() *sql.Selector {
-	selector := uptgb.sql
-	columns := make([]string, 0, len(uptgb.fields)+len(uptgb.fns))
-	columns = append(columns, uptgb.fields...)
+	selector := uptgb.sql.Select()
+	aggregation := make([]string, 0, len(uptgb.fns))
 	for _, fn := range uptgb.fns {
-		columns = append(columns, fn(selector))
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(uptgb.fields)+len(uptgb.fns))
+		for _, f := range uptgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
 	}
-	return selector.Select(columns...).GroupBy(uptgb.fields...)
+	return selector.GroupBy(selector.Columns(uptgb.fields...)...)
 }
 
 // UserPlatformTokensSelect is the builder for selecting fields of UserPlatformTokens entities.
 type UserPlatformTokensSelect struct {
 	*UserPlatformTokensQuery
+	selector
 	// intermediate query (i.e. traversal path).
 	sql *sql.Selector
 }
@@ -694,213 +515,12 @@ func (upts *UserPlatformTokensSelect) Scan(ctx context.Context, v interface{}) e
 	return upts.sqlScan(ctx, v)
 }
 
-// ScanX is like Scan, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) ScanX(ctx context.Context, v interface{}) {
-	if err := upts.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Strings(ctx context.Context) ([]string, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensSelect.Strings is not achievable when selecting more than 1 field")
-	}
-	var v []string
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) StringsX(ctx context.Context) []string {
-	v, err := upts.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = upts.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensSelect.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) StringX(ctx context.Context) string {
-	v, err := upts.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Ints(ctx context.Context) ([]int, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensSelect.Ints is not achievable when selecting more than 1 field")
-	}
-	var v []int
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) IntsX(ctx context.Context) []int {
-	v, err := upts.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Int returns a single int from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = upts.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensSelect.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) IntX(ctx context.Context) int {
-	v, err := upts.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Float64s(ctx context.Context) ([]float64, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensSelect.Float64s is not achievable when selecting more than 1 field")
-	}
-	var v []float64
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) Float64sX(ctx context.Context) []float64 {
-	v, err := upts.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = upts.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensSelect.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) Float64X(ctx context.Context) float64 {
-	v, err := upts.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Bools(ctx context.Context) ([]bool, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPlatformTokensSelect.Bools is not achievable when selecting more than 1 field")
-	}
-	var v []bool
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) BoolsX(ctx context.Context) []bool {
-	v, err := upts.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a selector. It is only allowed when selecting one field.
-func (upts *UserPlatformTokensSelect) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = upts.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userplatformtokens.Label}
-	default:
-		err = fmt.Errorf("ent: UserPlatformTokensSelect.Bools returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (upts *UserPlatformTokensSelect) BoolX(ctx context.Context) bool {
-	v, err := upts.Bool(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
 func (upts *UserPlatformTokensSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
-	query, args := upts.sqlQuery().Query()
+	query, args := upts.sql.Query()
 	if err := upts.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
-
-func (upts *UserPlatformTokensSelect) sqlQuery() sql.Querier {
-	selector := upts.sql
-	selector.Select(selector.Columns(upts.fields...)...)
-	return selector
-}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -21,9 +22,9 @@ type UserPlatformTokensUpdate struct {
 	mutation *UserPlatformTokensMutation
 }
 
-// Where adds a new predicate for the UserPlatformTokensUpdate builder.
+// Where appends a list predicates to the UserPlatformTokensUpdate builder.
 func (uptu *UserPlatformTokensUpdate) Where(ps ...predicate.UserPlatformTokens) *UserPlatformTokensUpdate {
-	uptu.mutation.predicates = append(uptu.mutation.predicates, ps...)
+	uptu.mutation.Where(ps...)
 	return uptu
 }
 
@@ -35,7 +36,7 @@ func (uptu *UserPlatformTokensUpdate) SetType(u uint8) *UserPlatformTokensUpdate
 }
 
 // AddType adds u to the "type" field.
-func (uptu *UserPlatformTokensUpdate) AddType(u uint8) *UserPlatformTokensUpdate {
+func (uptu *UserPlatformTokensUpdate) AddType(u int8) *UserPlatformTokensUpdate {
 	uptu.mutation.AddType(u)
 	return uptu
 }
@@ -64,6 +65,192 @@ func (uptu *UserPlatformTokensUpdate) SetAppID(s string) *UserPlatformTokensUpda
 	return uptu
 }
 
+// SetTimezone sets the "timezone" field.
+func (uptu *UserPlatformTokensUpdate) SetTimezone(s string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetTimezone(s)
+	return uptu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableTimezone(s *string) *UserPlatformTokensUpdate {
+	if s != nil {
+		uptu.SetTimezone(*s)
+	}
+	return uptu
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uptu *UserPlatformTokensUpdate) ClearTimezone() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearTimezone()
+	return uptu
+}
+
+// SetAppVersion sets the "app_version" field.
+func (uptu *UserPlatformTokensUpdate) SetAppVersion(s string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetAppVersion(s)
+	return uptu
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableAppVersion(s *string) *UserPlatformTokensUpdate {
+	if s != nil {
+		uptu.SetAppVersion(*s)
+	}
+	return uptu
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (uptu *UserPlatformTokensUpdate) ClearAppVersion() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearAppVersion()
+	return uptu
+}
+
+// SetAppVersionNum sets the "app_version_num" field.
+func (uptu *UserPlatformTokensUpdate) SetAppVersionNum(i int64) *UserPlatformTokensUpdate {
+	uptu.mutation.ResetAppVersionNum()
+	uptu.mutation.SetAppVersionNum(i)
+	return uptu
+}
+
+// SetNillableAppVersionNum sets the "app_version_num" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableAppVersionNum(i *int64) *UserPlatformTokensUpdate {
+	if i != nil {
+		uptu.SetAppVersionNum(*i)
+	}
+	return uptu
+}
+
+// AddAppVersionNum adds i to the "app_version_num" field.
+func (uptu *UserPlatformTokensUpdate) AddAppVersionNum(i int64) *UserPlatformTokensUpdate {
+	uptu.mutation.AddAppVersionNum(i)
+	return uptu
+}
+
+// ClearAppVersionNum clears the value of the "app_version_num" field.
+func (uptu *UserPlatformTokensUpdate) ClearAppVersionNum() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearAppVersionNum()
+	return uptu
+}
+
+// SetOsVersion sets the "os_version" field.
+func (uptu *UserPlatformTokensUpdate) SetOsVersion(s string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetOsVersion(s)
+	return uptu
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableOsVersion(s *string) *UserPlatformTokensUpdate {
+	if s != nil {
+		uptu.SetOsVersion(*s)
+	}
+	return uptu
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (uptu *UserPlatformTokensUpdate) ClearOsVersion() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearOsVersion()
+	return uptu
+}
+
+// SetOsVersionNum sets the "os_version_num" field.
+func (uptu *UserPlatformTokensUpdate) SetOsVersionNum(i int64) *UserPlatformTokensUpdate {
+	uptu.mutation.ResetOsVersionNum()
+	uptu.mutation.SetOsVersionNum(i)
+	return uptu
+}
+
+// SetNillableOsVersionNum sets the "os_version_num" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableOsVersionNum(i *int64) *UserPlatformTokensUpdate {
+	if i != nil {
+		uptu.SetOsVersionNum(*i)
+	}
+	return uptu
+}
+
+// AddOsVersionNum adds i to the "os_version_num" field.
+func (uptu *UserPlatformTokensUpdate) AddOsVersionNum(i int64) *UserPlatformTokensUpdate {
+	uptu.mutation.AddOsVersionNum(i)
+	return uptu
+}
+
+// ClearOsVersionNum clears the value of the "os_version_num" field.
+func (uptu *UserPlatformTokensUpdate) ClearOsVersionNum() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearOsVersionNum()
+	return uptu
+}
+
+// SetLocale sets the "locale" field.
+func (uptu *UserPlatformTokensUpdate) SetLocale(s string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetLocale(s)
+	return uptu
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableLocale(s *string) *UserPlatformTokensUpdate {
+	if s != nil {
+		uptu.SetLocale(*s)
+	}
+	return uptu
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uptu *UserPlatformTokensUpdate) ClearLocale() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearLocale()
+	return uptu
+}
+
+// SetCountry sets the "country" field.
+func (uptu *UserPlatformTokensUpdate) SetCountry(s string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetCountry(s)
+	return uptu
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableCountry(s *string) *UserPlatformTokensUpdate {
+	if s != nil {
+		uptu.SetCountry(*s)
+	}
+	return uptu
+}
+
+// ClearCountry clears the value of the "country" field.
+func (uptu *UserPlatformTokensUpdate) ClearCountry() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearCountry()
+	return uptu
+}
+
+// SetLastActiveAt sets the "last_active_at" field.
+func (uptu *UserPlatformTokensUpdate) SetLastActiveAt(t time.Time) *UserPlatformTokensUpdate {
+	uptu.mutation.SetLastActiveAt(t)
+	return uptu
+}
+
+// SetNillableLastActiveAt sets the "last_active_at" field if the given value is not nil.
+func (uptu *UserPlatformTokensUpdate) SetNillableLastActiveAt(t *time.Time) *UserPlatformTokensUpdate {
+	if t != nil {
+		uptu.SetLastActiveAt(*t)
+	}
+	return uptu
+}
+
+// ClearLastActiveAt clears the value of the "last_active_at" field.
+func (uptu *UserPlatformTokensUpdate) ClearLastActiveAt() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearLastActiveAt()
+	return uptu
+}
+
+// SetTags sets the "tags" field.
+func (uptu *UserPlatformTokensUpdate) SetTags(s []string) *UserPlatformTokensUpdate {
+	uptu.mutation.SetTags(s)
+	return uptu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (uptu *UserPlatformTokensUpdate) ClearTags() *UserPlatformTokensUpdate {
+	uptu.mutation.ClearTags()
+	return uptu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uptu *UserPlatformTokensUpdate) SetCreatedAt(t time.Time) *UserPlatformTokensUpdate {
 	uptu.mutation.SetCreatedAt(t)
@@ -101,6 +288,9 @@ func (uptu *UserPlatformTokensUpdate) Save(ctx context.Context) (int, error) {
 			return affected, err
 		})
 		for i := len(uptu.hooks) - 1; i >= 0; i-- {
+			if uptu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptu.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptu.mutation); err != nil {
@@ -192,6 +382,137 @@ func (uptu *UserPlatformTokensUpdate) sqlSave(ctx context.Context) (n int, err e
 			Column: userplatformtokens.FieldAppID,
 		})
 	}
+	if value, ok := uptu.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldTimezone,
+		})
+	}
+	if uptu.mutation.TimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldTimezone,
+		})
+	}
+	if value, ok := uptu.mutation.AppVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersion,
+		})
+	}
+	if uptu.mutation.AppVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldAppVersion,
+		})
+	}
+	if value, ok := uptu.mutation.AppVersionNum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if value, ok := uptu.mutation.AddedAppVersionNum(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if uptu.mutation.AppVersionNumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if value, ok := uptu.mutation.OsVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersion,
+		})
+	}
+	if uptu.mutation.OsVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldOsVersion,
+		})
+	}
+	if value, ok := uptu.mutation.OsVersionNum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if value, ok := uptu.mutation.AddedOsVersionNum(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if uptu.mutation.OsVersionNumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if value, ok := uptu.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldLocale,
+		})
+	}
+	if uptu.mutation.LocaleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldLocale,
+		})
+	}
+	if value, ok := uptu.mutation.Country(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldCountry,
+		})
+	}
+	if uptu.mutation.CountryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldCountry,
+		})
+	}
+	if value, ok := uptu.mutation.LastActiveAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userplatformtokens.FieldLastActiveAt,
+		})
+	}
+	if uptu.mutation.LastActiveAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: userplatformtokens.FieldLastActiveAt,
+		})
+	}
+	if value, ok := uptu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: userplatformtokens.FieldTags,
+		})
+	}
+	if uptu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: userplatformtokens.FieldTags,
+		})
+	}
 	if value, ok := uptu.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -209,8 +530,8 @@ func (uptu *UserPlatformTokensUpdate) sqlSave(ctx context.Context) (n int, err e
 	if n, err = sqlgraph.UpdateNodes(ctx, uptu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userplatformtokens.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return 0, err
 	}
@@ -233,7 +554,7 @@ func (uptuo *UserPlatformTokensUpdateOne) SetType(u uint8) *UserPlatformTokensUp
 }
 
 // AddType adds u to the "type" field.
-func (uptuo *UserPlatformTokensUpdateOne) AddType(u uint8) *UserPlatformTokensUpdateOne {
+func (uptuo *UserPlatformTokensUpdateOne) AddType(u int8) *UserPlatformTokensUpdateOne {
 	uptuo.mutation.AddType(u)
 	return uptuo
 }
@@ -262,6 +583,192 @@ func (uptuo *UserPlatformTokensUpdateOne) SetAppID(s string) *UserPlatformTokens
 	return uptuo
 }
 
+// SetTimezone sets the "timezone" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetTimezone(s string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetTimezone(s)
+	return uptuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableTimezone(s *string) *UserPlatformTokensUpdateOne {
+	if s != nil {
+		uptuo.SetTimezone(*s)
+	}
+	return uptuo
+}
+
+// ClearTimezone clears the value of the "timezone" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearTimezone() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearTimezone()
+	return uptuo
+}
+
+// SetAppVersion sets the "app_version" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetAppVersion(s string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetAppVersion(s)
+	return uptuo
+}
+
+// SetNillableAppVersion sets the "app_version" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableAppVersion(s *string) *UserPlatformTokensUpdateOne {
+	if s != nil {
+		uptuo.SetAppVersion(*s)
+	}
+	return uptuo
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearAppVersion() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearAppVersion()
+	return uptuo
+}
+
+// SetAppVersionNum sets the "app_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetAppVersionNum(i int64) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ResetAppVersionNum()
+	uptuo.mutation.SetAppVersionNum(i)
+	return uptuo
+}
+
+// SetNillableAppVersionNum sets the "app_version_num" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableAppVersionNum(i *int64) *UserPlatformTokensUpdateOne {
+	if i != nil {
+		uptuo.SetAppVersionNum(*i)
+	}
+	return uptuo
+}
+
+// AddAppVersionNum adds i to the "app_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) AddAppVersionNum(i int64) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.AddAppVersionNum(i)
+	return uptuo
+}
+
+// ClearAppVersionNum clears the value of the "app_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearAppVersionNum() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearAppVersionNum()
+	return uptuo
+}
+
+// SetOsVersion sets the "os_version" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetOsVersion(s string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetOsVersion(s)
+	return uptuo
+}
+
+// SetNillableOsVersion sets the "os_version" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableOsVersion(s *string) *UserPlatformTokensUpdateOne {
+	if s != nil {
+		uptuo.SetOsVersion(*s)
+	}
+	return uptuo
+}
+
+// ClearOsVersion clears the value of the "os_version" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearOsVersion() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearOsVersion()
+	return uptuo
+}
+
+// SetOsVersionNum sets the "os_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetOsVersionNum(i int64) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ResetOsVersionNum()
+	uptuo.mutation.SetOsVersionNum(i)
+	return uptuo
+}
+
+// SetNillableOsVersionNum sets the "os_version_num" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableOsVersionNum(i *int64) *UserPlatformTokensUpdateOne {
+	if i != nil {
+		uptuo.SetOsVersionNum(*i)
+	}
+	return uptuo
+}
+
+// AddOsVersionNum adds i to the "os_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) AddOsVersionNum(i int64) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.AddOsVersionNum(i)
+	return uptuo
+}
+
+// ClearOsVersionNum clears the value of the "os_version_num" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearOsVersionNum() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearOsVersionNum()
+	return uptuo
+}
+
+// SetLocale sets the "locale" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetLocale(s string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetLocale(s)
+	return uptuo
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableLocale(s *string) *UserPlatformTokensUpdateOne {
+	if s != nil {
+		uptuo.SetLocale(*s)
+	}
+	return uptuo
+}
+
+// ClearLocale clears the value of the "locale" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearLocale() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearLocale()
+	return uptuo
+}
+
+// SetCountry sets the "country" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetCountry(s string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetCountry(s)
+	return uptuo
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableCountry(s *string) *UserPlatformTokensUpdateOne {
+	if s != nil {
+		uptuo.SetCountry(*s)
+	}
+	return uptuo
+}
+
+// ClearCountry clears the value of the "country" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearCountry() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearCountry()
+	return uptuo
+}
+
+// SetLastActiveAt sets the "last_active_at" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetLastActiveAt(t time.Time) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetLastActiveAt(t)
+	return uptuo
+}
+
+// SetNillableLastActiveAt sets the "last_active_at" field if the given value is not nil.
+func (uptuo *UserPlatformTokensUpdateOne) SetNillableLastActiveAt(t *time.Time) *UserPlatformTokensUpdateOne {
+	if t != nil {
+		uptuo.SetLastActiveAt(*t)
+	}
+	return uptuo
+}
+
+// ClearLastActiveAt clears the value of the "last_active_at" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearLastActiveAt() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearLastActiveAt()
+	return uptuo
+}
+
+// SetTags sets the "tags" field.
+func (uptuo *UserPlatformTokensUpdateOne) SetTags(s []string) *UserPlatformTokensUpdateOne {
+	uptuo.mutation.SetTags(s)
+	return uptuo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (uptuo *UserPlatformTokensUpdateOne) ClearTags() *UserPlatformTokensUpdateOne {
+	uptuo.mutation.ClearTags()
+	return uptuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uptuo *UserPlatformTokensUpdateOne) SetCreatedAt(t time.Time) *UserPlatformTokensUpdateOne {
 	uptuo.mutation.SetCreatedAt(t)
@@ -306,6 +813,9 @@ func (uptuo *UserPlatformTokensUpdateOne) Save(ctx context.Context) (*UserPlatfo
 			return node, err
 		})
 		for i := len(uptuo.hooks) - 1; i >= 0; i-- {
+			if uptuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptuo.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptuo.mutation); err != nil {
@@ -350,7 +860,7 @@ func (uptuo *UserPlatformTokensUpdateOne) sqlSave(ctx context.Context) (_node *U
 	}
 	id, ok := uptuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing UserPlatformTokens.ID for update")}
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserPlatformTokens.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := uptuo.fields; len(fields) > 0 {
@@ -414,6 +924,137 @@ func (uptuo *UserPlatformTokensUpdateOne) sqlSave(ctx context.Context) (_node *U
 			Column: userplatformtokens.FieldAppID,
 		})
 	}
+	if value, ok := uptuo.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldTimezone,
+		})
+	}
+	if uptuo.mutation.TimezoneCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldTimezone,
+		})
+	}
+	if value, ok := uptuo.mutation.AppVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersion,
+		})
+	}
+	if uptuo.mutation.AppVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldAppVersion,
+		})
+	}
+	if value, ok := uptuo.mutation.AppVersionNum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if value, ok := uptuo.mutation.AddedAppVersionNum(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if uptuo.mutation.AppVersionNumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: userplatformtokens.FieldAppVersionNum,
+		})
+	}
+	if value, ok := uptuo.mutation.OsVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersion,
+		})
+	}
+	if uptuo.mutation.OsVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldOsVersion,
+		})
+	}
+	if value, ok := uptuo.mutation.OsVersionNum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if value, ok := uptuo.mutation.AddedOsVersionNum(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if uptuo.mutation.OsVersionNumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: userplatformtokens.FieldOsVersionNum,
+		})
+	}
+	if value, ok := uptuo.mutation.Locale(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldLocale,
+		})
+	}
+	if uptuo.mutation.LocaleCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldLocale,
+		})
+	}
+	if value, ok := uptuo.mutation.Country(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userplatformtokens.FieldCountry,
+		})
+	}
+	if uptuo.mutation.CountryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: userplatformtokens.FieldCountry,
+		})
+	}
+	if value, ok := uptuo.mutation.LastActiveAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userplatformtokens.FieldLastActiveAt,
+		})
+	}
+	if uptuo.mutation.LastActiveAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: userplatformtokens.FieldLastActiveAt,
+		})
+	}
+	if value, ok := uptuo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: userplatformtokens.FieldTags,
+		})
+	}
+	if uptuo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: userplatformtokens.FieldTags,
+		})
+	}
 	if value, ok := uptuo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -434,8 +1075,8 @@ func (uptuo *UserPlatformTokensUpdateOne) sqlSave(ctx context.Context) (_node *U
 	if err = sqlgraph.UpdateNode(ctx, uptuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userplatformtokens.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return nil, err
 	}
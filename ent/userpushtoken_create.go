@@ -93,11 +93,17 @@ func (uptc *UserPushTokenCreate) Save(ctx context.Context) (*UserPushToken, erro
 				return nil, err
 			}
 			uptc.mutation = mutation
-			node, err = uptc.sqlSave(ctx)
+			if node, err = uptc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
 			mutation.done = true
 			return node, err
 		})
 		for i := len(uptc.hooks) - 1; i >= 0; i-- {
+			if uptc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptc.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptc.mutation); err != nil {
@@ -116,6 +122,19 @@ func (uptc *UserPushTokenCreate) SaveX(ctx context.Context) *UserPushToken {
 	return v
 }
 
+// Exec executes the query.
+func (uptc *UserPushTokenCreate) Exec(ctx context.Context) error {
+	_, err := uptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uptc *UserPushTokenCreate) ExecX(ctx context.Context) {
+	if err := uptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // defaults sets the default values of the builder before save.
 func (uptc *UserPushTokenCreate) defaults() {
 	if _, ok := uptc.mutation.CreatedAt(); !ok {
@@ -131,19 +150,19 @@ func (uptc *UserPushTokenCreate) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (uptc *UserPushTokenCreate) check() error {
 	if _, ok := uptc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New("ent: missing required field \"user_id\"")}
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserPushToken.user_id"`)}
 	}
 	if _, ok := uptc.mutation.Token(); !ok {
-		return &ValidationError{Name: "token", err: errors.New("ent: missing required field \"token\"")}
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "UserPushToken.token"`)}
 	}
 	if _, ok := uptc.mutation.AppID(); !ok {
-		return &ValidationError{Name: "app_id", err: errors.New("ent: missing required field \"app_id\"")}
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "UserPushToken.app_id"`)}
 	}
 	if _, ok := uptc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserPushToken.created_at"`)}
 	}
 	if _, ok := uptc.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserPushToken.updated_at"`)}
 	}
 	return nil
 }
@@ -151,8 +170,8 @@ func (uptc *UserPushTokenCreate) check() error {
 func (uptc *UserPushTokenCreate) sqlSave(ctx context.Context) (*UserPushToken, error) {
 	_node, _spec := uptc.createSpec()
 	if err := sqlgraph.CreateNode(ctx, uptc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return nil, err
 	}
@@ -244,19 +263,23 @@ func (uptcb *UserPushTokenCreateBulk) Save(ctx context.Context) ([]*UserPushToke
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, uptcb.builders[i+1].mutation)
 				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, uptcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
-						if cerr, ok := isSQLConstraintError(err); ok {
-							err = cerr
+					if err = sqlgraph.BatchCreate(ctx, uptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
 						}
 					}
 				}
-				mutation.done = true
 				if err != nil {
 					return nil, err
 				}
-				id := specs[i].ID.Value.(int64)
-				nodes[i].ID = int(id)
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
 				return nodes[i], nil
 			})
 			for i := len(builder.hooks) - 1; i >= 0; i-- {
@@ -281,3 +304,16 @@ func (uptcb *UserPushTokenCreateBulk) SaveX(ctx context.Context) []*UserPushToke
 	}
 	return v
 }
+
+// Exec executes the query.
+func (uptcb *UserPushTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := uptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uptcb *UserPushTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := uptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
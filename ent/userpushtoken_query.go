@@ -4,7 +4,6 @@ package ent
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 
@@ -106,7 +105,7 @@ func (uptq *UserPushTokenQuery) FirstIDX(ctx context.Context) int {
 }
 
 // Only returns a single UserPushToken entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when exactly one UserPushToken entity is not found.
+// Returns a *NotSingularError when more than one UserPushToken entity is found.
 // Returns a *NotFoundError when no UserPushToken entities are found.
 func (uptq *UserPushTokenQuery) Only(ctx context.Context) (*UserPushToken, error) {
 	nodes, err := uptq.Limit(2).All(ctx)
@@ -133,7 +132,7 @@ func (uptq *UserPushTokenQuery) OnlyX(ctx context.Context) *UserPushToken {
 }
 
 // OnlyID is like Only, but returns the only UserPushToken ID in the query.
-// Returns a *NotSingularError when exactly one UserPushToken ID is not found.
+// Returns a *NotSingularError when more than one UserPushToken ID is found.
 // Returns a *NotFoundError when no entities are found.
 func (uptq *UserPushTokenQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
@@ -242,8 +241,9 @@ func (uptq *UserPushTokenQuery) Clone() *UserPushTokenQuery {
 		order:      append([]OrderFunc{}, uptq.order...),
 		predicates: append([]predicate.UserPushToken{}, uptq.predicates...),
 		// clone intermediate query.
-		sql:  uptq.sql.Clone(),
-		path: uptq.path,
+		sql:    uptq.sql.Clone(),
+		path:   uptq.path,
+		unique: uptq.unique,
 	}
 }
 
@@ -261,17 +261,18 @@ func (uptq *UserPushTokenQuery) Clone() *UserPushTokenQuery {
 //		GroupBy(userpushtoken.FieldUserID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (uptq *UserPushTokenQuery) GroupBy(field string, fields ...string) *UserPushTokenGroupBy {
-	group := &UserPushTokenGroupBy{config: uptq.config}
-	group.fields = append([]string{field}, fields...)
-	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+	grbuild := &UserPushTokenGroupBy{config: uptq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := uptq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
 		return uptq.sqlQuery(ctx), nil
 	}
-	return group
+	grbuild.label = userpushtoken.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
 }
 
 // Select allows the selection one or more fields/columns for the given query,
@@ -286,10 +287,12 @@ func (uptq *UserPushTokenQuery) GroupBy(field string, fields ...string) *UserPus
 //	client.UserPushToken.Query().
 //		Select(userpushtoken.FieldUserID).
 //		Scan(ctx, &v)
-//
-func (uptq *UserPushTokenQuery) Select(field string, fields ...string) *UserPushTokenSelect {
-	uptq.fields = append([]string{field}, fields...)
-	return &UserPushTokenSelect{UserPushTokenQuery: uptq}
+func (uptq *UserPushTokenQuery) Select(fields ...string) *UserPushTokenSelect {
+	uptq.fields = append(uptq.fields, fields...)
+	selbuild := &UserPushTokenSelect{UserPushTokenQuery: uptq}
+	selbuild.label = userpushtoken.Label
+	selbuild.flds, selbuild.scan = &uptq.fields, selbuild.Scan
+	return selbuild
 }
 
 func (uptq *UserPushTokenQuery) prepareQuery(ctx context.Context) error {
@@ -308,23 +311,22 @@ func (uptq *UserPushTokenQuery) prepareQuery(ctx context.Context) error {
 	return nil
 }
 
-func (uptq *UserPushTokenQuery) sqlAll(ctx context.Context) ([]*UserPushToken, error) {
+func (uptq *UserPushTokenQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserPushToken, error) {
 	var (
 		nodes = []*UserPushToken{}
 		_spec = uptq.querySpec()
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
-		node := &UserPushToken{config: uptq.config}
-		nodes = append(nodes, node)
-		return node.scanValues(columns)
+		return (*UserPushToken).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
-		if len(nodes) == 0 {
-			return fmt.Errorf("ent: Assign called without calling ScanValues")
-		}
-		node := nodes[len(nodes)-1]
+		node := &UserPushToken{config: uptq.config}
+		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
 	if err := sqlgraph.QueryNodes(ctx, uptq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -336,6 +338,10 @@ func (uptq *UserPushTokenQuery) sqlAll(ctx context.Context) ([]*UserPushToken, e
 
 func (uptq *UserPushTokenQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uptq.querySpec()
+	_spec.Node.Columns = uptq.fields
+	if len(uptq.fields) > 0 {
+		_spec.Unique = uptq.unique != nil && *uptq.unique
+	}
 	return sqlgraph.CountNodes(ctx, uptq.driver, _spec)
 }
 
@@ -398,10 +404,17 @@ func (uptq *UserPushTokenQuery) querySpec() *sqlgraph.QuerySpec {
 func (uptq *UserPushTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(uptq.driver.Dialect())
 	t1 := builder.Table(userpushtoken.Table)
-	selector := builder.Select(t1.Columns(userpushtoken.Columns...)...).From(t1)
+	columns := uptq.fields
+	if len(columns) == 0 {
+		columns = userpushtoken.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
 	if uptq.sql != nil {
 		selector = uptq.sql
-		selector.Select(selector.Columns(userpushtoken.Columns...)...)
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uptq.unique != nil && *uptq.unique {
+		selector.Distinct()
 	}
 	for _, p := range uptq.predicates {
 		p(selector)
@@ -423,6 +436,7 @@ func (uptq *UserPushTokenQuery) sqlQuery(ctx context.Context) *sql.Selector {
 // UserPushTokenGroupBy is the group-by builder for UserPushToken entities.
 type UserPushTokenGroupBy struct {
 	config
+	selector
 	fields []string
 	fns    []AggregateFunc
 	// intermediate query (i.e. traversal path).
@@ -446,209 +460,6 @@ func (uptgb *UserPushTokenGroupBy) Scan(ctx context.Context, v interface{}) erro
 	return uptgb.sqlScan(ctx, v)
 }
 
-// ScanX is like Scan, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) ScanX(ctx context.Context, v interface{}) {
-	if err := uptgb.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Strings(ctx context.Context) ([]string, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenGroupBy.Strings is not achievable when grouping more than 1 field")
-	}
-	var v []string
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) StringsX(ctx context.Context) []string {
-	v, err := uptgb.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = uptgb.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenGroupBy.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) StringX(ctx context.Context) string {
-	v, err := uptgb.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Ints(ctx context.Context) ([]int, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenGroupBy.Ints is not achievable when grouping more than 1 field")
-	}
-	var v []int
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) IntsX(ctx context.Context) []int {
-	v, err := uptgb.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Int returns a single int from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = uptgb.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenGroupBy.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) IntX(ctx context.Context) int {
-	v, err := uptgb.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Float64s(ctx context.Context) ([]float64, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenGroupBy.Float64s is not achievable when grouping more than 1 field")
-	}
-	var v []float64
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) Float64sX(ctx context.Context) []float64 {
-	v, err := uptgb.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = uptgb.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenGroupBy.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) Float64X(ctx context.Context) float64 {
-	v, err := uptgb.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from group-by.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Bools(ctx context.Context) ([]bool, error) {
-	if len(uptgb.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenGroupBy.Bools is not achievable when grouping more than 1 field")
-	}
-	var v []bool
-	if err := uptgb.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) BoolsX(ctx context.Context) []bool {
-	v, err := uptgb.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a group-by query.
-// It is only allowed when executing a group-by query with one field.
-func (uptgb *UserPushTokenGroupBy) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = uptgb.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenGroupBy.Bools returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (uptgb *UserPushTokenGroupBy) BoolX(ctx context.Context) bool {
-	v, err := uptgb.Bool(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
 func (uptgb *UserPushTokenGroupBy) sqlScan(ctx context.Context, v interface{}) error {
 	for _, f := range uptgb.fields {
 		if !userpushtoken.ValidColumn(f) {
@@ -669,18 +480,28 @@ func (uptgb *UserPushTokenGroupBy) sqlScan(ctx context.Context, v interface{}) e
 }
 
 func (uptgb *UserPushTokenGroupBy) sqlQuery() *sql.Selector {
-	selector := uptgb.sql
-	columns := make([]string, 0, len(uptgb.fields)+len(uptgb.fns))
-	columns = append(columns, uptgb.fields...)
+	selector := uptgb.sql.Select()
+	aggregation := make([]string, 0, len(uptgb.fns))
 	for _, fn := range uptgb.fns {
-		columns = append(columns, fn(selector))
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(uptgb.fields)+len(uptgb.fns))
+		for _, f := range uptgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
 	}
-	return selector.Select(columns...).GroupBy(uptgb.fields...)
+	return selector.GroupBy(selector.Columns(uptgb.fields...)...)
 }
 
 // UserPushTokenSelect is the builder for selecting fields of UserPushToken entities.
 type UserPushTokenSelect struct {
 	*UserPushTokenQuery
+	selector
 	// intermediate query (i.e. traversal path).
 	sql *sql.Selector
 }
@@ -694,213 +515,12 @@ func (upts *UserPushTokenSelect) Scan(ctx context.Context, v interface{}) error
 	return upts.sqlScan(ctx, v)
 }
 
-// ScanX is like Scan, but panics if an error occurs.
-func (upts *UserPushTokenSelect) ScanX(ctx context.Context, v interface{}) {
-	if err := upts.Scan(ctx, v); err != nil {
-		panic(err)
-	}
-}
-
-// Strings returns list of strings from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Strings(ctx context.Context) ([]string, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenSelect.Strings is not achievable when selecting more than 1 field")
-	}
-	var v []string
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// StringsX is like Strings, but panics if an error occurs.
-func (upts *UserPushTokenSelect) StringsX(ctx context.Context) []string {
-	v, err := upts.Strings(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// String returns a single string from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) String(ctx context.Context) (_ string, err error) {
-	var v []string
-	if v, err = upts.Strings(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenSelect.Strings returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// StringX is like String, but panics if an error occurs.
-func (upts *UserPushTokenSelect) StringX(ctx context.Context) string {
-	v, err := upts.String(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Ints returns list of ints from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Ints(ctx context.Context) ([]int, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenSelect.Ints is not achievable when selecting more than 1 field")
-	}
-	var v []int
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// IntsX is like Ints, but panics if an error occurs.
-func (upts *UserPushTokenSelect) IntsX(ctx context.Context) []int {
-	v, err := upts.Ints(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Int returns a single int from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Int(ctx context.Context) (_ int, err error) {
-	var v []int
-	if v, err = upts.Ints(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenSelect.Ints returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// IntX is like Int, but panics if an error occurs.
-func (upts *UserPushTokenSelect) IntX(ctx context.Context) int {
-	v, err := upts.Int(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Float64s(ctx context.Context) ([]float64, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenSelect.Float64s is not achievable when selecting more than 1 field")
-	}
-	var v []float64
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// Float64sX is like Float64s, but panics if an error occurs.
-func (upts *UserPushTokenSelect) Float64sX(ctx context.Context) []float64 {
-	v, err := upts.Float64s(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Float64(ctx context.Context) (_ float64, err error) {
-	var v []float64
-	if v, err = upts.Float64s(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenSelect.Float64s returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// Float64X is like Float64, but panics if an error occurs.
-func (upts *UserPushTokenSelect) Float64X(ctx context.Context) float64 {
-	v, err := upts.Float64(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bools returns list of bools from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Bools(ctx context.Context) ([]bool, error) {
-	if len(upts.fields) > 1 {
-		return nil, errors.New("ent: UserPushTokenSelect.Bools is not achievable when selecting more than 1 field")
-	}
-	var v []bool
-	if err := upts.Scan(ctx, &v); err != nil {
-		return nil, err
-	}
-	return v, nil
-}
-
-// BoolsX is like Bools, but panics if an error occurs.
-func (upts *UserPushTokenSelect) BoolsX(ctx context.Context) []bool {
-	v, err := upts.Bools(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
-// Bool returns a single bool from a selector. It is only allowed when selecting one field.
-func (upts *UserPushTokenSelect) Bool(ctx context.Context) (_ bool, err error) {
-	var v []bool
-	if v, err = upts.Bools(ctx); err != nil {
-		return
-	}
-	switch len(v) {
-	case 1:
-		return v[0], nil
-	case 0:
-		err = &NotFoundError{userpushtoken.Label}
-	default:
-		err = fmt.Errorf("ent: UserPushTokenSelect.Bools returned %d results when one was expected", len(v))
-	}
-	return
-}
-
-// BoolX is like Bool, but panics if an error occurs.
-func (upts *UserPushTokenSelect) BoolX(ctx context.Context) bool {
-	v, err := upts.Bool(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
-}
-
 func (upts *UserPushTokenSelect) sqlScan(ctx context.Context, v interface{}) error {
 	rows := &sql.Rows{}
-	query, args := upts.sqlQuery().Query()
+	query, args := upts.sql.Query()
 	if err := upts.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
-
-func (upts *UserPushTokenSelect) sqlQuery() sql.Querier {
-	selector := upts.sql
-	selector.Select(selector.Columns(upts.fields...)...)
-	return selector
-}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -21,9 +22,9 @@ type UserPushTokenUpdate struct {
 	mutation *UserPushTokenMutation
 }
 
-// Where adds a new predicate for the UserPushTokenUpdate builder.
+// Where appends a list predicates to the UserPushTokenUpdate builder.
 func (uptu *UserPushTokenUpdate) Where(ps ...predicate.UserPushToken) *UserPushTokenUpdate {
-	uptu.mutation.predicates = append(uptu.mutation.predicates, ps...)
+	uptu.mutation.Where(ps...)
 	return uptu
 }
 
@@ -91,6 +92,9 @@ func (uptu *UserPushTokenUpdate) Save(ctx context.Context) (int, error) {
 			return affected, err
 		})
 		for i := len(uptu.hooks) - 1; i >= 0; i-- {
+			if uptu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptu.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptu.mutation); err != nil {
@@ -186,8 +190,8 @@ func (uptu *UserPushTokenUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if n, err = sqlgraph.UpdateNodes(ctx, uptu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userpushtoken.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return 0, err
 	}
@@ -273,6 +277,9 @@ func (uptuo *UserPushTokenUpdateOne) Save(ctx context.Context) (*UserPushToken,
 			return node, err
 		})
 		for i := len(uptuo.hooks) - 1; i >= 0; i-- {
+			if uptuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
 			mut = uptuo.hooks[i](mut)
 		}
 		if _, err := mut.Mutate(ctx, uptuo.mutation); err != nil {
@@ -325,7 +332,7 @@ func (uptuo *UserPushTokenUpdateOne) sqlSave(ctx context.Context) (_node *UserPu
 	}
 	id, ok := uptuo.mutation.ID()
 	if !ok {
-		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing UserPushToken.ID for update")}
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserPushToken.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
 	if fields := uptuo.fields; len(fields) > 0 {
@@ -388,8 +395,8 @@ func (uptuo *UserPushTokenUpdateOne) sqlSave(ctx context.Context) (_node *UserPu
 	if err = sqlgraph.UpdateNode(ctx, uptuo.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{userpushtoken.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
 		}
 		return nil, err
 	}
@@ -0,0 +1,174 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+)
+
+// UserQuietHours is the model entity for the UserQuietHours schema.
+type UserQuietHours struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Start holds the value of the "start" field.
+	Start string `json:"start,omitempty"`
+	// End holds the value of the "end" field.
+	End string `json:"end,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserQuietHours) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userquiethours.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case userquiethours.FieldID:
+			values[i] = new(sql.NullInt64)
+		case userquiethours.FieldUserID, userquiethours.FieldAppID, userquiethours.FieldStart, userquiethours.FieldEnd, userquiethours.FieldTimezone:
+			values[i] = new(sql.NullString)
+		case userquiethours.FieldCreatedAt, userquiethours.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type UserQuietHours", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserQuietHours fields.
+func (uqh *UserQuietHours) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userquiethours.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uqh.ID = int(value.Int64)
+		case userquiethours.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				uqh.UserID = value.String
+			}
+		case userquiethours.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				uqh.AppID = value.String
+			}
+		case userquiethours.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				uqh.Enabled = value.Bool
+			}
+		case userquiethours.FieldStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start", values[i])
+			} else if value.Valid {
+				uqh.Start = value.String
+			}
+		case userquiethours.FieldEnd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end", values[i])
+			} else if value.Valid {
+				uqh.End = value.String
+			}
+		case userquiethours.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				uqh.Timezone = value.String
+			}
+		case userquiethours.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				uqh.CreatedAt = value.Time
+			}
+		case userquiethours.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				uqh.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this UserQuietHours.
+// Note that you need to call UserQuietHours.Unwrap() before calling this method if this UserQuietHours
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uqh *UserQuietHours) Update() *UserQuietHoursUpdateOne {
+	return (&UserQuietHoursClient{config: uqh.config}).UpdateOne(uqh)
+}
+
+// Unwrap unwraps the UserQuietHours entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uqh *UserQuietHours) Unwrap() *UserQuietHours {
+	tx, ok := uqh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserQuietHours is not a transactional entity")
+	}
+	uqh.config.driver = tx.drv
+	return uqh
+}
+
+// String implements the fmt.Stringer.
+func (uqh *UserQuietHours) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserQuietHours(")
+	builder.WriteString(fmt.Sprintf("id=%v", uqh.ID))
+	builder.WriteString(", user_id=")
+	builder.WriteString(uqh.UserID)
+	builder.WriteString(", app_id=")
+	builder.WriteString(uqh.AppID)
+	builder.WriteString(", enabled=")
+	builder.WriteString(fmt.Sprintf("%v", uqh.Enabled))
+	builder.WriteString(", start=")
+	builder.WriteString(uqh.Start)
+	builder.WriteString(", end=")
+	builder.WriteString(uqh.End)
+	builder.WriteString(", timezone=")
+	builder.WriteString(uqh.Timezone)
+	builder.WriteString(", created_at=")
+	builder.WriteString(uqh.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(uqh.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserQuietHoursSlice is a parsable slice of UserQuietHours.
+type UserQuietHoursSlice []*UserQuietHours
+
+func (uqh UserQuietHoursSlice) config(cfg config) {
+	for _i := range uqh {
+		uqh[_i].config = cfg
+	}
+}
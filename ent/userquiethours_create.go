@@ -0,0 +1,394 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+)
+
+// UserQuietHoursCreate is the builder for creating a UserQuietHours entity.
+type UserQuietHoursCreate struct {
+	config
+	mutation *UserQuietHoursMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (uqhc *UserQuietHoursCreate) SetUserID(s string) *UserQuietHoursCreate {
+	uqhc.mutation.SetUserID(s)
+	return uqhc
+}
+
+// SetAppID sets the "app_id" field.
+func (uqhc *UserQuietHoursCreate) SetAppID(s string) *UserQuietHoursCreate {
+	uqhc.mutation.SetAppID(s)
+	return uqhc
+}
+
+// SetEnabled sets the "enabled" field.
+func (uqhc *UserQuietHoursCreate) SetEnabled(b bool) *UserQuietHoursCreate {
+	uqhc.mutation.SetEnabled(b)
+	return uqhc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (uqhc *UserQuietHoursCreate) SetNillableEnabled(b *bool) *UserQuietHoursCreate {
+	if b != nil {
+		uqhc.SetEnabled(*b)
+	}
+	return uqhc
+}
+
+// SetStart sets the "start" field.
+func (uqhc *UserQuietHoursCreate) SetStart(s string) *UserQuietHoursCreate {
+	uqhc.mutation.SetStart(s)
+	return uqhc
+}
+
+// SetEnd sets the "end" field.
+func (uqhc *UserQuietHoursCreate) SetEnd(s string) *UserQuietHoursCreate {
+	uqhc.mutation.SetEnd(s)
+	return uqhc
+}
+
+// SetTimezone sets the "timezone" field.
+func (uqhc *UserQuietHoursCreate) SetTimezone(s string) *UserQuietHoursCreate {
+	uqhc.mutation.SetTimezone(s)
+	return uqhc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uqhc *UserQuietHoursCreate) SetNillableTimezone(s *string) *UserQuietHoursCreate {
+	if s != nil {
+		uqhc.SetTimezone(*s)
+	}
+	return uqhc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uqhc *UserQuietHoursCreate) SetCreatedAt(t time.Time) *UserQuietHoursCreate {
+	uqhc.mutation.SetCreatedAt(t)
+	return uqhc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uqhc *UserQuietHoursCreate) SetNillableCreatedAt(t *time.Time) *UserQuietHoursCreate {
+	if t != nil {
+		uqhc.SetCreatedAt(*t)
+	}
+	return uqhc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uqhc *UserQuietHoursCreate) SetUpdatedAt(t time.Time) *UserQuietHoursCreate {
+	uqhc.mutation.SetUpdatedAt(t)
+	return uqhc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uqhc *UserQuietHoursCreate) SetNillableUpdatedAt(t *time.Time) *UserQuietHoursCreate {
+	if t != nil {
+		uqhc.SetUpdatedAt(*t)
+	}
+	return uqhc
+}
+
+// Mutation returns the UserQuietHoursMutation object of the builder.
+func (uqhc *UserQuietHoursCreate) Mutation() *UserQuietHoursMutation {
+	return uqhc.mutation
+}
+
+// Save creates the UserQuietHours in the database.
+func (uqhc *UserQuietHoursCreate) Save(ctx context.Context) (*UserQuietHours, error) {
+	var (
+		err  error
+		node *UserQuietHours
+	)
+	uqhc.defaults()
+	if len(uqhc.hooks) == 0 {
+		if err = uqhc.check(); err != nil {
+			return nil, err
+		}
+		node, err = uqhc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserQuietHoursMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = uqhc.check(); err != nil {
+				return nil, err
+			}
+			uqhc.mutation = mutation
+			if node, err = uqhc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uqhc.hooks) - 1; i >= 0; i-- {
+			if uqhc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = uqhc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uqhc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uqhc *UserQuietHoursCreate) SaveX(ctx context.Context) *UserQuietHours {
+	v, err := uqhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uqhc *UserQuietHoursCreate) Exec(ctx context.Context) error {
+	_, err := uqhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uqhc *UserQuietHoursCreate) ExecX(ctx context.Context) {
+	if err := uqhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uqhc *UserQuietHoursCreate) defaults() {
+	if _, ok := uqhc.mutation.Enabled(); !ok {
+		v := userquiethours.DefaultEnabled
+		uqhc.mutation.SetEnabled(v)
+	}
+	if _, ok := uqhc.mutation.Timezone(); !ok {
+		v := userquiethours.DefaultTimezone
+		uqhc.mutation.SetTimezone(v)
+	}
+	if _, ok := uqhc.mutation.CreatedAt(); !ok {
+		v := userquiethours.DefaultCreatedAt()
+		uqhc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uqhc.mutation.UpdatedAt(); !ok {
+		v := userquiethours.DefaultUpdatedAt()
+		uqhc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uqhc *UserQuietHoursCreate) check() error {
+	if _, ok := uqhc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserQuietHours.user_id"`)}
+	}
+	if _, ok := uqhc.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "UserQuietHours.app_id"`)}
+	}
+	if _, ok := uqhc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "UserQuietHours.enabled"`)}
+	}
+	if _, ok := uqhc.mutation.Start(); !ok {
+		return &ValidationError{Name: "start", err: errors.New(`ent: missing required field "UserQuietHours.start"`)}
+	}
+	if _, ok := uqhc.mutation.End(); !ok {
+		return &ValidationError{Name: "end", err: errors.New(`ent: missing required field "UserQuietHours.end"`)}
+	}
+	if _, ok := uqhc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "UserQuietHours.timezone"`)}
+	}
+	if _, ok := uqhc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserQuietHours.created_at"`)}
+	}
+	if _, ok := uqhc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserQuietHours.updated_at"`)}
+	}
+	return nil
+}
+
+func (uqhc *UserQuietHoursCreate) sqlSave(ctx context.Context) (*UserQuietHours, error) {
+	_node, _spec := uqhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uqhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (uqhc *UserQuietHoursCreate) createSpec() (*UserQuietHours, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserQuietHours{config: uqhc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: userquiethours.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userquiethours.FieldID,
+			},
+		}
+	)
+	if value, ok := uqhc.mutation.UserID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldUserID,
+		})
+		_node.UserID = value
+	}
+	if value, ok := uqhc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := uqhc.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: userquiethours.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := uqhc.mutation.Start(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldStart,
+		})
+		_node.Start = value
+	}
+	if value, ok := uqhc.mutation.End(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldEnd,
+		})
+		_node.End = value
+	}
+	if value, ok := uqhc.mutation.Timezone(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldTimezone,
+		})
+		_node.Timezone = value
+	}
+	if value, ok := uqhc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := uqhc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UserQuietHoursCreateBulk is the builder for creating many UserQuietHours entities in bulk.
+type UserQuietHoursCreateBulk struct {
+	config
+	builders []*UserQuietHoursCreate
+}
+
+// Save creates the UserQuietHours entities in the database.
+func (uqhcb *UserQuietHoursCreateBulk) Save(ctx context.Context) ([]*UserQuietHours, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(uqhcb.builders))
+	nodes := make([]*UserQuietHours, len(uqhcb.builders))
+	mutators := make([]Mutator, len(uqhcb.builders))
+	for i := range uqhcb.builders {
+		func(i int, root context.Context) {
+			builder := uqhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserQuietHoursMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uqhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uqhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uqhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uqhcb *UserQuietHoursCreateBulk) SaveX(ctx context.Context) []*UserQuietHours {
+	v, err := uqhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uqhcb *UserQuietHoursCreateBulk) Exec(ctx context.Context) error {
+	_, err := uqhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uqhcb *UserQuietHoursCreateBulk) ExecX(ctx context.Context) {
+	if err := uqhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
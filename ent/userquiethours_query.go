@@ -0,0 +1,526 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+)
+
+// UserQuietHoursQuery is the builder for querying UserQuietHours entities.
+type UserQuietHoursQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.UserQuietHours
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserQuietHoursQuery builder.
+func (uqhq *UserQuietHoursQuery) Where(ps ...predicate.UserQuietHours) *UserQuietHoursQuery {
+	uqhq.predicates = append(uqhq.predicates, ps...)
+	return uqhq
+}
+
+// Limit adds a limit step to the query.
+func (uqhq *UserQuietHoursQuery) Limit(limit int) *UserQuietHoursQuery {
+	uqhq.limit = &limit
+	return uqhq
+}
+
+// Offset adds an offset step to the query.
+func (uqhq *UserQuietHoursQuery) Offset(offset int) *UserQuietHoursQuery {
+	uqhq.offset = &offset
+	return uqhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uqhq *UserQuietHoursQuery) Unique(unique bool) *UserQuietHoursQuery {
+	uqhq.unique = &unique
+	return uqhq
+}
+
+// Order adds an order step to the query.
+func (uqhq *UserQuietHoursQuery) Order(o ...OrderFunc) *UserQuietHoursQuery {
+	uqhq.order = append(uqhq.order, o...)
+	return uqhq
+}
+
+// First returns the first UserQuietHours entity from the query.
+// Returns a *NotFoundError when no UserQuietHours was found.
+func (uqhq *UserQuietHoursQuery) First(ctx context.Context) (*UserQuietHours, error) {
+	nodes, err := uqhq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userquiethours.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) FirstX(ctx context.Context) *UserQuietHours {
+	node, err := uqhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserQuietHours ID from the query.
+// Returns a *NotFoundError when no UserQuietHours ID was found.
+func (uqhq *UserQuietHoursQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uqhq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userquiethours.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) FirstIDX(ctx context.Context) int {
+	id, err := uqhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserQuietHours entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserQuietHours entity is found.
+// Returns a *NotFoundError when no UserQuietHours entities are found.
+func (uqhq *UserQuietHoursQuery) Only(ctx context.Context) (*UserQuietHours, error) {
+	nodes, err := uqhq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userquiethours.Label}
+	default:
+		return nil, &NotSingularError{userquiethours.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) OnlyX(ctx context.Context) *UserQuietHours {
+	node, err := uqhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserQuietHours ID in the query.
+// Returns a *NotSingularError when more than one UserQuietHours ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uqhq *UserQuietHoursQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uqhq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userquiethours.Label}
+	default:
+		err = &NotSingularError{userquiethours.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) OnlyIDX(ctx context.Context) int {
+	id, err := uqhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserQuietHoursSlice.
+func (uqhq *UserQuietHoursQuery) All(ctx context.Context) ([]*UserQuietHours, error) {
+	if err := uqhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return uqhq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) AllX(ctx context.Context) []*UserQuietHours {
+	nodes, err := uqhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserQuietHours IDs.
+func (uqhq *UserQuietHoursQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := uqhq.Select(userquiethours.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) IDsX(ctx context.Context) []int {
+	ids, err := uqhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uqhq *UserQuietHoursQuery) Count(ctx context.Context) (int, error) {
+	if err := uqhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return uqhq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) CountX(ctx context.Context) int {
+	count, err := uqhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uqhq *UserQuietHoursQuery) Exist(ctx context.Context) (bool, error) {
+	if err := uqhq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return uqhq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uqhq *UserQuietHoursQuery) ExistX(ctx context.Context) bool {
+	exist, err := uqhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserQuietHoursQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uqhq *UserQuietHoursQuery) Clone() *UserQuietHoursQuery {
+	if uqhq == nil {
+		return nil
+	}
+	return &UserQuietHoursQuery{
+		config:     uqhq.config,
+		limit:      uqhq.limit,
+		offset:     uqhq.offset,
+		order:      append([]OrderFunc{}, uqhq.order...),
+		predicates: append([]predicate.UserQuietHours{}, uqhq.predicates...),
+		// clone intermediate query.
+		sql:    uqhq.sql.Clone(),
+		path:   uqhq.path,
+		unique: uqhq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserQuietHours.Query().
+//		GroupBy(userquiethours.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uqhq *UserQuietHoursQuery) GroupBy(field string, fields ...string) *UserQuietHoursGroupBy {
+	grbuild := &UserQuietHoursGroupBy{config: uqhq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := uqhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return uqhq.sqlQuery(ctx), nil
+	}
+	grbuild.label = userquiethours.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserQuietHours.Query().
+//		Select(userquiethours.FieldUserID).
+//		Scan(ctx, &v)
+func (uqhq *UserQuietHoursQuery) Select(fields ...string) *UserQuietHoursSelect {
+	uqhq.fields = append(uqhq.fields, fields...)
+	selbuild := &UserQuietHoursSelect{UserQuietHoursQuery: uqhq}
+	selbuild.label = userquiethours.Label
+	selbuild.flds, selbuild.scan = &uqhq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (uqhq *UserQuietHoursQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range uqhq.fields {
+		if !userquiethours.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uqhq.path != nil {
+		prev, err := uqhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uqhq.sql = prev
+	}
+	return nil
+}
+
+func (uqhq *UserQuietHoursQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserQuietHours, error) {
+	var (
+		nodes = []*UserQuietHours{}
+		_spec = uqhq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*UserQuietHours).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &UserQuietHours{config: uqhq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uqhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (uqhq *UserQuietHoursQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uqhq.querySpec()
+	_spec.Node.Columns = uqhq.fields
+	if len(uqhq.fields) > 0 {
+		_spec.Unique = uqhq.unique != nil && *uqhq.unique
+	}
+	return sqlgraph.CountNodes(ctx, uqhq.driver, _spec)
+}
+
+func (uqhq *UserQuietHoursQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := uqhq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (uqhq *UserQuietHoursQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userquiethours.Table,
+			Columns: userquiethours.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userquiethours.FieldID,
+			},
+		},
+		From:   uqhq.sql,
+		Unique: true,
+	}
+	if unique := uqhq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := uqhq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userquiethours.FieldID)
+		for i := range fields {
+			if fields[i] != userquiethours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := uqhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uqhq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uqhq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uqhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uqhq *UserQuietHoursQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uqhq.driver.Dialect())
+	t1 := builder.Table(userquiethours.Table)
+	columns := uqhq.fields
+	if len(columns) == 0 {
+		columns = userquiethours.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uqhq.sql != nil {
+		selector = uqhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uqhq.unique != nil && *uqhq.unique {
+		selector.Distinct()
+	}
+	for _, p := range uqhq.predicates {
+		p(selector)
+	}
+	for _, p := range uqhq.order {
+		p(selector)
+	}
+	if offset := uqhq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uqhq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserQuietHoursGroupBy is the group-by builder for UserQuietHours entities.
+type UserQuietHoursGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uqhgb *UserQuietHoursGroupBy) Aggregate(fns ...AggregateFunc) *UserQuietHoursGroupBy {
+	uqhgb.fns = append(uqhgb.fns, fns...)
+	return uqhgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (uqhgb *UserQuietHoursGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := uqhgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	uqhgb.sql = query
+	return uqhgb.sqlScan(ctx, v)
+}
+
+func (uqhgb *UserQuietHoursGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range uqhgb.fields {
+		if !userquiethours.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := uqhgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uqhgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (uqhgb *UserQuietHoursGroupBy) sqlQuery() *sql.Selector {
+	selector := uqhgb.sql.Select()
+	aggregation := make([]string, 0, len(uqhgb.fns))
+	for _, fn := range uqhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(uqhgb.fields)+len(uqhgb.fns))
+		for _, f := range uqhgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(uqhgb.fields...)...)
+}
+
+// UserQuietHoursSelect is the builder for selecting fields of UserQuietHours entities.
+type UserQuietHoursSelect struct {
+	*UserQuietHoursQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uqhs *UserQuietHoursSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := uqhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	uqhs.sql = uqhs.UserQuietHoursQuery.sqlQuery(ctx)
+	return uqhs.sqlScan(ctx, v)
+}
+
+func (uqhs *UserQuietHoursSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := uqhs.sql.Query()
+	if err := uqhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,514 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+)
+
+// UserQuietHoursUpdate is the builder for updating UserQuietHours entities.
+type UserQuietHoursUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserQuietHoursMutation
+}
+
+// Where appends a list predicates to the UserQuietHoursUpdate builder.
+func (uqhu *UserQuietHoursUpdate) Where(ps ...predicate.UserQuietHours) *UserQuietHoursUpdate {
+	uqhu.mutation.Where(ps...)
+	return uqhu
+}
+
+// SetUserID sets the "user_id" field.
+func (uqhu *UserQuietHoursUpdate) SetUserID(s string) *UserQuietHoursUpdate {
+	uqhu.mutation.SetUserID(s)
+	return uqhu
+}
+
+// SetAppID sets the "app_id" field.
+func (uqhu *UserQuietHoursUpdate) SetAppID(s string) *UserQuietHoursUpdate {
+	uqhu.mutation.SetAppID(s)
+	return uqhu
+}
+
+// SetEnabled sets the "enabled" field.
+func (uqhu *UserQuietHoursUpdate) SetEnabled(b bool) *UserQuietHoursUpdate {
+	uqhu.mutation.SetEnabled(b)
+	return uqhu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (uqhu *UserQuietHoursUpdate) SetNillableEnabled(b *bool) *UserQuietHoursUpdate {
+	if b != nil {
+		uqhu.SetEnabled(*b)
+	}
+	return uqhu
+}
+
+// SetStart sets the "start" field.
+func (uqhu *UserQuietHoursUpdate) SetStart(s string) *UserQuietHoursUpdate {
+	uqhu.mutation.SetStart(s)
+	return uqhu
+}
+
+// SetEnd sets the "end" field.
+func (uqhu *UserQuietHoursUpdate) SetEnd(s string) *UserQuietHoursUpdate {
+	uqhu.mutation.SetEnd(s)
+	return uqhu
+}
+
+// SetTimezone sets the "timezone" field.
+func (uqhu *UserQuietHoursUpdate) SetTimezone(s string) *UserQuietHoursUpdate {
+	uqhu.mutation.SetTimezone(s)
+	return uqhu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uqhu *UserQuietHoursUpdate) SetNillableTimezone(s *string) *UserQuietHoursUpdate {
+	if s != nil {
+		uqhu.SetTimezone(*s)
+	}
+	return uqhu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uqhu *UserQuietHoursUpdate) SetCreatedAt(t time.Time) *UserQuietHoursUpdate {
+	uqhu.mutation.SetCreatedAt(t)
+	return uqhu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uqhu *UserQuietHoursUpdate) SetNillableCreatedAt(t *time.Time) *UserQuietHoursUpdate {
+	if t != nil {
+		uqhu.SetCreatedAt(*t)
+	}
+	return uqhu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uqhu *UserQuietHoursUpdate) SetUpdatedAt(t time.Time) *UserQuietHoursUpdate {
+	uqhu.mutation.SetUpdatedAt(t)
+	return uqhu
+}
+
+// Mutation returns the UserQuietHoursMutation object of the builder.
+func (uqhu *UserQuietHoursUpdate) Mutation() *UserQuietHoursMutation {
+	return uqhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uqhu *UserQuietHoursUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	uqhu.defaults()
+	if len(uqhu.hooks) == 0 {
+		affected, err = uqhu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserQuietHoursMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uqhu.mutation = mutation
+			affected, err = uqhu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(uqhu.hooks) - 1; i >= 0; i-- {
+			if uqhu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = uqhu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uqhu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uqhu *UserQuietHoursUpdate) SaveX(ctx context.Context) int {
+	affected, err := uqhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uqhu *UserQuietHoursUpdate) Exec(ctx context.Context) error {
+	_, err := uqhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uqhu *UserQuietHoursUpdate) ExecX(ctx context.Context) {
+	if err := uqhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uqhu *UserQuietHoursUpdate) defaults() {
+	if _, ok := uqhu.mutation.UpdatedAt(); !ok {
+		v := userquiethours.UpdateDefaultUpdatedAt()
+		uqhu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uqhu *UserQuietHoursUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userquiethours.Table,
+			Columns: userquiethours.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userquiethours.FieldID,
+			},
+		},
+	}
+	if ps := uqhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uqhu.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldUserID,
+		})
+	}
+	if value, ok := uqhu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldAppID,
+		})
+	}
+	if value, ok := uqhu.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: userquiethours.FieldEnabled,
+		})
+	}
+	if value, ok := uqhu.mutation.Start(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldStart,
+		})
+	}
+	if value, ok := uqhu.mutation.End(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldEnd,
+		})
+	}
+	if value, ok := uqhu.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldTimezone,
+		})
+	}
+	if value, ok := uqhu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldCreatedAt,
+		})
+	}
+	if value, ok := uqhu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uqhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userquiethours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// UserQuietHoursUpdateOne is the builder for updating a single UserQuietHours entity.
+type UserQuietHoursUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserQuietHoursMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetUserID(s string) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetUserID(s)
+	return uqhuo
+}
+
+// SetAppID sets the "app_id" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetAppID(s string) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetAppID(s)
+	return uqhuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetEnabled(b bool) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetEnabled(b)
+	return uqhuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (uqhuo *UserQuietHoursUpdateOne) SetNillableEnabled(b *bool) *UserQuietHoursUpdateOne {
+	if b != nil {
+		uqhuo.SetEnabled(*b)
+	}
+	return uqhuo
+}
+
+// SetStart sets the "start" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetStart(s string) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetStart(s)
+	return uqhuo
+}
+
+// SetEnd sets the "end" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetEnd(s string) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetEnd(s)
+	return uqhuo
+}
+
+// SetTimezone sets the "timezone" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetTimezone(s string) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetTimezone(s)
+	return uqhuo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (uqhuo *UserQuietHoursUpdateOne) SetNillableTimezone(s *string) *UserQuietHoursUpdateOne {
+	if s != nil {
+		uqhuo.SetTimezone(*s)
+	}
+	return uqhuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetCreatedAt(t time.Time) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetCreatedAt(t)
+	return uqhuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uqhuo *UserQuietHoursUpdateOne) SetNillableCreatedAt(t *time.Time) *UserQuietHoursUpdateOne {
+	if t != nil {
+		uqhuo.SetCreatedAt(*t)
+	}
+	return uqhuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uqhuo *UserQuietHoursUpdateOne) SetUpdatedAt(t time.Time) *UserQuietHoursUpdateOne {
+	uqhuo.mutation.SetUpdatedAt(t)
+	return uqhuo
+}
+
+// Mutation returns the UserQuietHoursMutation object of the builder.
+func (uqhuo *UserQuietHoursUpdateOne) Mutation() *UserQuietHoursMutation {
+	return uqhuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uqhuo *UserQuietHoursUpdateOne) Select(field string, fields ...string) *UserQuietHoursUpdateOne {
+	uqhuo.fields = append([]string{field}, fields...)
+	return uqhuo
+}
+
+// Save executes the query and returns the updated UserQuietHours entity.
+func (uqhuo *UserQuietHoursUpdateOne) Save(ctx context.Context) (*UserQuietHours, error) {
+	var (
+		err  error
+		node *UserQuietHours
+	)
+	uqhuo.defaults()
+	if len(uqhuo.hooks) == 0 {
+		node, err = uqhuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*UserQuietHoursMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			uqhuo.mutation = mutation
+			node, err = uqhuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(uqhuo.hooks) - 1; i >= 0; i-- {
+			if uqhuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = uqhuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, uqhuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uqhuo *UserQuietHoursUpdateOne) SaveX(ctx context.Context) *UserQuietHours {
+	node, err := uqhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uqhuo *UserQuietHoursUpdateOne) Exec(ctx context.Context) error {
+	_, err := uqhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uqhuo *UserQuietHoursUpdateOne) ExecX(ctx context.Context) {
+	if err := uqhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uqhuo *UserQuietHoursUpdateOne) defaults() {
+	if _, ok := uqhuo.mutation.UpdatedAt(); !ok {
+		v := userquiethours.UpdateDefaultUpdatedAt()
+		uqhuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (uqhuo *UserQuietHoursUpdateOne) sqlSave(ctx context.Context) (_node *UserQuietHours, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   userquiethours.Table,
+			Columns: userquiethours.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: userquiethours.FieldID,
+			},
+		},
+	}
+	id, ok := uqhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserQuietHours.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uqhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userquiethours.FieldID)
+		for _, f := range fields {
+			if !userquiethours.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userquiethours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uqhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uqhuo.mutation.UserID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldUserID,
+		})
+	}
+	if value, ok := uqhuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldAppID,
+		})
+	}
+	if value, ok := uqhuo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: userquiethours.FieldEnabled,
+		})
+	}
+	if value, ok := uqhuo.mutation.Start(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldStart,
+		})
+	}
+	if value, ok := uqhuo.mutation.End(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldEnd,
+		})
+	}
+	if value, ok := uqhuo.mutation.Timezone(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: userquiethours.FieldTimezone,
+		})
+	}
+	if value, ok := uqhuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldCreatedAt,
+		})
+	}
+	if value, ok := uqhuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: userquiethours.FieldUpdatedAt,
+		})
+	}
+	_node = &UserQuietHours{config: uqhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uqhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userquiethours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,690 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (wdu *WebhookDeliveryUpdate) SetSubscriptionID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetSubscriptionID()
+	wdu.mutation.SetSubscriptionID(i)
+	return wdu
+}
+
+// AddSubscriptionID adds i to the "subscription_id" field.
+func (wdu *WebhookDeliveryUpdate) AddSubscriptionID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddSubscriptionID(i)
+	return wdu
+}
+
+// SetEventID sets the "event_id" field.
+func (wdu *WebhookDeliveryUpdate) SetEventID(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventID(s)
+	return wdu
+}
+
+// SetEventType sets the "event_type" field.
+func (wdu *WebhookDeliveryUpdate) SetEventType(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEventType(s)
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(s)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(s)
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetResponseCode sets the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseCode()
+	wdu.mutation.SetResponseCode(i)
+	return wdu
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseCode(*i)
+	}
+	return wdu
+}
+
+// AddResponseCode adds i to the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseCode(i)
+	return wdu
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseCode()
+	return wdu
+}
+
+// SetError sets the "error" field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdu *WebhookDeliveryUpdate) SetCreatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetCreatedAt(t)
+	return wdu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetCreatedAt(*t)
+	}
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	wdu.defaults()
+	if len(wdu.hooks) == 0 {
+		affected, err = wdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdu.mutation = mutation
+			affected, err = wdu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wdu.hooks) - 1; i >= 0; i-- {
+			if wdu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.SubscriptionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldSubscriptionID,
+		})
+	}
+	if value, ok := wdu.mutation.AddedSubscriptionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldSubscriptionID,
+		})
+	}
+	if value, ok := wdu.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventID,
+		})
+	}
+	if value, ok := wdu.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventType,
+		})
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldStatus,
+		})
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.ResponseCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if value, ok := wdu.mutation.AddedResponseCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if wdu.mutation.ResponseCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wdu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetSubscriptionID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetSubscriptionID()
+	wduo.mutation.SetSubscriptionID(i)
+	return wduo
+}
+
+// AddSubscriptionID adds i to the "subscription_id" field.
+func (wduo *WebhookDeliveryUpdateOne) AddSubscriptionID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddSubscriptionID(i)
+	return wduo
+}
+
+// SetEventID sets the "event_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventID(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventID(s)
+	return wduo
+}
+
+// SetEventType sets the "event_type" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEventType(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEventType(s)
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(s)
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(s)
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetResponseCode sets the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseCode()
+	wduo.mutation.SetResponseCode(i)
+	return wduo
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseCode(*i)
+	}
+	return wduo
+}
+
+// AddResponseCode adds i to the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseCode(i)
+	return wduo
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseCode()
+	return wduo
+}
+
+// SetError sets the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetCreatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetCreatedAt(t)
+	return wduo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetCreatedAt(*t)
+	}
+	return wduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	wduo.defaults()
+	if len(wduo.hooks) == 0 {
+		node, err = wduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wduo.mutation = mutation
+			node, err = wduo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wduo.hooks) - 1; i >= 0; i-- {
+			if wduo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.SubscriptionID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldSubscriptionID,
+		})
+	}
+	if value, ok := wduo.mutation.AddedSubscriptionID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldSubscriptionID,
+		})
+	}
+	if value, ok := wduo.mutation.EventID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventID,
+		})
+	}
+	if value, ok := wduo.mutation.EventType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEventType,
+		})
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldStatus,
+		})
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.ResponseCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if value, ok := wduo.mutation.AddedResponseCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if wduo.mutation.ResponseCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldResponseCode,
+		})
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldError,
+		})
+	}
+	if value, ok := wduo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldUpdatedAt,
+		})
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+)
+
+// WebhookSubscription is the model entity for the WebhookSubscription schema.
+type WebhookSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID string `json:"app_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookSubscription) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhooksubscription.FieldEvents:
+			values[i] = new([]byte)
+		case webhooksubscription.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case webhooksubscription.FieldID:
+			values[i] = new(sql.NullInt64)
+		case webhooksubscription.FieldAppID, webhooksubscription.FieldURL, webhooksubscription.FieldSecret:
+			values[i] = new(sql.NullString)
+		case webhooksubscription.FieldCreatedAt, webhooksubscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WebhookSubscription", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookSubscription fields.
+func (ws *WebhookSubscription) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhooksubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ws.ID = int(value.Int64)
+		case webhooksubscription.FieldAppID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				ws.AppID = value.String
+			}
+		case webhooksubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				ws.URL = value.String
+			}
+		case webhooksubscription.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ws.Secret = value.String
+			}
+		case webhooksubscription.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ws.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case webhooksubscription.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ws.Enabled = value.Bool
+			}
+		case webhooksubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		case webhooksubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ws.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this WebhookSubscription.
+// Note that you need to call WebhookSubscription.Unwrap() before calling this method if this WebhookSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WebhookSubscription) Update() *WebhookSubscriptionUpdateOne {
+	return (&WebhookSubscriptionClient{config: ws.config}).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WebhookSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WebhookSubscription) Unwrap() *WebhookSubscription {
+	tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookSubscription is not a transactional entity")
+	}
+	ws.config.driver = tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WebhookSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v", ws.ID))
+	builder.WriteString(", app_id=")
+	builder.WriteString(ws.AppID)
+	builder.WriteString(", url=")
+	builder.WriteString(ws.URL)
+	builder.WriteString(", secret=<sensitive>")
+	builder.WriteString(", events=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Events))
+	builder.WriteString(", enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ws.Enabled))
+	builder.WriteString(", created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(ws.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookSubscriptions is a parsable slice of WebhookSubscription.
+type WebhookSubscriptions []*WebhookSubscription
+
+func (ws WebhookSubscriptions) config(cfg config) {
+	for _i := range ws {
+		ws[_i].config = cfg
+	}
+}
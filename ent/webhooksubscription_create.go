@@ -0,0 +1,365 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+)
+
+// WebhookSubscriptionCreate is the builder for creating a WebhookSubscription entity.
+type WebhookSubscriptionCreate struct {
+	config
+	mutation *WebhookSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetAppID sets the "app_id" field.
+func (wsc *WebhookSubscriptionCreate) SetAppID(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetAppID(s)
+	return wsc
+}
+
+// SetURL sets the "url" field.
+func (wsc *WebhookSubscriptionCreate) SetURL(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetURL(s)
+	return wsc
+}
+
+// SetSecret sets the "secret" field.
+func (wsc *WebhookSubscriptionCreate) SetSecret(s string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetSecret(s)
+	return wsc
+}
+
+// SetEvents sets the "events" field.
+func (wsc *WebhookSubscriptionCreate) SetEvents(s []string) *WebhookSubscriptionCreate {
+	wsc.mutation.SetEvents(s)
+	return wsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsc *WebhookSubscriptionCreate) SetEnabled(b bool) *WebhookSubscriptionCreate {
+	wsc.mutation.SetEnabled(b)
+	return wsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableEnabled(b *bool) *WebhookSubscriptionCreate {
+	if b != nil {
+		wsc.SetEnabled(*b)
+	}
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WebhookSubscriptionCreate) SetCreatedAt(t time.Time) *WebhookSubscriptionCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *WebhookSubscriptionCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsc *WebhookSubscriptionCreate) SetUpdatedAt(t time.Time) *WebhookSubscriptionCreate {
+	wsc.mutation.SetUpdatedAt(t)
+	return wsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wsc *WebhookSubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *WebhookSubscriptionCreate {
+	if t != nil {
+		wsc.SetUpdatedAt(*t)
+	}
+	return wsc
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsc *WebhookSubscriptionCreate) Mutation() *WebhookSubscriptionMutation {
+	return wsc.mutation
+}
+
+// Save creates the WebhookSubscription in the database.
+func (wsc *WebhookSubscriptionCreate) Save(ctx context.Context) (*WebhookSubscription, error) {
+	var (
+		err  error
+		node *WebhookSubscription
+	)
+	wsc.defaults()
+	if len(wsc.hooks) == 0 {
+		if err = wsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookSubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsc.check(); err != nil {
+				return nil, err
+			}
+			wsc.mutation = mutation
+			if node, err = wsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsc.hooks) - 1; i >= 0; i-- {
+			if wsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WebhookSubscriptionCreate) SaveX(ctx context.Context) *WebhookSubscription {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WebhookSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WebhookSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WebhookSubscriptionCreate) defaults() {
+	if _, ok := wsc.mutation.Enabled(); !ok {
+		v := webhooksubscription.DefaultEnabled
+		wsc.mutation.SetEnabled(v)
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := webhooksubscription.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.DefaultUpdatedAt()
+		wsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WebhookSubscriptionCreate) check() error {
+	if _, ok := wsc.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "WebhookSubscription.app_id"`)}
+	}
+	if _, ok := wsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookSubscription.url"`)}
+	}
+	if _, ok := wsc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WebhookSubscription.secret"`)}
+	}
+	if _, ok := wsc.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`ent: missing required field "WebhookSubscription.events"`)}
+	}
+	if _, ok := wsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WebhookSubscription.enabled"`)}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookSubscription.created_at"`)}
+	}
+	if _, ok := wsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookSubscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (wsc *WebhookSubscriptionCreate) sqlSave(ctx context.Context) (*WebhookSubscription, error) {
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (wsc *WebhookSubscriptionCreate) createSpec() (*WebhookSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookSubscription{config: wsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhooksubscription.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhooksubscription.FieldID,
+			},
+		}
+	)
+	if value, ok := wsc.mutation.AppID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldAppID,
+		})
+		_node.AppID = value
+	}
+	if value, ok := wsc.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldURL,
+		})
+		_node.URL = value
+	}
+	if value, ok := wsc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldSecret,
+		})
+		_node.Secret = value
+	}
+	if value, ok := wsc.mutation.Events(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhooksubscription.FieldEvents,
+		})
+		_node.Events = value
+	}
+	if value, ok := wsc.mutation.Enabled(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhooksubscription.FieldEnabled,
+		})
+		_node.Enabled = value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := wsc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebhookSubscriptionCreateBulk is the builder for creating many WebhookSubscription entities in bulk.
+type WebhookSubscriptionCreateBulk struct {
+	config
+	builders []*WebhookSubscriptionCreate
+}
+
+// Save creates the WebhookSubscription entities in the database.
+func (wscb *WebhookSubscriptionCreateBulk) Save(ctx context.Context) ([]*WebhookSubscription, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WebhookSubscription, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WebhookSubscriptionCreateBulk) SaveX(ctx context.Context) []*WebhookSubscription {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WebhookSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WebhookSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+)
+
+// WebhookSubscriptionQuery is the builder for querying WebhookSubscription entities.
+type WebhookSubscriptionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WebhookSubscription
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WebhookSubscriptionQuery builder.
+func (wsq *WebhookSubscriptionQuery) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit adds a limit step to the query.
+func (wsq *WebhookSubscriptionQuery) Limit(limit int) *WebhookSubscriptionQuery {
+	wsq.limit = &limit
+	return wsq
+}
+
+// Offset adds an offset step to the query.
+func (wsq *WebhookSubscriptionQuery) Offset(offset int) *WebhookSubscriptionQuery {
+	wsq.offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WebhookSubscriptionQuery) Unique(unique bool) *WebhookSubscriptionQuery {
+	wsq.unique = &unique
+	return wsq
+}
+
+// Order adds an order step to the query.
+func (wsq *WebhookSubscriptionQuery) Order(o ...OrderFunc) *WebhookSubscriptionQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// First returns the first WebhookSubscription entity from the query.
+// Returns a *NotFoundError when no WebhookSubscription was found.
+func (wsq *WebhookSubscriptionQuery) First(ctx context.Context) (*WebhookSubscription, error) {
+	nodes, err := wsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{webhooksubscription.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) FirstX(ctx context.Context) *WebhookSubscription {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WebhookSubscription ID from the query.
+// Returns a *NotFoundError when no WebhookSubscription ID was found.
+func (wsq *WebhookSubscriptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhooksubscription.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WebhookSubscription entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WebhookSubscription entity is found.
+// Returns a *NotFoundError when no WebhookSubscription entities are found.
+func (wsq *WebhookSubscriptionQuery) Only(ctx context.Context) (*WebhookSubscription, error) {
+	nodes, err := wsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{webhooksubscription.Label}
+	default:
+		return nil, &NotSingularError{webhooksubscription.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) OnlyX(ctx context.Context) *WebhookSubscription {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WebhookSubscription ID in the query.
+// Returns a *NotSingularError when more than one WebhookSubscription ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WebhookSubscriptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhooksubscription.Label}
+	default:
+		err = &NotSingularError{webhooksubscription.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WebhookSubscriptions.
+func (wsq *WebhookSubscriptionQuery) All(ctx context.Context) ([]*WebhookSubscription, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) AllX(ctx context.Context) []*WebhookSubscription {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WebhookSubscription IDs.
+func (wsq *WebhookSubscriptionQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := wsq.Select(webhooksubscription.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WebhookSubscriptionQuery) Count(ctx context.Context) (int, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WebhookSubscriptionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WebhookSubscriptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WebhookSubscriptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WebhookSubscriptionQuery) Clone() *WebhookSubscriptionQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WebhookSubscriptionQuery{
+		config:     wsq.config,
+		limit:      wsq.limit,
+		offset:     wsq.offset,
+		order:      append([]OrderFunc{}, wsq.order...),
+		predicates: append([]predicate.WebhookSubscription{}, wsq.predicates...),
+		// clone intermediate query.
+		sql:    wsq.sql.Clone(),
+		path:   wsq.path,
+		unique: wsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AppID string `json:"app_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WebhookSubscription.Query().
+//		GroupBy(webhooksubscription.FieldAppID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsq *WebhookSubscriptionQuery) GroupBy(field string, fields ...string) *WebhookSubscriptionGroupBy {
+	grbuild := &WebhookSubscriptionGroupBy{config: wsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = webhooksubscription.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AppID string `json:"app_id,omitempty"`
+//	}
+//
+//	client.WebhookSubscription.Query().
+//		Select(webhooksubscription.FieldAppID).
+//		Scan(ctx, &v)
+func (wsq *WebhookSubscriptionQuery) Select(fields ...string) *WebhookSubscriptionSelect {
+	wsq.fields = append(wsq.fields, fields...)
+	selbuild := &WebhookSubscriptionSelect{WebhookSubscriptionQuery: wsq}
+	selbuild.label = webhooksubscription.Label
+	selbuild.flds, selbuild.scan = &wsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wsq *WebhookSubscriptionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wsq.fields {
+		if !webhooksubscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WebhookSubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WebhookSubscription, error) {
+	var (
+		nodes = []*WebhookSubscription{}
+		_spec = wsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*WebhookSubscription).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &WebhookSubscription{config: wsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (wsq *WebhookSubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	_spec.Node.Columns = wsq.fields
+	if len(wsq.fields) > 0 {
+		_spec.Unique = wsq.unique != nil && *wsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WebhookSubscriptionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wsq *WebhookSubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhooksubscription.Table,
+			Columns: webhooksubscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhooksubscription.FieldID,
+			},
+		},
+		From:   wsq.sql,
+		Unique: true,
+	}
+	if unique := wsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhooksubscription.FieldID)
+		for i := range fields {
+			if fields[i] != webhooksubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WebhookSubscriptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(webhooksubscription.Table)
+	columns := wsq.fields
+	if len(columns) == 0 {
+		columns = webhooksubscription.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.unique != nil && *wsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookSubscriptionGroupBy is the group-by builder for WebhookSubscription entities.
+type WebhookSubscriptionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WebhookSubscriptionGroupBy) Aggregate(fns ...AggregateFunc) *WebhookSubscriptionGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wsgb *WebhookSubscriptionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wsgb.sql = query
+	return wsgb.sqlScan(ctx, v)
+}
+
+func (wsgb *WebhookSubscriptionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wsgb.fields {
+		if !webhooksubscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wsgb *WebhookSubscriptionGroupBy) sqlQuery() *sql.Selector {
+	selector := wsgb.sql.Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wsgb.fields)+len(wsgb.fns))
+		for _, f := range wsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wsgb.fields...)...)
+}
+
+// WebhookSubscriptionSelect is the builder for selecting fields of WebhookSubscription entities.
+type WebhookSubscriptionSelect struct {
+	*WebhookSubscriptionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WebhookSubscriptionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wss.sql = wss.WebhookSubscriptionQuery.sqlQuery(ctx)
+	return wss.sqlScan(ctx, v)
+}
+
+func (wss *WebhookSubscriptionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wss.sql.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,472 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+)
+
+// WebhookSubscriptionUpdate is the builder for updating WebhookSubscription entities.
+type WebhookSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookSubscriptionMutation
+}
+
+// Where appends a list predicates to the WebhookSubscriptionUpdate builder.
+func (wsu *WebhookSubscriptionUpdate) Where(ps ...predicate.WebhookSubscription) *WebhookSubscriptionUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetAppID sets the "app_id" field.
+func (wsu *WebhookSubscriptionUpdate) SetAppID(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetAppID(s)
+	return wsu
+}
+
+// SetURL sets the "url" field.
+func (wsu *WebhookSubscriptionUpdate) SetURL(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetURL(s)
+	return wsu
+}
+
+// SetSecret sets the "secret" field.
+func (wsu *WebhookSubscriptionUpdate) SetSecret(s string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetSecret(s)
+	return wsu
+}
+
+// SetEvents sets the "events" field.
+func (wsu *WebhookSubscriptionUpdate) SetEvents(s []string) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetEvents(s)
+	return wsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsu *WebhookSubscriptionUpdate) SetEnabled(b bool) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetEnabled(b)
+	return wsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableEnabled(b *bool) *WebhookSubscriptionUpdate {
+	if b != nil {
+		wsu.SetEnabled(*b)
+	}
+	return wsu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsu *WebhookSubscriptionUpdate) SetCreatedAt(t time.Time) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetCreatedAt(t)
+	return wsu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsu *WebhookSubscriptionUpdate) SetNillableCreatedAt(t *time.Time) *WebhookSubscriptionUpdate {
+	if t != nil {
+		wsu.SetCreatedAt(*t)
+	}
+	return wsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsu *WebhookSubscriptionUpdate) SetUpdatedAt(t time.Time) *WebhookSubscriptionUpdate {
+	wsu.mutation.SetUpdatedAt(t)
+	return wsu
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsu *WebhookSubscriptionUpdate) Mutation() *WebhookSubscriptionMutation {
+	return wsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WebhookSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	wsu.defaults()
+	if len(wsu.hooks) == 0 {
+		affected, err = wsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookSubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsu.mutation = mutation
+			affected, err = wsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsu.hooks) - 1; i >= 0; i-- {
+			if wsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WebhookSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WebhookSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsu *WebhookSubscriptionUpdate) defaults() {
+	if _, ok := wsu.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdatedAt()
+		wsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wsu *WebhookSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhooksubscription.Table,
+			Columns: webhooksubscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhooksubscription.FieldID,
+			},
+		},
+	}
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsu.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldAppID,
+		})
+	}
+	if value, ok := wsu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldURL,
+		})
+	}
+	if value, ok := wsu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldSecret,
+		})
+	}
+	if value, ok := wsu.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhooksubscription.FieldEvents,
+		})
+	}
+	if value, ok := wsu.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhooksubscription.FieldEnabled,
+		})
+	}
+	if value, ok := wsu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldCreatedAt,
+		})
+	}
+	if value, ok := wsu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookSubscriptionUpdateOne is the builder for updating a single WebhookSubscription entity.
+type WebhookSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookSubscriptionMutation
+}
+
+// SetAppID sets the "app_id" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetAppID(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetAppID(s)
+	return wsuo
+}
+
+// SetURL sets the "url" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetURL(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetURL(s)
+	return wsuo
+}
+
+// SetSecret sets the "secret" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetSecret(s string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetSecret(s)
+	return wsuo
+}
+
+// SetEvents sets the "events" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetEvents(s []string) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetEvents(s)
+	return wsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetEnabled(b bool) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetEnabled(b)
+	return wsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableEnabled(b *bool) *WebhookSubscriptionUpdateOne {
+	if b != nil {
+		wsuo.SetEnabled(*b)
+	}
+	return wsuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetCreatedAt(t time.Time) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetCreatedAt(t)
+	return wsuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsuo *WebhookSubscriptionUpdateOne) SetNillableCreatedAt(t *time.Time) *WebhookSubscriptionUpdateOne {
+	if t != nil {
+		wsuo.SetCreatedAt(*t)
+	}
+	return wsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wsuo *WebhookSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *WebhookSubscriptionUpdateOne {
+	wsuo.mutation.SetUpdatedAt(t)
+	return wsuo
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (wsuo *WebhookSubscriptionUpdateOne) Mutation() *WebhookSubscriptionMutation {
+	return wsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WebhookSubscriptionUpdateOne) Select(field string, fields ...string) *WebhookSubscriptionUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WebhookSubscription entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Save(ctx context.Context) (*WebhookSubscription, error) {
+	var (
+		err  error
+		node *WebhookSubscription
+	)
+	wsuo.defaults()
+	if len(wsuo.hooks) == 0 {
+		node, err = wsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookSubscriptionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsuo.mutation = mutation
+			node, err = wsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsuo.hooks) - 1; i >= 0; i-- {
+			if wsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) SaveX(ctx context.Context) *WebhookSubscription {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WebhookSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WebhookSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsuo *WebhookSubscriptionUpdateOne) defaults() {
+	if _, ok := wsuo.mutation.UpdatedAt(); !ok {
+		v := webhooksubscription.UpdateDefaultUpdatedAt()
+		wsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (wsuo *WebhookSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *WebhookSubscription, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhooksubscription.Table,
+			Columns: webhooksubscription.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhooksubscription.FieldID,
+			},
+		},
+	}
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhooksubscription.FieldID)
+		for _, f := range fields {
+			if !webhooksubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhooksubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wsuo.mutation.AppID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldAppID,
+		})
+	}
+	if value, ok := wsuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldURL,
+		})
+	}
+	if value, ok := wsuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhooksubscription.FieldSecret,
+		})
+	}
+	if value, ok := wsuo.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhooksubscription.FieldEvents,
+		})
+	}
+	if value, ok := wsuo.mutation.Enabled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhooksubscription.FieldEnabled,
+		})
+	}
+	if value, ok := wsuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldCreatedAt,
+		})
+	}
+	if value, ok := wsuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhooksubscription.FieldUpdatedAt,
+		})
+	}
+	_node = &WebhookSubscription{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhooksubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
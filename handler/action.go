@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"errors"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/webhook"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type ActionResp struct {
+	// 推送动作的唯一 id
+	ActionId string `json:"action_id"`
+	// 推送动作的状态 running/paused/cancelled
+	State action.State `json:"state"`
+	// 推送动作的消息统计, 包括 enqueued/succeeded/failed/cancelled 等
+	Stats map[string]int64 `json:"stats,omitempty"`
+	// A/B 测试时每个变体的消息统计, key 为变体名称
+	Variants map[string]map[string]int64 `json:"variants,omitempty"`
+}
+
+// GetAction godoc
+// @Summary 获取推送动作的状态
+// @Description 获取推送动作的状态以及消息统计
+// @ID get-action
+// @Tags action
+// @Produce  json
+// @Param action_id path string true "推送动作的唯一 id"
+// @Success 200 {object} api.ResponseEntry{data=handler.ActionResp} "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/actions/{action_id} [get]
+func GetAction(c *api.Context) api.ResponseOptions {
+	actionId := c.Param("action_id")
+	c.WithLogFields(zap.String("action_id", actionId))
+	if resp := authorizeAction(c, actionId); resp != nil {
+		return resp
+	}
+
+	state, err := action.GetState(c, c.RedisClient, actionId)
+	if err != nil {
+		c.Logger.Error("GetAction: failed to get action state", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get action state")
+	}
+	stats, err := action.GetStats(c, c.RedisClient, actionId)
+	if err != nil {
+		c.Logger.Error("GetAction: failed to get action stats", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get action stats")
+	}
+
+	stats, variants := action.SplitVariantStats(stats)
+
+	return api.Ok(ActionResp{
+		ActionId: actionId,
+		State:    state,
+		Stats:    stats,
+		Variants: variants,
+	})
+}
+
+// CancelAction godoc
+// @Summary 取消推送动作
+// @Description 取消推送动作, 队列中还未发送的消息将被丢弃并计入 cancelled; 已取消的推送动作无法恢复
+// @ID cancel-action
+// @Tags action
+// @Produce  json
+// @Param action_id path string true "推送动作的唯一 id"
+// @Success 200 {object} api.ResponseEntry{data=handler.ActionResp} "ok"
+// @Failure 409 {object} api.ResponseEntry "推送动作当前的状态不允许此操作"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/actions/{action_id}/cancel [post]
+func CancelAction(c *api.Context) api.ResponseOptions {
+	c.Audit(audit.OperationActionCancel)
+	return transitionAction(c, action.StateCancelled)
+}
+
+// PauseAction godoc
+// @Summary 暂停推送动作
+// @Description 暂停推送动作, 队列中还未发送的消息将被保留, 直到推送动作恢复后再发送
+// @ID pause-action
+// @Tags action
+// @Produce  json
+// @Param action_id path string true "推送动作的唯一 id"
+// @Success 200 {object} api.ResponseEntry{data=handler.ActionResp} "ok"
+// @Failure 409 {object} api.ResponseEntry "推送动作当前的状态不允许此操作"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/actions/{action_id}/pause [post]
+func PauseAction(c *api.Context) api.ResponseOptions {
+	c.Audit(audit.OperationActionPause)
+	return transitionAction(c, action.StatePaused)
+}
+
+// ResumeAction godoc
+// @Summary 恢复推送动作
+// @Description 恢复已暂停的推送动作
+// @ID resume-action
+// @Tags action
+// @Produce  json
+// @Param action_id path string true "推送动作的唯一 id"
+// @Success 200 {object} api.ResponseEntry{data=handler.ActionResp} "ok"
+// @Failure 409 {object} api.ResponseEntry "推送动作当前的状态不允许此操作"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/actions/{action_id}/resume [post]
+func ResumeAction(c *api.Context) api.ResponseOptions {
+	c.Audit(audit.OperationActionResume)
+	return transitionAction(c, action.StateRunning)
+}
+
+func transitionAction(c *api.Context, target action.State) api.ResponseOptions {
+	actionId := c.Param("action_id")
+	c.WithLogFields(zap.String("action_id", actionId))
+	if entry := c.AuditEntry(); entry != nil {
+		entry.ActionId = actionId
+	}
+	if resp := authorizeAction(c, actionId); resp != nil {
+		return resp
+	}
+
+	state, err := action.Transition(c, c.RedisClient, actionId, target)
+	switch {
+	case errors.Is(err, action.InvalidStateTransition):
+		c.Logger.Warn("transitionAction: invalid action state transition",
+			zap.String("state", string(state)),
+			zap.String("target", string(target)),
+		)
+		return api.New(http.StatusConflict, http.StatusConflict, "action state does not allow this operation", ActionResp{
+			ActionId: actionId,
+			State:    state,
+		})
+	case err != nil:
+		c.Logger.Error("transitionAction: failed to change action state",
+			zap.String("target", string(target)),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to change action state")
+	}
+
+	c.Logger.Info("transitionAction: change action state successfully",
+		zap.String("state", string(state)),
+	)
+	return api.Ok(ActionResp{
+		ActionId: actionId,
+		State:    state,
+	})
+}
+
+// authorizeAction checks whether the caller can access all the apps which the messages of action are sent to
+func authorizeAction(c *api.Context, actionId string) api.ResponseOptions {
+	if getScopedAppIds(c) == nil {
+		return nil
+	}
+	appIds, err := action.GetApps(c, c.RedisClient, actionId)
+	if err != nil {
+		c.Logger.Error("authorizeAction: failed to get apps of action", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get apps of action")
+	}
+	return c.AuthorizeApps(appIds...)
+}
+
+// recordActionApps records the apps which the messages of action are sent to, the action.completed webhook is published to them.
+// The apps are recorded before their messages are enqueued, so that the scoped callers can control the in-flight action.
+func recordActionApps(c *api.Context, actionId string, appIds ...string) error {
+	err := action.AddApps(c, c.RedisClient, actionId, appIds...)
+	if err != nil {
+		c.Logger.Error("recordActionApps: failed to record apps of action",
+			zap.Strings("app_ids", appIds),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+// recordVariantEnqueuedCounts records the enqueued count of each variant, the count of action is recorded by recordEnqueuedCount
+func recordVariantEnqueuedCounts(c *api.Context, actionId string, counts map[string]int64) {
+	for variant, n := range counts {
+		if n <= 0 {
+			continue
+		}
+		err := action.IncrStat(c, c.RedisClient, actionId, action.VariantStat(variant, action.StatEnqueued), n)
+		if err != nil {
+			c.Logger.Error("recordVariantEnqueuedCounts: failed to record enqueued count of variant",
+				zap.String("variant", variant),
+				zap.Int64("count", n),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func recordEnqueuedCount(c *api.Context, actionId string, n int64) {
+	if entry := c.AuditEntry(); entry != nil {
+		entry.AudienceSize += n
+	}
+	if n <= 0 {
+		return
+	}
+	err := action.IncrStat(c, c.RedisClient, actionId, action.StatEnqueued, n)
+	if err != nil {
+		c.Logger.Error("recordEnqueuedCount: failed to record enqueued count of action",
+			zap.Int64("count", n),
+			zap.Error(err),
+		)
+		return
+	}
+	// the messages may have been processed before the enqueued count is recorded
+	webhook.NotifyActionCompleted(c, actionId)
+}
+
+type GetActionResultsResp struct {
+	// 满足条件的推送结果总数
+	Total int `json:"total"`
+	// 推送结果列表
+	Results []*ent.DeliveryResult `json:"results"`
+}
+
+// GetActionResults godoc
+// @Summary 获取推送动作的推送结果
+// @Description 获取推送动作中每个设备 token 的推送结果, 包括推送成功, 失败以及由于频率限制, 取消或过期等原因未发送的消息
+// @ID get-action-results
+// @Tags action
+// @Produce  json
+// @Param action_id path string true "推送动作的唯一 id"
+// @Param status query string false "推送结果状态 succeeded/failed/suppressed"
+// @Param reason query string false "未发送或失败的原因, 例如 capped/cancelled/expired"
+// @Param variant query string false "A/B 测试的变体名称"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "返回的数量, 默认 100, 最大 1000"
+// @Success 200 {object} api.ResponseEntry{data=handler.GetActionResultsResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/actions/{action_id}/results [get]
+func GetActionResults(c *api.Context) api.ResponseOptions {
+	actionId := c.Param("action_id")
+	c.WithLogFields(zap.String("action_id", actionId))
+	if resp := authorizeAction(c, actionId); resp != nil {
+		return resp
+	}
+	query := c.Req.URL.Query()
+
+	offset, limit, err := getPagination(c)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	q := c.Db.DeliveryResult.Query().Where(deliveryresult.ActionID(actionId))
+	if status := query.Get("status"); len(status) > 0 {
+		q.Where(deliveryresult.Status(status))
+	}
+	if reason := query.Get("reason"); len(reason) > 0 {
+		q.Where(deliveryresult.Reason(reason))
+	}
+	if variant := query.Get("variant"); len(variant) > 0 {
+		q.Where(deliveryresult.Variant(variant))
+	}
+
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		c.Logger.Error("GetActionResults: failed to count delivery results", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get delivery results")
+	}
+	results, err := q.
+		Order(ent.Asc(deliveryresult.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(c)
+	if err != nil {
+		c.Logger.Error("GetActionResults: failed to query delivery results", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get delivery results")
+	}
+
+	return api.Ok(GetActionResultsResp{
+		Total:   total,
+		Results: results,
+	})
+}
@@ -0,0 +1,322 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/auth"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/apikey"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// the previous signing secret is accepted in this period after rotating by default
+const defaultSigningSecretGracePeriod = 24 * time.Hour
+
+type CreateApiKeyReq struct {
+	// API key 名称, 用于区分调用方
+	Name string `json:"name"`
+	// (optional) API key 可以访问的 app id, 为空时可以访问所有 app
+	AppIds []string `json:"app_ids,omitempty"`
+	// API key 的权限 send/broadcast/tokens/metrics/admin
+	Permissions []string `json:"permissions"`
+	// (optional) 过期时间, unix 时间戳, 单位 s; 为空时永不过期
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// (optional) 是否使用请求签名认证, 设置时只返回签名密钥, 不返回 API key
+	Signature bool `json:"signature,omitempty"`
+}
+
+func (r *CreateApiKeyReq) validate() error {
+	switch {
+	case len(r.Name) <= 0:
+		return fmt.Errorf("name is required")
+	case len(r.Permissions) <= 0:
+		return fmt.Errorf("permissions is required")
+	case r.ExpiresAt > 0 && r.ExpiresAt <= time.Now().Unix():
+		return fmt.Errorf("expires_at must be in the future")
+	}
+	for _, permission := range r.Permissions {
+		if !auth.IsValidPermission(permission) {
+			return fmt.Errorf("unknown permission %q", permission)
+		}
+	}
+	return nil
+}
+
+type CreateApiKeyResp struct {
+	*ent.ApiKey
+	// API key, 只在创建时返回
+	Key string `json:"key,omitempty"`
+	// 请求签名的密钥, 只在创建时返回
+	SigningSecret string `json:"signing_secret,omitempty"`
+}
+
+type RotateSigningSecretReq struct {
+	// (optional, default: 86400) 旧的签名密钥在轮换后继续有效的时间, 单位 s; 为 0 时使用默认值, 为负数时旧密钥立即失效
+	GracePeriod int64 `json:"grace_period,omitempty"`
+}
+
+type RotateSigningSecretResp struct {
+	// API key id
+	KeyId int `json:"key_id"`
+	// 新的签名密钥
+	SigningSecret string `json:"signing_secret"`
+	// 旧的签名密钥失效的时间, unix 时间戳, 单位 s; 没有旧密钥时为空
+	PreviousExpiresAt int64 `json:"previous_expires_at,omitempty"`
+}
+
+// CreateApiKey godoc
+// @Summary 创建 API key
+// @Description 创建 API key, 请求需要在 X-Api-Key 请求头或 Authorization: Bearer 中携带 API key, 或者使用签名密钥对请求签名; 限制了 app 的 API key 只能创建访问这些 app 的 API key
+// @ID create-api-key
+// @Tags api-keys
+// @Accept  json
+// @Produce  json
+// @Param message body CreateApiKeyReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=handler.CreateApiKeyResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/api_keys [post]
+func CreateApiKey(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationApiKeyCreate)
+	var req = new(CreateApiKeyReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("CreateApiKey: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("CreateApiKey: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	auditEntry.AppIds = req.AppIds
+	if resp := c.AuthorizeApps(req.AppIds...); resp != nil {
+		return resp
+	}
+
+	key, prefix, hash, err := auth.GenerateKey()
+	if err != nil {
+		c.Logger.Error("CreateApiKey: failed to generate api key", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to generate api key")
+	}
+	create := c.Db.ApiKey.Create().
+		SetName(req.Name).
+		SetPrefix(prefix).
+		SetKeyHash(hash).
+		SetAppIds(req.AppIds).
+		SetPermissions(req.Permissions)
+	var signingSecret string
+	if req.Signature {
+		signingSecret, err = auth.GenerateSigningSecret()
+		if err != nil {
+			c.Logger.Error("CreateApiKey: failed to generate signing secret", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to generate signing secret")
+		}
+		storedSecret, err := auth.EncryptSigningSecret(c.Config.Get().Auth, signingSecret)
+		if err != nil {
+			c.Logger.Error("CreateApiKey: failed to encrypt signing secret", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to encrypt signing secret")
+		}
+		create.SetSigningSecret(storedSecret)
+		// the key of signature callers is never returned, it can not be used for authentication
+		key = ""
+	}
+	if req.ExpiresAt > 0 {
+		create.SetExpiresAt(time.Unix(req.ExpiresAt, 0))
+	}
+	record, err := create.Save(c)
+	if err != nil {
+		c.Logger.Error("CreateApiKey: failed to create api key", zap.String("name", req.Name), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to create api key")
+	}
+	auditEntry.Target = fmt.Sprintf("api_key:%d", record.ID)
+	c.Logger.Info("CreateApiKey: api key is created",
+		zap.Int("key_id", record.ID),
+		zap.String("name", record.Name),
+		zap.Strings("app_ids", record.AppIds),
+		zap.Strings("permissions", record.Permissions),
+	)
+
+	return api.Ok(CreateApiKeyResp{
+		ApiKey:        record,
+		Key:           key,
+		SigningSecret: signingSecret,
+	})
+}
+
+// ListApiKeys godoc
+// @Summary 获取 API key 列表
+// @Description 获取 API key 列表, 不包含 key 本身; 限制了 app 的 API key 只能获取访问这些 app 的 API key
+// @ID list-api-keys
+// @Tags api-keys
+// @Produce  json
+// @Success 200 {object} api.ResponseEntry{data=[]ent.ApiKey} "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/api_keys [get]
+func ListApiKeys(c *api.Context) api.ResponseOptions {
+	records, err := c.Db.ApiKey.Query().Order(ent.Asc(apikey.FieldID)).All(c)
+	if err != nil {
+		c.Logger.Error("ListApiKeys: failed to query api keys", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query api keys")
+	}
+
+	keys := make([]*ent.ApiKey, 0, len(records))
+	for _, record := range records {
+		if c.AuthorizeApps(record.AppIds...) == nil {
+			keys = append(keys, record)
+		}
+	}
+	return api.Ok(keys)
+}
+
+// RevokeApiKey godoc
+// @Summary 吊销 API key
+// @Description 吊销 API key, 吊销后使用该 key 的请求将被拒绝
+// @ID revoke-api-key
+// @Tags api-keys
+// @Produce  json
+// @Param key_id path int true "API key id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 404 {object} api.ResponseEntry "API key 不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/api_keys/{key_id} [delete]
+func RevokeApiKey(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationApiKeyRevoke)
+	auditEntry.Target = "api_key:" + c.Param("key_id")
+	keyId, err := strconv.Atoi(c.Param("key_id"))
+	if err != nil {
+		return api.Error(http.StatusBadRequest, "invalid key_id")
+	}
+
+	record, err := c.Db.ApiKey.Get(c, keyId)
+	switch {
+	case ent.IsNotFound(err):
+		return api.Error(http.StatusNotFound, "api key not found")
+	case err != nil:
+		c.Logger.Error("RevokeApiKey: failed to get api key", zap.Int("key_id", keyId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get api key")
+	}
+	auditEntry.AppIds = record.AppIds
+	if resp := c.AuthorizeApps(record.AppIds...); resp != nil {
+		return resp
+	}
+	if record.RevokedAt != nil {
+		return api.Ok(nil)
+	}
+
+	err = c.Db.ApiKey.UpdateOne(record).SetRevokedAt(time.Now()).Exec(c)
+	if err != nil {
+		c.Logger.Error("RevokeApiKey: failed to revoke api key", zap.Int("key_id", keyId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to revoke api key")
+	}
+	c.Logger.Info("RevokeApiKey: api key is revoked", zap.Int("key_id", keyId), zap.String("name", record.Name))
+
+	return api.Ok(nil)
+}
+
+// RotateSigningSecret godoc
+// @Summary 轮换请求签名的密钥
+// @Description 生成新的签名密钥, 旧的密钥在 grace_period 内仍然有效以便调用方切换; API key 没有签名密钥时为其启用请求签名认证。签名的请求需要携带 X-Push-Key-Id, X-Push-Timestamp, X-Push-Nonce 以及 X-Push-Signature 请求头, 签名内容为 <method>\n<path?query>\n<timestamp>\n<nonce>\n<hex(sha256(body))>
+// @ID rotate-signing-secret
+// @Tags api-keys
+// @Accept  json
+// @Produce  json
+// @Param key_id path int true "API key id"
+// @Param message body RotateSigningSecretReq false "请求体"
+// @Success 200 {object} api.ResponseEntry{data=handler.RotateSigningSecretResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 404 {object} api.ResponseEntry "API key 不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/api_keys/{key_id}/rotate_signing_secret [post]
+func RotateSigningSecret(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationApiKeyRotateSigningSecret)
+	auditEntry.Target = "api_key:" + c.Param("key_id")
+	keyId, err := strconv.Atoi(c.Param("key_id"))
+	if err != nil {
+		return api.Error(http.StatusBadRequest, "invalid key_id")
+	}
+	var req = new(RotateSigningSecretReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("RotateSigningSecret: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, req); err != nil {
+			c.Logger.Warn("RotateSigningSecret: deserialize request body failed", zap.Error(err))
+			return api.Error(http.StatusBadRequest, "deserialize request body failed")
+		}
+	}
+	gracePeriod := defaultSigningSecretGracePeriod
+	if req.GracePeriod != 0 {
+		gracePeriod = time.Duration(req.GracePeriod) * time.Second
+	}
+
+	record, err := c.Db.ApiKey.Get(c, keyId)
+	switch {
+	case ent.IsNotFound(err):
+		return api.Error(http.StatusNotFound, "api key not found")
+	case err != nil:
+		c.Logger.Error("RotateSigningSecret: failed to get api key", zap.Int("key_id", keyId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get api key")
+	}
+	auditEntry.AppIds = record.AppIds
+	if resp := c.AuthorizeApps(record.AppIds...); resp != nil {
+		return resp
+	}
+	if record.RevokedAt != nil {
+		return api.Error(http.StatusBadRequest, "api key is revoked")
+	}
+
+	secret, err := auth.GenerateSigningSecret()
+	if err != nil {
+		c.Logger.Error("RotateSigningSecret: failed to generate signing secret", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to generate signing secret")
+	}
+	resp := RotateSigningSecretResp{
+		KeyId:         keyId,
+		SigningSecret: secret,
+	}
+	storedSecret, err := auth.EncryptSigningSecret(c.Config.Get().Auth, secret)
+	if err != nil {
+		c.Logger.Error("RotateSigningSecret: failed to encrypt signing secret", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to encrypt signing secret")
+	}
+	// the previous secret is kept in the stored form, it is decrypted when the request is authenticated
+	update := c.Db.ApiKey.UpdateOne(record).SetSigningSecret(storedSecret)
+	if len(record.SigningSecret) > 0 && gracePeriod > 0 {
+		expiresAt := time.Now().Add(gracePeriod)
+		update.SetPreviousSigningSecret(record.SigningSecret).SetPreviousSigningSecretExpiresAt(expiresAt)
+		resp.PreviousExpiresAt = expiresAt.Unix()
+	} else {
+		update.ClearPreviousSigningSecret().ClearPreviousSigningSecretExpiresAt()
+	}
+	err = update.Exec(c)
+	if err != nil {
+		c.Logger.Error("RotateSigningSecret: failed to update signing secret", zap.Int("key_id", keyId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to update signing secret")
+	}
+	c.Logger.Info("RotateSigningSecret: signing secret is rotated", zap.Int("key_id", keyId), zap.Duration("grace_period", gracePeriod))
+
+	return api.Ok(resp)
+}
+
+// getScopedAppIds returns the apps which the caller is scoped to, nil means the caller can access all the apps
+func getScopedAppIds(c *api.Context) []string {
+	if c.Caller == nil || !c.Caller.IsScoped() {
+		return nil
+	}
+	return c.Caller.AppIds
+}
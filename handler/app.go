@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/app"
+	"github.com/shitamachi/push-service/push"
+	"github.com/shitamachi/push-service/registry"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type CreateAppReq struct {
+	// app id, ios app 的 bundle id 或 android app 的包名
+	AppId string `json:"app_id"`
+	// (optional) app 名称
+	Name string `json:"name,omitempty"`
+	// 推送类型 apple/firebase
+	PushType string `json:"push_type"`
+	// push_type 为 apple 时必填, APNs 推送凭证
+	Apple *config_entries.ApplePushSecretConfigItem `json:"apple,omitempty"`
+	// push_type 为 firebase 时必填, Firebase 推送凭证
+	Firebase *config_entries.FirebaseConfigItem `json:"firebase,omitempty"`
+}
+
+type UpdateAppReq struct {
+	// (optional) app 名称
+	Name *string `json:"name,omitempty"`
+	// (optional) 是否启用, 停用后不再向该 app 推送消息
+	Enabled *bool `json:"enabled,omitempty"`
+	// (optional) 新的 APNs 推送凭证, 只能用于 push_type 为 apple 的 app
+	Apple *config_entries.ApplePushSecretConfigItem `json:"apple,omitempty"`
+	// (optional) 新的 Firebase 推送凭证, 只能用于 push_type 为 firebase 的 app
+	Firebase *config_entries.FirebaseConfigItem `json:"firebase,omitempty"`
+}
+
+// getAppCredentials checks the credentials of push type by creating the push client with them,
+// the error response is returned if they are invalid
+func getAppCredentials(c *api.Context, appId string, pushType config_entries.PushType, apple *config_entries.ApplePushSecretConfigItem, firebase *config_entries.FirebaseConfigItem) (*registry.Credentials, api.ResponseOptions) {
+	switch pushType {
+	case config_entries.ApplePush:
+		switch {
+		case firebase != nil:
+			return nil, api.Error(http.StatusBadRequest, "firebase credentials can not be used by apple app")
+		case apple == nil || len(apple.AuthKey) <= 0 || len(apple.KeyID) <= 0 || len(apple.TeamID) <= 0:
+			return nil, api.Error(http.StatusBadRequest, "apple.auth_key, apple.key_id and apple.team_id are required")
+		}
+		item := *apple
+		item.BundleID = appId
+		if _, err := push.NewAppleClient(c, c.Config.Get().Mode, appId, item); err != nil {
+			return nil, api.Error(http.StatusBadRequest, fmt.Sprintf("invalid apple credentials: %v", err))
+		}
+		return &registry.Credentials{Apple: &item}, nil
+	case config_entries.FirebasePush:
+		switch {
+		case apple != nil:
+			return nil, api.Error(http.StatusBadRequest, "apple credentials can not be used by firebase app")
+		case firebase == nil || len(firebase.ServiceAccountFileContent) <= 0:
+			return nil, api.Error(http.StatusBadRequest, "firebase.service_account_file_content is required")
+		}
+		item := *firebase
+		item.PackageName = appId
+		if _, err := push.NewFirebaseClient(c, appId, item); err != nil {
+			return nil, api.Error(http.StatusBadRequest, fmt.Sprintf("invalid firebase credentials: %v", err))
+		}
+		return &registry.Credentials{Firebase: &item}, nil
+	default:
+		return nil, api.Error(http.StatusBadRequest, fmt.Sprintf("unknown push_type %q", pushType))
+	}
+}
+
+// encryptAppCredentials encrypts the credentials to store them, the error response is returned if failed
+func encryptAppCredentials(c *api.Context, appId string, credentials *registry.Credentials) ([]byte, api.ResponseOptions) {
+	encrypted, err := registry.EncryptCredentials(c.Config.Get(), credentials)
+	if err != nil {
+		c.Logger.Error("encryptAppCredentials: failed to encrypt app credentials", zap.String("app_id", appId), zap.Error(err))
+		return nil, api.Error(http.StatusInternalServerError, "failed to encrypt app credentials")
+	}
+	return encrypted, nil
+}
+
+// CreateApp godoc
+// @Summary 注册 app
+// @Description 在 app 注册表中添加 app 及其推送凭证, 无需修改配置文件及重新部署; 推送凭证加密存储, 不会通过接口返回. 配置文件中已存在的 app 不能添加
+// @ID create-app
+// @Tags apps
+// @Accept  json
+// @Produce  json
+// @Param message body CreateAppReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.App} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 409 {object} api.ResponseEntry "app 已存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Failure 503 {object} api.ResponseEntry "未配置 app 注册表的加密密钥"
+// @Router /v1/apps [post]
+func CreateApp(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationAppCreate)
+	var req = new(CreateAppReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("CreateApp: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("CreateApp: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if len(req.AppId) <= 0 {
+		return api.Error(http.StatusBadRequest, "app_id is required")
+	}
+	auditEntry.AppIds = []string{req.AppId}
+	auditEntry.Target = "app:" + req.AppId
+	if resp := c.AuthorizeApps(req.AppId); resp != nil {
+		return resp
+	}
+	conf := c.Config.Get()
+	if !conf.AppRegistry.IsEnabled() {
+		return api.Error(http.StatusServiceUnavailable, registry.ErrDisabled.Error())
+	}
+	if registry.IsStatic(conf, req.AppId) {
+		return api.Error(http.StatusConflict, "app is configured in config file")
+	}
+
+	pushType := config_entries.PushType(req.PushType)
+	credentials, errResp := getAppCredentials(c, req.AppId, pushType, req.Apple, req.Firebase)
+	if errResp != nil {
+		return errResp
+	}
+	encrypted, errResp := encryptAppCredentials(c, req.AppId, credentials)
+	if errResp != nil {
+		return errResp
+	}
+
+	record, err := c.Db.App.Create().
+		SetAppID(req.AppId).
+		SetName(req.Name).
+		SetPushType(app.PushType(pushType)).
+		SetCredentials(encrypted).
+		Save(c)
+	if ent.IsConstraintError(err) {
+		return api.Error(http.StatusConflict, "app already exists")
+	}
+	if err != nil {
+		c.Logger.Error("CreateApp: failed to create app", zap.String("app_id", req.AppId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to create app")
+	}
+	registry.Invalidate(req.AppId)
+
+	return api.Ok(record)
+}
+
+// ListApps godoc
+// @Summary 获取 app 注册表中的 app 列表
+// @Description 获取 app 注册表中的 app 列表, 不包括配置文件中的 app
+// @ID list-apps
+// @Tags apps
+// @Produce  json
+// @Success 200 {object} api.ResponseEntry{data=[]ent.App} "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/apps [get]
+func ListApps(c *api.Context) api.ResponseOptions {
+	q := c.Db.App.Query()
+	if appIds := getScopedAppIds(c); appIds != nil {
+		q.Where(app.AppIDIn(appIds...))
+	}
+	records, err := q.Order(ent.Asc(app.FieldID)).All(c)
+	if err != nil {
+		c.Logger.Error("ListApps: failed to query apps", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query apps")
+	}
+
+	return api.Ok(records)
+}
+
+// GetApp godoc
+// @Summary 获取 app 注册表中的 app
+// @Description 获取 app 注册表中的 app, 推送凭证不会返回
+// @ID get-app
+// @Tags apps
+// @Produce  json
+// @Param app_id path string true "app id"
+// @Success 200 {object} api.ResponseEntry{data=ent.App} "ok"
+// @Failure 404 {object} api.ResponseEntry "app 不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/apps/{app_id} [get]
+func GetApp(c *api.Context) api.ResponseOptions {
+	record, errResp := getApp(c)
+	if errResp != nil {
+		return errResp
+	}
+	return api.Ok(record)
+}
+
+// UpdateApp godoc
+// @Summary 更新 app 注册表中的 app
+// @Description 更新 app 的名称, 启用状态或推送凭证; 当前实例立即生效, 其他实例在缓存过期后生效, 使用旧凭证的推送请求不受影响
+// @ID update-app
+// @Tags apps
+// @Accept  json
+// @Produce  json
+// @Param app_id path string true "app id"
+// @Param message body UpdateAppReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.App} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "app 不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Failure 503 {object} api.ResponseEntry "未配置 app 注册表的加密密钥"
+// @Router /v1/apps/{app_id} [put]
+func UpdateApp(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationAppUpdate)
+	auditEntry.Target = "app:" + c.Param("app_id")
+	auditEntry.AppIds = []string{c.Param("app_id")}
+	record, errResp := getApp(c)
+	if errResp != nil {
+		return errResp
+	}
+	var req = new(UpdateAppReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("UpdateApp: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("UpdateApp: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+
+	update := c.Db.App.UpdateOne(record)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+	}
+	if req.Apple != nil || req.Firebase != nil {
+		if !c.Config.Get().AppRegistry.IsEnabled() {
+			return api.Error(http.StatusServiceUnavailable, registry.ErrDisabled.Error())
+		}
+		credentials, errResp := getAppCredentials(c, record.AppID, config_entries.PushType(record.PushType), req.Apple, req.Firebase)
+		if errResp != nil {
+			return errResp
+		}
+		encrypted, errResp := encryptAppCredentials(c, record.AppID, credentials)
+		if errResp != nil {
+			return errResp
+		}
+		update.SetCredentials(encrypted)
+	}
+	updated, err := update.Save(c)
+	if err != nil {
+		c.Logger.Error("UpdateApp: failed to update app", zap.String("app_id", record.AppID), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to update app")
+	}
+	registry.Invalidate(updated.AppID)
+
+	return api.Ok(updated)
+}
+
+// DeleteApp godoc
+// @Summary 删除 app 注册表中的 app
+// @Description 删除 app 及其推送凭证, 之后不再向该 app 推送消息
+// @ID delete-app
+// @Tags apps
+// @Produce  json
+// @Param app_id path string true "app id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/apps/{app_id} [delete]
+func DeleteApp(c *api.Context) api.ResponseOptions {
+	appId := c.Param("app_id")
+	auditEntry := c.Audit(audit.OperationAppDelete)
+	auditEntry.Target = "app:" + appId
+	auditEntry.AppIds = []string{appId}
+	if resp := c.AuthorizeApps(appId); resp != nil {
+		return resp
+	}
+
+	_, err := c.Db.App.Delete().Where(app.AppID(appId)).Exec(c)
+	if err != nil {
+		c.Logger.Error("DeleteApp: failed to delete app", zap.String("app_id", appId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to delete app")
+	}
+	registry.Invalidate(appId)
+
+	return api.Ok(nil)
+}
+
+// getApp gets the app in registry by the app_id path parameter, the error response is returned if failed
+func getApp(c *api.Context) (*ent.App, api.ResponseOptions) {
+	appId := c.Param("app_id")
+	if resp := c.AuthorizeApps(appId); resp != nil {
+		return nil, resp
+	}
+
+	record, err := c.Db.App.Query().Where(app.AppID(appId)).Only(c)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, api.Error(http.StatusNotFound, "app not found")
+	case err != nil:
+		c.Logger.Error("getApp: failed to query app", zap.String("app_id", appId), zap.Error(err))
+		return nil, api.Error(http.StatusInternalServerError, "failed to get app")
+	}
+	return record, nil
+}
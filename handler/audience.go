@@ -0,0 +1,608 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/audience"
+	"github.com/shitamachi/push-service/ent/audiencemember"
+	"github.com/shitamachi/push-service/ent/userplatformtokens"
+	"github.com/shitamachi/push-service/metrics"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/segment"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// the number of audience members inserted or expanded at a time
+	audienceMemberBatchSize = 1000
+	// the max length of a line in the uploaded jsonl file
+	maxJSONLineSize = 1 << 20
+)
+
+type CreateAudienceReq struct {
+	// 受众名称
+	Name string `json:"name"`
+	// (optional) 受众描述
+	Description string `json:"description"`
+	// (optional) 设备属性的筛选条件, 设置时创建 segment 类型的受众, 否则创建 users 类型的受众并通过上传接口添加用户
+	Segment string `json:"segment,omitempty"`
+	// (optional) 受众只包含这些 app 的设备, segment 类型的受众必须设置
+	AppIds []string `json:"app_ids,omitempty"`
+}
+
+func (r *CreateAudienceReq) validate() error {
+	switch {
+	case len(r.Name) <= 0:
+		return fmt.Errorf("name is required")
+	case len(r.Segment) > 0 && len(r.AppIds) <= 0:
+		return fmt.Errorf("app_ids is required for segment audience")
+	}
+	if len(r.Segment) > 0 {
+		if _, err := segment.Parse(r.Segment); err != nil {
+			return fmt.Errorf("invalid segment: %v", err)
+		}
+	}
+	return nil
+}
+
+type ListAudiencesResp struct {
+	// 受众总数
+	Total int `json:"total"`
+	// 受众列表
+	Audiences []*ent.Audience `json:"audiences"`
+}
+
+type UploadAudienceMembersResp struct {
+	// 新添加的用户数量
+	Inserted int `json:"inserted"`
+	// 由于重复或为空而跳过的行数
+	Skipped int `json:"skipped"`
+	// 受众当前的用户总数
+	MemberCount int64 `json:"member_count"`
+}
+
+// CreateAudience godoc
+// @Summary 创建受众
+// @Description 创建受众, 受众可以是上传的用户列表或保存的设备属性筛选条件, 推送时可以通过 audience_id 指定受众
+// @ID create-audience
+// @Tags audiences
+// @Accept  json
+// @Produce  json
+// @Param message body CreateAudienceReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.Audience} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audiences [post]
+func CreateAudience(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationAudienceCreate)
+	var req = new(CreateAudienceReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("CreateAudience: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("CreateAudience: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	auditEntry.AppIds = req.AppIds
+	if resp := c.AuthorizeApps(req.AppIds...); resp != nil {
+		return resp
+	}
+
+	create := c.Db.Audience.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetAppIds(req.AppIds)
+	if len(req.Segment) > 0 {
+		create.SetType(models.AudienceSegment).SetSegment(req.Segment)
+	} else {
+		create.SetType(models.AudienceUsers)
+	}
+	record, err := create.Save(c)
+	if err != nil {
+		c.Logger.Error("CreateAudience: failed to create audience", zap.String("name", req.Name), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to create audience")
+	}
+	auditEntry.Target = fmt.Sprintf("audience:%d", record.ID)
+
+	return api.Ok(record)
+}
+
+// ListAudiences godoc
+// @Summary 获取受众列表
+// @Description 获取受众列表, 按创建时间倒序排列
+// @ID list-audiences
+// @Tags audiences
+// @Produce  json
+// @Param offset query int false "偏移量"
+// @Param limit query int false "返回的数量, 默认 100, 最大 1000"
+// @Success 200 {object} api.ResponseEntry{data=handler.ListAudiencesResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audiences [get]
+func ListAudiences(c *api.Context) api.ResponseOptions {
+	offset, limit, err := getPagination(c)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	q := c.Db.Audience.Query()
+	if getScopedAppIds(c) != nil {
+		return listScopedAudiences(c, q, offset, limit)
+	}
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		c.Logger.Error("ListAudiences: failed to count audiences", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audiences")
+	}
+	audiences, err := q.
+		Order(ent.Desc(audience.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(c)
+	if err != nil {
+		c.Logger.Error("ListAudiences: failed to query audiences", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audiences")
+	}
+
+	return api.Ok(ListAudiencesResp{
+		Total:     total,
+		Audiences: audiences,
+	})
+}
+
+// listScopedAudiences lists the audiences which the scoped caller can access,
+// the app ids of audience are saved as json so they are filtered after querying.
+func listScopedAudiences(c *api.Context, q *ent.AudienceQuery, offset, limit int) api.ResponseOptions {
+	records, err := q.Order(ent.Desc(audience.FieldID)).All(c)
+	if err != nil {
+		c.Logger.Error("ListAudiences: failed to query audiences", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audiences")
+	}
+
+	audiences := make([]*ent.Audience, 0, limit)
+	total := 0
+	for _, record := range records {
+		if c.AuthorizeApps(record.AppIds...) != nil {
+			continue
+		}
+		if total >= offset && len(audiences) < limit {
+			audiences = append(audiences, record)
+		}
+		total++
+	}
+
+	return api.Ok(ListAudiencesResp{
+		Total:     total,
+		Audiences: audiences,
+	})
+}
+
+// GetAudience godoc
+// @Summary 获取受众
+// @Description 获取受众的详细信息
+// @ID get-audience
+// @Tags audiences
+// @Produce  json
+// @Param audience_id path int true "受众 id"
+// @Success 200 {object} api.ResponseEntry{data=ent.Audience} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "受众不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audiences/{audience_id} [get]
+func GetAudience(c *api.Context) api.ResponseOptions {
+	record, resp := getAudience(c)
+	if resp != nil {
+		return resp
+	}
+	return api.Ok(record)
+}
+
+// DeleteAudience godoc
+// @Summary 删除受众
+// @Description 删除受众及其上传的用户列表
+// @ID delete-audience
+// @Tags audiences
+// @Produce  json
+// @Param audience_id path int true "受众 id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "受众不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audiences/{audience_id} [delete]
+func DeleteAudience(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationAudienceDelete)
+	auditEntry.Target = "audience:" + c.Param("audience_id")
+	record, errResp := getAudience(c)
+	if errResp != nil {
+		return errResp
+	}
+	auditEntry.AppIds = record.AppIds
+	audienceId := record.ID
+
+	err := withTx(c, func(tx *ent.Tx) error {
+		_, err := tx.AudienceMember.Delete().Where(audiencemember.AudienceID(audienceId)).Exec(c)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Audience.Delete().Where(audience.ID(audienceId)).Exec(c)
+		return err
+	})
+	if err != nil {
+		c.Logger.Error("DeleteAudience: failed to delete audience", zap.Int("audience_id", audienceId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to delete audience")
+	}
+
+	return api.Ok(nil)
+}
+
+// UploadAudienceMembers godoc
+// @Summary 上传受众的用户列表
+// @Description 上传 CSV 或 JSONL 格式的用户列表并添加到 users 类型的受众; CSV 的第一列为 user_id, 可以包含 user_id 表头; JSONL 的每一行为 {"user_id": "..."}; 文件以流的方式处理, 已存在的用户将被跳过; replace 为 true 时在一个事务中清空受众并以流的方式写入用户, 文件不合法时事务回滚, 受众保持不变
+// @ID upload-audience-members
+// @Tags audiences
+// @Accept  text/csv
+// @Accept  application/x-ndjson
+// @Produce  json
+// @Param audience_id path int true "受众 id"
+// @Param format query string false "文件格式 csv/jsonl, 为空时根据 Content-Type 判断, 默认为 csv"
+// @Param replace query bool false "是否在上传前清空受众已有的用户"
+// @Success 200 {object} api.ResponseEntry{data=handler.UploadAudienceMembersResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "受众不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audiences/{audience_id}/members [post]
+func UploadAudienceMembers(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationAudienceUploadMembers)
+	auditEntry.Target = "audience:" + c.Param("audience_id")
+	record, errResp := getAudience(c)
+	if errResp != nil {
+		return errResp
+	}
+	auditEntry.AppIds = record.AppIds
+	if record.Type != models.AudienceUsers {
+		return api.Error(http.StatusBadRequest, "members can only be uploaded to users audience")
+	}
+
+	query := c.Req.URL.Query()
+	next, err := newAudienceMemberReader(c.Req.Body, query.Get("format"), c.Req.Header.Get("Content-Type"))
+	if err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	var resp UploadAudienceMembersResp
+	if replace, _ := strconv.ParseBool(query.Get("replace")); replace {
+		// the members are replaced in a transaction while the file is streamed, so the audience is unchanged if the file is invalid
+		err = withTx(c, func(tx *ent.Tx) error {
+			_, err := tx.AudienceMember.Delete().Where(audiencemember.AudienceID(record.ID)).Exec(c)
+			if err != nil {
+				return err
+			}
+			return saveAudienceMembers(c, tx.Client(), record.ID, next, &resp)
+		})
+	} else {
+		err = saveAudienceMembers(c, c.Db, record.ID, next, &resp)
+	}
+	var lineErr *audienceLineError
+	switch {
+	case errors.As(err, &lineErr):
+		return api.Error(http.StatusBadRequest, lineErr.Error())
+	case err != nil:
+		c.Logger.Error("UploadAudienceMembers: failed to insert audience members", zap.Int("audience_id", record.ID), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to insert audience members")
+	}
+
+	count, err := c.Db.AudienceMember.Query().Where(audiencemember.AudienceID(record.ID)).Count(c)
+	if err == nil {
+		resp.MemberCount = int64(count)
+		err = c.Db.Audience.UpdateOneID(record.ID).SetMemberCount(resp.MemberCount).Exec(c)
+	}
+	if err != nil {
+		c.Logger.Error("UploadAudienceMembers: failed to update audience member count", zap.Int("audience_id", record.ID), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to update audience member count")
+	}
+
+	return api.Ok(resp)
+}
+
+// getAudience gets the audience by the audience_id path parameter, the error response is returned if failed
+func getAudience(c *api.Context) (*ent.Audience, api.ResponseOptions) {
+	audienceId, err := strconv.Atoi(c.Param("audience_id"))
+	if err != nil {
+		return nil, api.Error(http.StatusBadRequest, "invalid audience_id")
+	}
+
+	record, err := c.Db.Audience.Get(c, audienceId)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, api.Error(http.StatusNotFound, "audience not found")
+	case err != nil:
+		c.Logger.Error("getAudience: failed to query audience", zap.Int("audience_id", audienceId), zap.Error(err))
+		return nil, api.Error(http.StatusInternalServerError, "failed to get audience")
+	}
+	if resp := c.AuthorizeApps(record.AppIds...); resp != nil {
+		return nil, resp
+	}
+	return record, nil
+}
+
+// newAudienceMemberReader returns a function which reads the user id of uploaded file line by line,
+// io.EOF is returned when there are no more lines.
+func newAudienceMemberReader(r io.Reader, format, contentType string) (func() (string, error), error) {
+	if len(format) <= 0 {
+		switch {
+		case strings.Contains(contentType, "ndjson"), strings.Contains(contentType, "jsonl"):
+			format = "jsonl"
+		default:
+			format = "csv"
+		}
+	}
+
+	switch format {
+	case "csv":
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.ReuseRecord = true
+		isFirstLine := true
+		return func() (string, error) {
+			record, err := reader.Read()
+			if err != nil {
+				return "", err
+			}
+			userId := strings.TrimSpace(record[0])
+			// skip the header
+			if isFirstLine && userId == "user_id" {
+				userId = ""
+			}
+			isFirstLine = false
+			return userId, nil
+		}, nil
+	case "jsonl":
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxJSONLineSize)
+		return func() (string, error) {
+			if !scanner.Scan() {
+				if err := scanner.Err(); err != nil {
+					return "", err
+				}
+				return "", io.EOF
+			}
+			line := strings.TrimSpace(scanner.Text())
+			if len(line) <= 0 {
+				return "", nil
+			}
+			var member struct {
+				UserId string `json:"user_id"`
+			}
+			if err := json.Unmarshal([]byte(line), &member); err != nil {
+				return "", err
+			}
+			return strings.TrimSpace(member.UserId), nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// audienceLineError is returned when a line of the uploaded file can not be parsed
+type audienceLineError struct {
+	line int
+	err  error
+}
+
+func (e *audienceLineError) Error() string {
+	return fmt.Sprintf("invalid line %d: %v", e.line, e.err)
+}
+
+// saveAudienceMembers reads the uploaded users and inserts them batch by batch,
+// only a batch of users is held in memory at a time.
+func saveAudienceMembers(c *api.Context, client *ent.Client, audienceId int, next func() (string, error), resp *UploadAudienceMembersResp) error {
+	var (
+		userIds = make([]string, 0, audienceMemberBatchSize)
+		line    int
+	)
+	for {
+		userId, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return &audienceLineError{line: line, err: err}
+		}
+		if len(userId) <= 0 {
+			resp.Skipped++
+			continue
+		}
+
+		userIds = append(userIds, userId)
+		if len(userIds) >= audienceMemberBatchSize {
+			if err = insertAudienceMemberBatches(c, client, audienceId, userIds, resp); err != nil {
+				return err
+			}
+			userIds = userIds[:0]
+		}
+	}
+	return insertAudienceMemberBatches(c, client, audienceId, userIds, resp)
+}
+
+// withTx runs f in a transaction, the transaction is rolled back if f returns an error
+func withTx(c *api.Context, f func(tx *ent.Tx) error) error {
+	tx, err := c.Db.Tx(c)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err = f(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			c.Logger.Error("withTx: failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertAudienceMemberBatches inserts the users batch by batch and counts the inserted and skipped users in resp
+func insertAudienceMemberBatches(c *api.Context, client *ent.Client, audienceId int, userIds []string, resp *UploadAudienceMembersResp) error {
+	for len(userIds) > 0 {
+		batch := userIds
+		if len(batch) > audienceMemberBatchSize {
+			batch = batch[:audienceMemberBatchSize]
+		}
+		userIds = userIds[len(batch):]
+
+		inserted, err := insertAudienceMembers(c, client, audienceId, batch)
+		if err != nil {
+			return err
+		}
+		resp.Inserted += inserted
+		resp.Skipped += len(batch) - inserted
+	}
+	return nil
+}
+
+// insertAudienceMembers inserts the users which are not the members of audience, the number of inserted users is returned
+func insertAudienceMembers(c *api.Context, client *ent.Client, audienceId int, userIds []string) (int, error) {
+	existing, err := client.AudienceMember.Query().
+		Where(
+			audiencemember.AudienceID(audienceId),
+			audiencemember.UserIDIn(userIds...),
+		).
+		Select(audiencemember.FieldUserID).
+		Strings(c)
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[string]struct{}, len(userIds))
+	for _, userId := range existing {
+		seen[userId] = struct{}{}
+	}
+	builders := make([]*ent.AudienceMemberCreate, 0, len(userIds))
+	for _, userId := range userIds {
+		if _, ok := seen[userId]; ok {
+			continue
+		}
+		seen[userId] = struct{}{}
+		builders = append(builders, client.AudienceMember.Create().SetAudienceID(audienceId).SetUserID(userId))
+	}
+	if len(builders) <= 0 {
+		return 0, nil
+	}
+
+	_, err = client.AudienceMember.CreateBulk(builders...).Save(c)
+	if err != nil {
+		return 0, err
+	}
+	return len(builders), nil
+}
+
+// pushMessageForAudience pushes the global message to all the devices of audience,
+// the segment audience is pushed by the broadcast fan-out and the users audience is expanded to the tokens of users batch by batch.
+func pushMessageForAudience(c *api.Context, req *BatchPushMessageReq) api.ResponseOptions {
+	record, err := c.Db.Audience.Get(c, req.AudienceId)
+	switch {
+	case ent.IsNotFound(err):
+		return api.Error(http.StatusNotFound, "audience not found")
+	case err != nil:
+		c.Logger.Error("pushMessageForAudience: failed to query audience", zap.Int("audience_id", req.AudienceId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audience")
+	}
+	if entry := c.AuditEntry(); entry != nil {
+		entry.AppIds = record.AppIds
+	}
+	if resp := c.AuthorizeApps(record.AppIds...); resp != nil {
+		return resp
+	}
+
+	if record.Type == models.AudienceSegment {
+		seg, err := segment.Parse(record.Segment)
+		if err != nil {
+			c.Logger.Error("pushMessageForAudience: invalid segment of audience", zap.Int("audience_id", record.ID), zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "invalid segment of audience")
+		}
+		return pushMessageForAllSpecificClient(c, &PushMessageForAllSpecificClientReq{
+			ActionId: req.ActionId,
+			Message:  req.GlobalMessage,
+			AppIds:   record.AppIds,
+			Segment:  record.Segment,
+		}, seg)
+	}
+
+	var (
+		enqueuedCount int64
+		filteredCount int
+		lastId        int
+	)
+	defer func() {
+		recordEnqueuedCount(c, req.ActionId, enqueuedCount)
+	}()
+
+	for {
+		members, err := c.Db.AudienceMember.Query().
+			Where(
+				audiencemember.AudienceID(record.ID),
+				audiencemember.IDGT(lastId),
+			).
+			Order(ent.Asc(audiencemember.FieldID)).
+			Limit(audienceMemberBatchSize).
+			All(c)
+		if err != nil {
+			c.Logger.Error("pushMessageForAudience: failed to query audience members", zap.Int("audience_id", record.ID), zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to get audience members")
+		}
+		if len(members) <= 0 {
+			break
+		}
+		lastId = members[len(members)-1].ID
+
+		userIds := make([]string, len(members))
+		for i := range members {
+			userIds[i] = members[i].UserID
+		}
+		query := c.Db.UserPlatformTokens.Query().Where(userplatformtokens.UserIDIn(userIds...))
+		if len(record.AppIds) > 0 {
+			query.Where(userplatformtokens.AppIDIn(record.AppIds...))
+		}
+		timer := metrics.NewTokenQueryTimer("audience_members")
+		tokens, err := query.All(c)
+		timer.ObserveDuration()
+		if err != nil {
+			c.Logger.Error("pushMessageForAudience: failed to get the device tokens of audience members", zap.Int("audience_id", record.ID), zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to get device tokens")
+		}
+		optedOutUsers, err := getOptedOutUsers(c, req.GlobalMessage.Category, record.AppIds, userIds)
+		if err != nil {
+			c.Logger.Error("pushMessageForAudience: failed to get opted out users", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to get user notification preferences")
+		}
+
+		enqueued, filtered, err := enqueuePushMessages(c, req.GlobalMessage, nil, tokens, optedOutUsers, req.ActionId)
+		enqueuedCount += enqueued
+		filteredCount += filtered
+		if err != nil {
+			c.Logger.Error("pushMessageForAudience: failed to enqueue message", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to add push message to queue")
+		}
+	}
+
+	return api.Ok(PushMessageForAllSpecificClientResp{
+		Status:        1,
+		ActionId:      req.ActionId,
+		FilteredCount: filteredCount,
+	})
+}
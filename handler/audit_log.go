@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/auditlog"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	auditLogExportFormatCsv   = "csv"
+	auditLogExportFormatJsonl = "jsonl"
+
+	auditLogExportBatchSize = 500
+)
+
+type ListAuditLogsResp struct {
+	// 满足条件的审计日志总数
+	Total int `json:"total"`
+	// 审计日志列表
+	Logs []*ent.AuditLog `json:"logs"`
+}
+
+// getAuditLogFilters parses the filters of audit logs from query string
+func getAuditLogFilters(c *api.Context) ([]predicate.AuditLog, api.ResponseOptions) {
+	query := c.Req.URL.Query()
+	var preds []predicate.AuditLog
+
+	if operation := query.Get("operation"); len(operation) > 0 {
+		preds = append(preds, auditlog.Operation(operation))
+	}
+	if actionId := query.Get("action_id"); len(actionId) > 0 {
+		preds = append(preds, auditlog.ActionID(actionId))
+	}
+	if s := query.Get("caller_key_id"); len(s) > 0 {
+		keyId, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, api.Error(http.StatusBadRequest, fmt.Sprintf("invalid caller_key_id %s", s))
+		}
+		preds = append(preds, auditlog.CallerKeyID(keyId))
+	}
+	for param, op := range map[string]func(time.Time) predicate.AuditLog{"from": auditlog.CreatedAtGTE, "to": auditlog.CreatedAtLT} {
+		s := query.Get(param)
+		if len(s) <= 0 {
+			continue
+		}
+		ts, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, api.Error(http.StatusBadRequest, fmt.Sprintf("invalid %s %s", param, s))
+		}
+		preds = append(preds, op(time.Unix(ts, 0)))
+	}
+
+	var appIds []string
+	if appId := query.Get("app_id"); len(appId) > 0 {
+		if resp := c.AuthorizeApps(appId); resp != nil {
+			return nil, resp
+		}
+		appIds = []string{appId}
+	} else {
+		appIds = getScopedAppIds(c)
+	}
+	if len(appIds) > 0 {
+		preds = append(preds, func(s *sql.Selector) {
+			contains := make([]*sql.Predicate, len(appIds))
+			for i, appId := range appIds {
+				contains[i] = sqljson.ValueContains(s.C(auditlog.FieldAppIds), appId)
+			}
+			s.Where(sql.Or(contains...))
+		})
+	}
+	return preds, nil
+}
+
+// ListAuditLogs godoc
+// @Summary 获取审计日志
+// @Description 获取发送推送, 取消推送以及修改配置等操作的审计日志, 按创建时间倒序排列
+// @ID list-audit-logs
+// @Tags audit
+// @Produce  json
+// @Param operation query string false "操作类型, 例如 push.broadcast/action.cancel/api_key.create/config.reload"
+// @Param action_id query string false "推送动作的唯一 id"
+// @Param app_id query string false "app id"
+// @Param caller_key_id query int false "调用方 API key 的 id, 0 为 root key"
+// @Param from query int false "开始时间, unix 时间戳, 单位 s"
+// @Param to query int false "结束时间, unix 时间戳, 单位 s"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "返回的数量, 默认 100, 最大 1000"
+// @Success 200 {object} api.ResponseEntry{data=handler.ListAuditLogsResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audit_logs [get]
+func ListAuditLogs(c *api.Context) api.ResponseOptions {
+	preds, errResp := getAuditLogFilters(c)
+	if errResp != nil {
+		return errResp
+	}
+	offset, limit, err := getPagination(c)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	q := c.Db.AuditLog.Query().Where(preds...)
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		c.Logger.Error("ListAuditLogs: failed to count audit logs", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audit logs")
+	}
+	logs, err := q.
+		Order(ent.Desc(auditlog.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(c)
+	if err != nil {
+		c.Logger.Error("ListAuditLogs: failed to query audit logs", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get audit logs")
+	}
+
+	return api.Ok(ListAuditLogsResp{
+		Total: total,
+		Logs:  logs,
+	})
+}
+
+// ExportAuditLogs godoc
+// @Summary 导出审计日志
+// @Description 按创建时间顺序导出满足条件的全部审计日志, 支持 csv 以及 jsonl (每行一个 json 对象) 格式
+// @ID export-audit-logs
+// @Tags audit
+// @Produce  text/csv
+// @Produce  application/x-ndjson
+// @Param format query string false "导出格式 csv/jsonl, 默认为 csv"
+// @Param operation query string false "操作类型, 例如 push.broadcast/action.cancel/api_key.create/config.reload"
+// @Param action_id query string false "推送动作的唯一 id"
+// @Param app_id query string false "app id"
+// @Param caller_key_id query int false "调用方 API key 的 id, 0 为 root key"
+// @Param from query int false "开始时间, unix 时间戳, 单位 s"
+// @Param to query int false "结束时间, unix 时间戳, 单位 s"
+// @Success 200 {string} string "导出的文件"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/audit_logs/export [get]
+func ExportAuditLogs(c *api.Context) api.ResponseOptions {
+	format := c.Req.URL.Query().Get("format")
+	var contentType string
+	switch format {
+	case "", auditLogExportFormatCsv:
+		format, contentType = auditLogExportFormatCsv, "text/csv; charset=utf-8"
+	case auditLogExportFormatJsonl:
+		contentType = "application/x-ndjson"
+	default:
+		return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid format %q", format))
+	}
+	preds, errResp := getAuditLogFilters(c)
+	if errResp != nil {
+		return errResp
+	}
+
+	// query the first batch before writing the header, so that the error can still be responded as json
+	lastId := 0
+	logs, err := queryAuditLogsAfter(c, preds, lastId)
+	if err != nil {
+		c.Logger.Error("ExportAuditLogs: failed to query audit logs", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to export audit logs")
+	}
+
+	filename := fmt.Sprintf("audit_logs_%s.%s", time.Now().Format("20060102150405"), format)
+	c.Writer.Header().Set("Content-Type", contentType)
+	c.Writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Writer.WriteHeader(http.StatusOK)
+
+	writer := newAuditLogWriter(c.Writer, format)
+	defer writer.Flush()
+	for len(logs) > 0 {
+		for _, record := range logs {
+			if err = writer.Write(record); err != nil {
+				// the client may have gone away, nothing can be responded
+				c.Logger.Warn("ExportAuditLogs: failed to write audit log", zap.Error(err))
+				return api.Written(http.StatusOK)
+			}
+		}
+		writer.Flush()
+		if len(logs) < auditLogExportBatchSize {
+			break
+		}
+		lastId = logs[len(logs)-1].ID
+		logs, err = queryAuditLogsAfter(c, preds, lastId)
+		if err != nil {
+			// the header has been written, the truncated file is all that can be responded
+			c.Logger.Error("ExportAuditLogs: failed to query audit logs", zap.Int("last_id", lastId), zap.Error(err))
+			return api.Written(http.StatusOK)
+		}
+	}
+	return api.Written(http.StatusOK)
+}
+
+func queryAuditLogsAfter(c *api.Context, preds []predicate.AuditLog, lastId int) ([]*ent.AuditLog, error) {
+	return c.Db.AuditLog.Query().
+		Where(preds...).
+		Where(auditlog.IDGT(lastId)).
+		Order(ent.Asc(auditlog.FieldID)).
+		Limit(auditLogExportBatchSize).
+		All(c)
+}
+
+type auditLogWriter interface {
+	Write(record *ent.AuditLog) error
+	Flush()
+}
+
+func newAuditLogWriter(w http.ResponseWriter, format string) auditLogWriter {
+	if format == auditLogExportFormatJsonl {
+		return &auditLogJsonlWriter{w: w, encoder: json.NewEncoder(w)}
+	}
+	writer := csv.NewWriter(w)
+	// the error is reported by the following writes as the csv writer is buffered
+	_ = writer.Write(auditLogCsvHeader)
+	return &auditLogCsvWriter{w: w, writer: writer}
+}
+
+type auditLogJsonlWriter struct {
+	w       http.ResponseWriter
+	encoder *json.Encoder
+}
+
+func (w *auditLogJsonlWriter) Write(record *ent.AuditLog) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *auditLogJsonlWriter) Flush() {
+	if f, ok := w.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+var auditLogCsvHeader = []string{
+	auditlog.FieldID,
+	auditlog.FieldCreatedAt,
+	auditlog.FieldCallerKeyID,
+	auditlog.FieldCallerName,
+	auditlog.FieldCallerUserID,
+	auditlog.FieldRemoteAddr,
+	auditlog.FieldMethod,
+	auditlog.FieldEndpoint,
+	auditlog.FieldOperation,
+	auditlog.FieldTarget,
+	auditlog.FieldActionID,
+	auditlog.FieldAppIds,
+	auditlog.FieldAudienceSize,
+	auditlog.FieldMessageSummary,
+	auditlog.FieldStatusCode,
+	auditlog.FieldResult,
+}
+
+type auditLogCsvWriter struct {
+	w      http.ResponseWriter
+	writer *csv.Writer
+}
+
+func (w *auditLogCsvWriter) Write(record *ent.AuditLog) error {
+	return w.writer.Write([]string{
+		strconv.Itoa(record.ID),
+		record.CreatedAt.Format(time.RFC3339),
+		strconv.Itoa(record.CallerKeyID),
+		record.CallerName,
+		record.CallerUserID,
+		record.RemoteAddr,
+		record.Method,
+		record.Endpoint,
+		record.Operation,
+		record.Target,
+		record.ActionID,
+		strings.Join(record.AppIds, ","),
+		strconv.FormatInt(record.AudienceSize, 10),
+		record.MessageSummary,
+		strconv.Itoa(record.StatusCode),
+		record.Result,
+	})
+}
+
+func (w *auditLogCsvWriter) Flush() {
+	w.writer.Flush()
+	if f, ok := w.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
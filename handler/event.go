@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/ent/pushevent"
+	"github.com/shitamachi/push-service/models"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type ReportEventReq struct {
+	// 推送消息 data 中的 tracking_id
+	TrackingId string `json:"tracking_id"`
+	// 事件类型 opened/dismissed
+	Event models.EventType `json:"event"`
+	// (optional) 事件发生的时间, unix 时间戳, 单位 s; 为空时使用服务端接收到事件的时间
+	Timestamp int64 `json:"timestamp,omitempty"`
+}
+
+func (r *ReportEventReq) validate() error {
+	switch {
+	case len(r.TrackingId) <= 0:
+		return fmt.Errorf("tracking_id is required")
+	case r.Event != models.EventOpened && r.Event != models.EventDismissed:
+		return fmt.Errorf("invalid event %q", r.Event)
+	}
+	return nil
+}
+
+type EventStatsItem struct {
+	// 分组的值, 即 action_id, app_id 或 template_id
+	Key string `json:"key"`
+	// 推送成功的消息数
+	Delivered int `json:"delivered"`
+	// 被打开的消息数
+	Opened int `json:"opened"`
+	// 被忽略的消息数
+	Dismissed int `json:"dismissed"`
+	// 打开率, opened / delivered
+	OpenRate float64 `json:"open_rate"`
+}
+
+// eventStatsRow is the row of grouped counts, only the grouped columns are set
+type eventStatsRow struct {
+	ActionID   string `json:"action_id"`
+	AppID      string `json:"app_id"`
+	TemplateID string `json:"template_id"`
+	EventType  string `json:"event_type"`
+	Count      int    `json:"count"`
+}
+
+func (r eventStatsRow) key(groupBy string) string {
+	switch groupBy {
+	case deliveryresult.FieldAppID:
+		return r.AppID
+	case deliveryresult.FieldTemplateID:
+		return r.TemplateID
+	default:
+		return r.ActionID
+	}
+}
+
+// ReportEvent godoc
+// @Summary 上报通知事件
+// @Description 客户端在通知被打开或忽略时上报事件, tracking_id 为推送消息 data 中的 tracking_id; 重复上报的事件将被忽略; 用户 token 只能上报推送给该用户的通知的事件
+// @ID report-event
+// @Tags events
+// @Accept  json
+// @Produce  json
+// @Param message body ReportEventReq true "请求体"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 404 {object} api.ResponseEntry "tracking_id 不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/events [post]
+func ReportEvent(c *api.Context) api.ResponseOptions {
+	var req = new(ReportEventReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("ReportEvent: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("ReportEvent: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := c.Db.DeliveryResult.Query().
+		Where(deliveryresult.TrackingID(req.TrackingId)).
+		First(c)
+	switch {
+	case ent.IsNotFound(err):
+		return api.Error(http.StatusNotFound, "tracking_id not found")
+	case err != nil:
+		c.Logger.Error("ReportEvent: failed to query delivery result", zap.String("tracking_id", req.TrackingId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query delivery result")
+	}
+	if resp := c.AuthorizeApps(result.AppID); resp != nil {
+		return resp
+	}
+	// the user token can only report the events of the pushes sent to the user
+	if resp := c.AuthorizeUser(result.UserID); resp != nil {
+		return resp
+	}
+
+	occurredAt := time.Now()
+	if req.Timestamp > 0 {
+		occurredAt = time.Unix(req.Timestamp, 0)
+	}
+	_, err = c.Db.PushEvent.Create().
+		SetTrackingID(req.TrackingId).
+		SetEventType(req.Event).
+		SetActionID(result.ActionID).
+		SetAppID(result.AppID).
+		SetUserID(result.UserID).
+		SetTemplateID(result.TemplateID).
+		SetVariant(result.Variant).
+		SetOccurredAt(occurredAt).
+		Save(c)
+	switch {
+	case ent.IsConstraintError(err):
+		// the event has been reported
+		return api.Ok(nil)
+	case err != nil:
+		c.Logger.Error("ReportEvent: failed to save event", zap.String("tracking_id", req.TrackingId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to save event")
+	}
+
+	if len(result.Variant) > 0 {
+		err = action.IncrVariantStat(c, c.RedisClient, result.ActionID, result.Variant, req.Event, 1)
+	} else {
+		err = action.IncrStat(c, c.RedisClient, result.ActionID, req.Event, 1)
+	}
+	if err != nil {
+		c.Logger.Warn("ReportEvent: failed to record action stat",
+			zap.String("action_id", result.ActionID),
+			zap.String("event", req.Event),
+			zap.Error(err),
+		)
+	}
+
+	return api.Ok(nil)
+}
+
+// GetEventStats godoc
+// @Summary 获取通知的打开率
+// @Description 按 action_id, app_id 或 template_id 分组统计推送成功的消息数, 打开数, 忽略数以及打开率
+// @ID get-event-stats
+// @Tags events
+// @Produce  json
+// @Param group_by query string false "分组字段 action_id/app_id/template_id, 默认为 action_id"
+// @Param action_id query string false "推送动作的唯一 id"
+// @Param app_id query string false "app id"
+// @Param template_id query string false "消息模板 id"
+// @Param from query int false "开始时间, unix 时间戳, 单位 s"
+// @Param to query int false "结束时间, unix 时间戳, 单位 s"
+// @Success 200 {object} api.ResponseEntry{data=[]handler.EventStatsItem} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/events/stats [get]
+func GetEventStats(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	groupBy := query.Get("group_by")
+	switch groupBy {
+	case "":
+		groupBy = deliveryresult.FieldActionID
+	case deliveryresult.FieldActionID, deliveryresult.FieldAppID, deliveryresult.FieldTemplateID:
+	default:
+		return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid group_by %q", groupBy))
+	}
+
+	// the delivery results and events have the same filter columns
+	var preds []*sql.Predicate
+	for _, column := range []string{deliveryresult.FieldActionID, deliveryresult.FieldAppID, deliveryresult.FieldTemplateID} {
+		if v := query.Get(column); len(v) > 0 {
+			preds = append(preds, sql.EQ(column, v))
+		}
+	}
+	if appId := query.Get(deliveryresult.FieldAppID); len(appId) > 0 {
+		if resp := c.AuthorizeApps(appId); resp != nil {
+			return resp
+		}
+	} else if appIds := getScopedAppIds(c); appIds != nil {
+		args := make([]interface{}, len(appIds))
+		for i := range appIds {
+			args[i] = appIds[i]
+		}
+		preds = append(preds, sql.In(deliveryresult.FieldAppID, args...))
+	}
+	for param, op := range map[string]func(string, interface{}) *sql.Predicate{"from": sql.GTE, "to": sql.LT} {
+		s := query.Get(param)
+		if len(s) <= 0 {
+			continue
+		}
+		ts, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid %s %s", param, s))
+		}
+		preds = append(preds, op(deliveryresult.FieldCreatedAt, time.Unix(ts, 0)))
+	}
+	filter := func(s *sql.Selector) {
+		if len(preds) > 0 {
+			s.Where(sql.And(preds...))
+		}
+	}
+
+	var delivered []eventStatsRow
+	err := c.Db.DeliveryResult.Query().
+		Where(filter, deliveryresult.Status(models.DeliverySucceeded)).
+		GroupBy(groupBy).
+		Aggregate(ent.Count()).
+		Scan(c, &delivered)
+	if err != nil {
+		c.Logger.Error("GetEventStats: failed to count delivery results", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to count delivery results")
+	}
+	var events []eventStatsRow
+	err = c.Db.PushEvent.Query().
+		Where(filter).
+		GroupBy(groupBy, pushevent.FieldEventType).
+		Aggregate(ent.Count()).
+		Scan(c, &events)
+	if err != nil {
+		c.Logger.Error("GetEventStats: failed to count events", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to count events")
+	}
+
+	items := make(map[string]*EventStatsItem, len(delivered))
+	getItem := func(key string) *EventStatsItem {
+		item, ok := items[key]
+		if !ok {
+			item = &EventStatsItem{Key: key}
+			items[key] = item
+		}
+		return item
+	}
+	for _, row := range delivered {
+		getItem(row.key(groupBy)).Delivered = row.Count
+	}
+	for _, row := range events {
+		item := getItem(row.key(groupBy))
+		switch row.EventType {
+		case models.EventOpened:
+			item.Opened = row.Count
+		case models.EventDismissed:
+			item.Dismissed = row.Count
+		}
+	}
+
+	resp := make([]*EventStatsItem, 0, len(items))
+	for _, item := range items {
+		if item.Delivered > 0 {
+			item.OpenRate = float64(item.Opened) / float64(item.Delivered)
+		}
+		resp = append(resp, item)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Key < resp[j].Key
+	})
+
+	return api.Ok(resp)
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/shitamachi/push-service/api"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	idempotencyKeyPrefix      = "push_idempotency"
+	idempotencyStatePending   = "pending"
+	idempotencyStateCompleted = "completed"
+	// the ttl of the pending state, it is extended while the request is being handled,
+	// so the key is released soon if the process crashes before the response is saved
+	idempotencyPendingTTL = 30 * time.Second
+)
+
+type idempotencyRecord struct {
+	State string `json:"state"`
+	// the sha256 of the request body, the key can not be reused by a different request
+	BodyHash string `json:"body_hash,omitempty"`
+	HttpCode int    `json:"http_code,omitempty"`
+	// the original response, data is kept as raw json so that it can be replayed as it was
+	Response *struct {
+		api.ResponseEntry
+		Data json.RawMessage `json:"data,omitempty"`
+	} `json:"response,omitempty"`
+}
+
+// getIdempotencyKey returns the Idempotency-Key header if it is set, otherwise the action id passed by the caller
+func getIdempotencyKey(c *api.Context, actionId string) string {
+	if key := c.Req.Header.Get(IdempotencyKeyHeader); len(key) > 0 {
+		return key
+	}
+	return actionId
+}
+
+// getActionId returns the action id passed by the caller. If it is not set but the Idempotency-Key header is,
+// the action id is derived from the key, so that the retried request enqueues the messages with the same action id
+// and the messages enqueued before a failure are deduplicated by the consumer. Otherwise a new one is generated.
+func getActionId(c *api.Context, actionId string) string {
+	if len(actionId) > 0 {
+		return actionId
+	}
+	if key := c.Req.Header.Get(IdempotencyKeyHeader); len(key) > 0 {
+		return uuid.NewSHA1(uuid.NameSpaceOID, []byte(getIdempotencyRedisKey(c, key))).String()
+	}
+	return uuid.NewString()
+}
+
+// getIdempotencyRedisKey scopes the key to the caller and the route, so that different callers or endpoints
+// using the same key do not get the response of each other
+func getIdempotencyRedisKey(c *api.Context, key string) string {
+	var keyId int
+	if c.Caller != nil {
+		keyId = c.Caller.KeyId
+	}
+	return fmt.Sprintf("%s:%d:%s:%s", idempotencyKeyPrefix, keyId, c.FullPath, key)
+}
+
+// withIdempotency makes sure the requests with the same key are only handled once within the configured window.
+// The response of the first succeeded request is saved and returned to the repeated ones,
+// if the first request is still being handled the repeated ones will be rejected,
+// so are the requests which reuse the key with a different body.
+func withIdempotency(c *api.Context, key string, body []byte, f func() api.ResponseOptions) api.ResponseOptions {
+	if len(key) <= 0 {
+		return f()
+	}
+
+	redisKey := getIdempotencyRedisKey(c, key)
+	window := c.Config.Get().Idempotency.GetWindow()
+	sum := sha256.Sum256(body)
+	bodyHash := hex.EncodeToString(sum[:])
+
+	pending, _ := json.Marshal(&idempotencyRecord{State: idempotencyStatePending, BodyHash: bodyHash})
+	ok, err := c.RedisClient.SetNX(c, redisKey, pending, idempotencyPendingTTL).Result()
+	if err != nil {
+		// redis is unavailable, handle the request anyway
+		c.Logger.Error("Idempotency: failed to save idempotency key", zap.String("key", key), zap.Error(err))
+		return f()
+	}
+
+	if !ok {
+		return replayIdempotentResponse(c, key, redisKey, bodyHash)
+	}
+
+	stop := keepIdempotencyKeyPending(c, key, redisKey)
+	opts := f()
+	stop()
+	response := api.NewResponse(opts)
+	if response.HttpCode < http.StatusOK || response.HttpCode >= http.StatusMultipleChoices {
+		// the request is failed, allow the caller to retry it
+		if err := c.RedisClient.Del(c, redisKey).Err(); err != nil {
+			c.Logger.Error("Idempotency: failed to delete idempotency key", zap.String("key", key), zap.Error(err))
+		}
+		return opts
+	}
+
+	record := idempotencyRecord{State: idempotencyStateCompleted, BodyHash: bodyHash, HttpCode: response.HttpCode}
+	bytes, err := json.Marshal(response.ResponseEntry)
+	if err == nil {
+		err = json.Unmarshal(bytes, &record.Response)
+	}
+	if err == nil {
+		bytes, err = json.Marshal(&record)
+	}
+	if err == nil {
+		err = c.RedisClient.Set(c, redisKey, bytes, window).Err()
+	}
+	if err != nil {
+		c.Logger.Error("Idempotency: failed to save response of idempotency key", zap.String("key", key), zap.Error(err))
+	}
+
+	return opts
+}
+
+// keepIdempotencyKeyPending extends the ttl of pending state periodically until the returned stop func is called
+func keepIdempotencyKeyPending(c *api.Context, key, redisKey string) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(idempotencyPendingTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := c.RedisClient.Expire(c, redisKey, idempotencyPendingTTL).Err(); err != nil {
+					c.Logger.Warn("Idempotency: failed to extend pending idempotency key", zap.String("key", key), zap.Error(err))
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func replayIdempotentResponse(c *api.Context, key, redisKey, bodyHash string) api.ResponseOptions {
+	value, err := c.RedisClient.Get(c, redisKey).Bytes()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		c.Logger.Error("Idempotency: failed to get idempotency key", zap.String("key", key), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to check idempotency key")
+	}
+
+	var record idempotencyRecord
+	if err == nil {
+		err = json.Unmarshal(value, &record)
+		if err != nil {
+			c.Logger.Error("Idempotency: failed to decode idempotency record", zap.String("key", key), zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to check idempotency key")
+		}
+	}
+
+	if len(record.BodyHash) > 0 && record.BodyHash != bodyHash {
+		c.Logger.Warn("Idempotency: idempotency key is reused with a different request body", zap.String("key", key))
+		return api.Error(http.StatusUnprocessableEntity, "action_id or idempotency key is reused with a different request body")
+	}
+	if record.State != idempotencyStateCompleted || record.Response == nil {
+		c.Logger.Warn("Idempotency: request with the same idempotency key is being processed", zap.String("key", key))
+		return api.Error(http.StatusConflict, "request with the same action_id or idempotency key is being processed")
+	}
+
+	c.Logger.Info("Idempotency: repeated request, replay the original response", zap.String("key", key))
+	c.Writer.Header().Set(IdempotentReplayedHeader, "true")
+	return api.New(record.HttpCode, record.Response.Status, record.Response.Message, record.Response.Data)
+}
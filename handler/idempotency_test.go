@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/auth"
+	"github.com/shitamachi/push-service/config"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestContext(t *testing.T) (*miniredis.Miniredis, func(keyId int, header http.Header) *api.Context) {
+	m := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	appContext := api.NewAppContext(config.NewStore("", &config.AppConfig{}, zap.NewNop()), zap.NewNop(), client, nil, nil, nil)
+	return m, func(keyId int, header http.Header) *api.Context {
+		req := httptest.NewRequest(http.MethodPost, "/v1/batch_push_messages_async", nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		return &api.Context{
+			AppContext: appContext,
+			Writer:     httptest.NewRecorder(),
+			Req:        req,
+			Caller:     &auth.Caller{KeyId: keyId},
+			FullPath:   "/v1/batch_push_messages_async",
+		}
+	}
+}
+
+func TestWithIdempotency(t *testing.T) {
+	m, newContext := newTestContext(t)
+	body := []byte(`{"action_id":"action"}`)
+	calls := 0
+	handle := func() api.ResponseOptions {
+		calls++
+		return api.Ok(PushMessageForAllSpecificClientResp{Status: 1, ActionId: "action"})
+	}
+
+	c := newContext(1, nil)
+	resp := api.NewResponse(withIdempotency(c, "action", body, handle))
+	assert.Equal(t, http.StatusOK, resp.HttpCode)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, c.Config.Get().Idempotency.GetWindow(), m.TTL(getIdempotencyRedisKey(c, "action")))
+
+	// the repeated request gets the original response
+	c = newContext(1, nil)
+	resp = api.NewResponse(withIdempotency(c, "action", body, handle))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusOK, resp.HttpCode)
+	assert.Equal(t, "true", c.Writer.Header().Get(IdempotentReplayedHeader))
+	data, _ := json.Marshal(resp.Data)
+	assert.JSONEq(t, `{"status":1,"action_id":"action","filtered_count":0}`, string(data))
+
+	// the key can not be reused with a different body
+	resp = api.NewResponse(withIdempotency(newContext(1, nil), "action", []byte(`{"action_id":"other"}`), handle))
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.HttpCode)
+	assert.Equal(t, 1, calls)
+
+	// the keys of different callers are independent
+	resp = api.NewResponse(withIdempotency(newContext(2, nil), "action", body, handle))
+	assert.Equal(t, http.StatusOK, resp.HttpCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestWithIdempotencyPending(t *testing.T) {
+	m, newContext := newTestContext(t)
+	body := []byte(`{}`)
+
+	c := newContext(1, nil)
+	resp := api.NewResponse(withIdempotency(c, "key", body, func() api.ResponseOptions {
+		// the pending key expires soon in case the process crashes
+		assert.Equal(t, idempotencyPendingTTL, m.TTL(getIdempotencyRedisKey(c, "key")))
+		// the request with the same key is rejected while the first one is being handled
+		resp := api.NewResponse(withIdempotency(newContext(1, nil), "key", body, func() api.ResponseOptions {
+			assert.Fail(t, "the request should not be handled")
+			return api.Ok(nil)
+		}))
+		assert.Equal(t, http.StatusConflict, resp.HttpCode)
+		return api.Error(http.StatusInternalServerError, "failed")
+	}))
+	assert.Equal(t, http.StatusInternalServerError, resp.HttpCode)
+
+	// the failed request can be retried
+	assert.False(t, m.Exists(getIdempotencyRedisKey(c, "key")))
+	calls := 0
+	resp = api.NewResponse(withIdempotency(newContext(1, nil), "key", body, func() api.ResponseOptions {
+		calls++
+		return api.Ok(nil)
+	}))
+	assert.Equal(t, http.StatusOK, resp.HttpCode)
+	assert.Equal(t, 1, calls)
+
+	// the key of crashed request is released after the pending ttl
+	c = newContext(1, nil)
+	assert.NoError(t, c.RedisClient.Set(c, getIdempotencyRedisKey(c, "crashed"), `{"state":"pending","body_hash":"hash"}`, idempotencyPendingTTL).Err())
+	m.FastForward(idempotencyPendingTTL)
+	resp = api.NewResponse(withIdempotency(c, "crashed", body, func() api.ResponseOptions {
+		return api.Ok(nil)
+	}))
+	assert.Equal(t, http.StatusOK, resp.HttpCode)
+}
+
+func TestGetActionId(t *testing.T) {
+	_, newContext := newTestContext(t)
+	header := http.Header{IdempotencyKeyHeader: []string{"key"}}
+
+	assert.Equal(t, "action", getActionId(newContext(1, header), "action"))
+	// the retried request gets the same action id
+	actionId := getActionId(newContext(1, header), "")
+	assert.Len(t, actionId, 36)
+	assert.Equal(t, actionId, getActionId(newContext(1, header), ""))
+	assert.NotEqual(t, actionId, getActionId(newContext(2, header), ""))
+	assert.NotEqual(t, actionId, getActionId(newContext(1, http.Header{IdempotencyKeyHeader: []string{"other"}}), ""))
+	// a new one is generated without the idempotency key
+	assert.NotEqual(t, getActionId(newContext(1, nil), ""), getActionId(newContext(1, nil), ""))
+}
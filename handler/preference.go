@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/usernotificationpreference"
+	"github.com/shitamachi/push-service/ent/userplatformtokens"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type SetUserPreferencesReq struct {
+	// 用户 id
+	UserId string `json:"user_id"`
+	// app id
+	AppId string `json:"app_id"`
+	// 消息分类的订阅状态, key 为消息分类, value 为 false 时表示退订该分类
+	Categories map[string]bool `json:"categories"`
+}
+
+func (r *SetUserPreferencesReq) validate() error {
+	switch {
+	case len(r.UserId) <= 0:
+		return fmt.Errorf("user_id is required")
+	case len(r.AppId) <= 0:
+		return fmt.Errorf("app_id is required")
+	case len(r.Categories) <= 0:
+		return fmt.Errorf("categories is required")
+	}
+	for category := range r.Categories {
+		if len(category) <= 0 {
+			return fmt.Errorf("category can not be empty")
+		}
+	}
+	return nil
+}
+
+// SetUserPreferences godoc
+// @Summary 设置用户的消息分类订阅状态
+// @Description 设置用户在某个 app 对各个消息分类的订阅状态, 退订的分类将不会再推送给该用户
+// @ID set-user-preferences
+// @Tags preferences
+// @Accept  json
+// @Produce  json
+// @Param message body SetUserPreferencesReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=[]ent.UserNotificationPreference} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/preferences [put]
+func SetUserPreferences(c *api.Context) api.ResponseOptions {
+	var req = new(SetUserPreferencesReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("SetUserPreferences: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("SetUserPreferences: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	if resp := c.AuthorizeApps(req.AppId); resp != nil {
+		return resp
+	}
+
+	for category, enabled := range req.Categories {
+		n, err := c.Db.UserNotificationPreference.Update().
+			Where(
+				usernotificationpreference.UserID(req.UserId),
+				usernotificationpreference.AppID(req.AppId),
+				usernotificationpreference.Category(category),
+			).
+			SetEnabled(enabled).
+			Save(c)
+		if err == nil && n <= 0 {
+			_, err = c.Db.UserNotificationPreference.Create().
+				SetUserID(req.UserId).
+				SetAppID(req.AppId).
+				SetCategory(category).
+				SetEnabled(enabled).
+				Save(c)
+		}
+		if err != nil {
+			c.Logger.Error("SetUserPreferences: failed to save user notification preference",
+				zap.String("user_id", req.UserId),
+				zap.String("app_id", req.AppId),
+				zap.String("category", category),
+				zap.Error(err),
+			)
+			return api.Error(http.StatusInternalServerError, "failed to save user notification preferences")
+		}
+	}
+
+	records, err := c.Db.UserNotificationPreference.Query().
+		Where(
+			usernotificationpreference.UserID(req.UserId),
+			usernotificationpreference.AppID(req.AppId),
+		).
+		Order(ent.Asc(usernotificationpreference.FieldCategory)).
+		All(c)
+	if err != nil {
+		c.Logger.Error("SetUserPreferences: failed to query user notification preferences", zap.String("user_id", req.UserId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query user notification preferences")
+	}
+
+	return api.Ok(records)
+}
+
+// GetUserPreferences godoc
+// @Summary 获取用户的消息分类订阅状态
+// @Description 获取用户在各个 app 对消息分类的订阅状态, 未设置的分类默认为订阅
+// @ID get-user-preferences
+// @Tags preferences
+// @Produce  json
+// @Param user_id query string true "用户 id"
+// @Param app_id query string false "app id, 为空时返回所有 app 的设置"
+// @Success 200 {object} api.ResponseEntry{data=[]ent.UserNotificationPreference} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/preferences [get]
+func GetUserPreferences(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	userId := query.Get("user_id")
+	if len(userId) <= 0 {
+		return api.Error(http.StatusBadRequest, "user_id is required")
+	}
+
+	q := c.Db.UserNotificationPreference.Query().Where(usernotificationpreference.UserID(userId))
+	if appId := query.Get("app_id"); len(appId) > 0 {
+		if resp := c.AuthorizeApps(appId); resp != nil {
+			return resp
+		}
+		q.Where(usernotificationpreference.AppID(appId))
+	} else if appIds := getScopedAppIds(c); appIds != nil {
+		q.Where(usernotificationpreference.AppIDIn(appIds...))
+	}
+	records, err := q.
+		Order(ent.Asc(usernotificationpreference.FieldAppID), ent.Asc(usernotificationpreference.FieldCategory)).
+		All(c)
+	if err != nil {
+		c.Logger.Error("GetUserPreferences: failed to query user notification preferences", zap.String("user_id", userId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query user notification preferences")
+	}
+
+	return api.Ok(records)
+}
+
+// DeleteUserPreferences godoc
+// @Summary 删除用户的消息分类订阅设置
+// @Description 删除用户的消息分类订阅设置, 删除后该分类恢复为默认的订阅状态
+// @ID delete-user-preferences
+// @Tags preferences
+// @Produce  json
+// @Param user_id query string true "用户 id"
+// @Param app_id query string true "app id"
+// @Param category query string false "消息分类, 为空时删除该 app 的所有设置"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/preferences [delete]
+func DeleteUserPreferences(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	userId, appId := query.Get("user_id"), query.Get("app_id")
+	if len(userId) <= 0 || len(appId) <= 0 {
+		return api.Error(http.StatusBadRequest, "user_id and app_id are required")
+	}
+	if resp := c.AuthorizeApps(appId); resp != nil {
+		return resp
+	}
+
+	d := c.Db.UserNotificationPreference.Delete().
+		Where(
+			usernotificationpreference.UserID(userId),
+			usernotificationpreference.AppID(appId),
+		)
+	if category := query.Get("category"); len(category) > 0 {
+		d.Where(usernotificationpreference.Category(category))
+	}
+	_, err := d.Exec(c)
+	if err != nil {
+		c.Logger.Error("DeleteUserPreferences: failed to delete user notification preferences",
+			zap.String("user_id", userId),
+			zap.String("app_id", appId),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to delete user notification preferences")
+	}
+
+	return api.Ok(nil)
+}
+
+func getOptedOutKey(userId, appId string) string {
+	return fmt.Sprintf("%s:%s", userId, appId)
+}
+
+// getOptedOutUsers returns the users who have opted out the category, the result is keyed by getOptedOutKey.
+// Messages without category can not be opted out, so nil is returned for an empty category.
+func getOptedOutUsers(c *api.Context, category string, appIds []string, userIds []string) (map[string]struct{}, error) {
+	if len(category) <= 0 {
+		return nil, nil
+	}
+
+	q := c.Db.UserNotificationPreference.Query().
+		Where(
+			usernotificationpreference.Category(category),
+			usernotificationpreference.Enabled(false),
+		)
+	if len(appIds) > 0 {
+		q.Where(usernotificationpreference.AppIDIn(appIds...))
+	}
+	if len(userIds) > 0 {
+		q.Where(usernotificationpreference.UserIDIn(userIds...))
+	}
+	records, err := q.All(c)
+	if err != nil {
+		return nil, err
+	}
+
+	optedOutUsers := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		optedOutUsers[getOptedOutKey(record.UserID, record.AppID)] = struct{}{}
+	}
+	return optedOutUsers, nil
+}
+
+// optedOutPredicate matches the device tokens whose users have opted out the category of their apps,
+// column returns the qualified column of the device token table in the outer query.
+func optedOutPredicate(category string, column func(string) string) *sql.Predicate {
+	t := sql.Table(usernotificationpreference.Table)
+	return sql.Exists(
+		sql.Select(t.C(usernotificationpreference.FieldID)).
+			From(t).
+			Where(sql.And(
+				sql.ColumnsEQ(t.C(usernotificationpreference.FieldUserID), column(userplatformtokens.FieldUserID)),
+				sql.ColumnsEQ(t.C(usernotificationpreference.FieldAppID), column(userplatformtokens.FieldAppID)),
+				sql.EQ(t.C(usernotificationpreference.FieldCategory), category),
+				sql.EQ(t.C(usernotificationpreference.FieldEnabled), false),
+			)),
+	)
+}
+
+func isOptedOut(optedOutUsers map[string]struct{}, token *ent.UserPlatformTokens) bool {
+	_, ok := optedOutUsers[getOptedOutKey(token.UserID, token.AppID)]
+	return ok
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestDb(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=private&_fk=1")
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return client
+}
+
+func TestQueryBroadcastTokenIds(t *testing.T) {
+	_, newContext := newTestContext(t)
+	c := newContext(1, nil)
+	c.AppContext = api.NewAppContext(c.Config, c.Logger, c.RedisClient, newTestDb(t), nil, nil)
+
+	now := time.Now()
+	tokenIds := make(map[string]int)
+	for _, token := range []struct{ userId, appId, token string }{
+		{"u1", "app", "t1"},
+		{"u1", "other", "t2"},
+		{"u2", "app", "t3"},
+		{"u2", "app", "t4"},
+		{"u3", "app", "t5"},
+		{"u4", "excluded", "t6"},
+	} {
+		record := c.Db.UserPlatformTokens.Create().
+			SetType(1).
+			SetUserID(token.userId).
+			SetDeviceID(token.token).
+			SetToken(token.token).
+			SetAppID(token.appId).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			SaveX(c)
+		tokenIds[token.token] = record.ID
+	}
+	for _, preference := range []struct {
+		userId, appId, category string
+		enabled                 bool
+	}{
+		// u1 only opted out promotions of app
+		{"u1", "app", "promotions", false},
+		{"u2", "app", "promotions", false},
+		{"u2", "app", "order_updates", false},
+		{"u3", "app", "promotions", true},
+	} {
+		c.Db.UserNotificationPreference.Create().
+			SetUserID(preference.userId).
+			SetAppID(preference.appId).
+			SetCategory(preference.category).
+			SetEnabled(preference.enabled).
+			SaveX(c)
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		tokens   []string
+		filtered int
+	}{
+		{name: "no category", tokens: []string{"t1", "t2", "t3", "t4", "t5"}},
+		{name: "opted out", category: "promotions", tokens: []string{"t2", "t5"}, filtered: 3},
+		{name: "opted out by one user", category: "order_updates", tokens: []string{"t1", "t2", "t5"}, filtered: 2},
+		{name: "nobody opted out", category: "news", tokens: []string{"t1", "t2", "t3", "t4", "t5"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, filtered, err := queryBroadcastTokenIds(c, []string{"app", "other"}, nil, tt.category)
+			assert.NoError(t, err)
+			expected := make([]int, 0, len(tt.tokens))
+			for _, token := range tt.tokens {
+				expected = append(expected, tokenIds[token])
+			}
+			sort.Ints(ids)
+			assert.Equal(t, expected, ids)
+			assert.Equal(t, tt.filtered, filtered)
+		})
+	}
+}
@@ -7,15 +7,21 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
 	"github.com/shitamachi/push-service/ent"
 	"github.com/shitamachi/push-service/ent/userplatformtokens"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
 	"github.com/shitamachi/push-service/models"
 	"github.com/shitamachi/push-service/mq"
 	"github.com/shitamachi/push-service/push"
+	"github.com/shitamachi/push-service/segment"
+	"github.com/shitamachi/push-service/tracing"
 	"github.com/shitamachi/redisqueue/v2"
 	"go.uber.org/zap"
 	"net/http"
 	"sync"
+	"time"
 )
 
 type PushMessageFirebaseItem push.MessageFirebaseItem
@@ -38,6 +44,8 @@ type BatchPushMessageReq struct {
 	MessageItems []PushMessageReqItem `json:"message_items"`
 	// 异步处理推送时使用
 	ActionId string `json:"action_id,omitempty"`
+	// (optional) 推送给保存的受众, 设置时忽略 message_items 并使用 global_message 推送给受众的所有设备
+	AudienceId int `json:"audience_id,omitempty"`
 }
 
 type BatchPushMessageRespItem struct {
@@ -81,13 +89,27 @@ type PushMessageForAllSpecificClientReq struct {
 	Message *models.PushMessage `json:"message,omitempty"`
 	// 待发送的客户端 app id
 	AppIds []string `json:"app_ids"`
+	// (optional) 设备属性的筛选条件, 只会推送给满足条件的设备, 例如 app_version >= 3.2 AND locale IN (ja, zh)
+	Segment string `json:"segment,omitempty"`
+	// (optional) A/B 测试的消息变体, 设置时忽略 message; 用户按权重固定分配到其中一个变体, 所有变体的消息分类必须相同
+	Variants []models.MessageVariant `json:"variants,omitempty"`
+}
+
+// getCategory returns the category of message, all the variants are the same category
+func (r *PushMessageForAllSpecificClientReq) getCategory() string {
+	if len(r.Variants) > 0 {
+		return r.Variants[0].Message.Category
+	}
+	return r.Message.Category
 }
 
 type PushMessageForAllSpecificClientResp struct {
 	// 推送状态 1为成功
 	Status int `json:"status"`
-	// 本次推送的唯一标识符, 如果请求没有传递则会生成一个新的标识符返回
+	// 本次推送的唯一标识符, 如果请求没有传递则会生成一个新的标识符返回; 设置了 Idempotency-Key 时由 Idempotency-Key 生成, 重试的请求使用相同的标识符
 	ActionId string `json:"action_id"`
+	// 由于用户退订了消息分类而被过滤掉的设备数量
+	FilteredCount int `json:"filtered_count"`
 }
 
 // BatchPushMessageAsync godoc
@@ -100,11 +122,14 @@ type PushMessageForAllSpecificClientResp struct {
 // @Param message body BatchPushMessageReq true "请求体"
 // @Success 200 {object} api.ResponseEntry{data=handler.PushMessageForAllSpecificClientResp} "ok"
 // @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 409 {object} api.ResponseEntry "相同 action_id 或 Idempotency-Key 的请求正在处理"
+// @Failure 422 {object} api.ResponseEntry "action_id 或 Idempotency-Key 已被不同的请求体使用"
 // @Failure 500 {object} api.ResponseEntry "内部错误"
 // @Router /v1/batch_push_messages_async [post]
 func BatchPushMessageAsync(c *api.Context) api.ResponseOptions {
 	var req = new(BatchPushMessageReq)
 	var isSetGlobalMessage bool
+	auditEntry := c.Audit(audit.OperationPushBatch)
 
 	body, err := c.GetBody()
 	if err != nil {
@@ -120,11 +145,70 @@ func BatchPushMessageAsync(c *api.Context) api.ResponseOptions {
 		isSetGlobalMessage = !(req.GlobalMessage == nil)
 	}
 
-	if len(req.MessageItems) <= 0 {
+	auditEntry.MessageSummary = summarizeBatchMessages(req)
+	switch {
+	case req.AudienceId > 0:
+		auditEntry.Target = fmt.Sprintf("audience:%d", req.AudienceId)
+		if !isSetGlobalMessage {
+			return api.Error(http.StatusBadRequest, "global_message is required when audience_id is set")
+		}
+	case len(req.MessageItems) <= 0:
 		c.Logger.Warn("BatchPushMessageAsync: request push message items list is empty")
 		return api.Error(http.StatusBadRequest, "request push message items list is empty")
+	default:
+		appIds := make([]string, 0, 1)
+		seen := make(map[string]struct{}, 1)
+		for _, reqItem := range req.MessageItems {
+			if _, ok := seen[reqItem.AppId]; !ok && len(reqItem.AppId) > 0 {
+				seen[reqItem.AppId] = struct{}{}
+				appIds = append(appIds, reqItem.AppId)
+			}
+		}
+		auditEntry.AppIds = appIds
+		if resp := c.AuthorizeApps(appIds...); resp != nil {
+			return resp
+		}
 	}
 
+	idempotencyKey := getIdempotencyKey(c, req.ActionId)
+	// 如果请求中没有传递 action id 则会生成一个, 需要在消息入队之前生成, 消费者会依据 action id 对消息去重
+	req.ActionId = getActionId(c, req.ActionId)
+	auditEntry.ActionId = req.ActionId
+	c.WithLogFields(zap.String("action_id", req.ActionId))
+
+	return withIdempotency(c, idempotencyKey, body, func() api.ResponseOptions {
+		if req.AudienceId > 0 {
+			return pushMessageForAudience(c, req)
+		}
+		return batchPushMessageAsync(c, req, isSetGlobalMessage)
+	})
+}
+
+// summarizeBatchMessages returns the summary of the global message or the messages of items for audit log
+func summarizeBatchMessages(req *BatchPushMessageReq) string {
+	if req.GlobalMessage != nil {
+		return audit.SummarizeMessage(req.GlobalMessage, nil)
+	}
+	for i, item := range req.MessageItems {
+		if item.Message != nil {
+			if others := len(req.MessageItems) - i - 1; others > 0 {
+				return fmt.Sprintf("%s; and %d more items", audit.SummarizeMessage(item.Message, nil), others)
+			}
+			return audit.SummarizeMessage(item.Message, nil)
+		}
+	}
+	return ""
+}
+
+func batchPushMessageAsync(c *api.Context, req *BatchPushMessageReq, isSetGlobalMessage bool) api.ResponseOptions {
+	var (
+		enqueuedCount int64
+		filteredCount int
+	)
+	defer func() {
+		recordEnqueuedCount(c, req.ActionId, enqueuedCount)
+	}()
+
 	for _, reqItem := range req.MessageItems {
 		isItemValid := false
 		switch {
@@ -160,48 +244,49 @@ func BatchPushMessageAsync(c *api.Context) api.ResponseOptions {
 		default:
 			c.Logger.Error("BatchPushMessageAsync: unknown query condition")
 		}
+		if appIds := getScopedAppIds(c); appIds != nil {
+			// the user may have devices of other apps which the caller can not access
+			query.Where(userplatformtokens.AppIDIn(appIds...))
+		}
+		timer := metrics.NewTokenQueryTimer("batch_push")
 		tokens, err := query.All(c.Req.Context())
+		timer.ObserveDuration()
 		if err != nil {
 			reason := fmt.Sprintf("BatchPushMessage: failed to get the user's corresponding device token, err: %v", err)
 			c.Logger.Error(reason)
 			continue
 		}
 
-		for _, token := range tokens {
-			var message *models.PushMessage
-
-			if isSetGlobalMessage {
-				message = req.GlobalMessage.SetAppId(token.AppID).SetToken(token.Token)
-			} else {
-				message = reqItem.Message.SetAppId(token.AppID).SetToken(token.Token)
-			}
-			streamValues := message.ToRedisStreamValues(c, map[string]interface{}{
-				"app_id":    token.AppID,
-				"token":     token.Token,
-				"user_id":   token.UserID,
-				"action_id": req.ActionId,
-			})
-			err := c.Producer.Enqueue(&redisqueue.Message{
-				Stream: mq.PushMessageStreamKey,
-				Values: streamValues,
-			})
-			if err != nil {
-				c.Logger.Error("BatchPushMessageAsync: failed to enqueue message", zap.Error(err))
-				return api.Error(http.StatusInternalServerError, "failed to add push message to queue")
-			}
-			c.Logger.Info("BatchPushMessageAsync: add message to stream successfully")
+		var message *models.PushMessage
+		if isSetGlobalMessage {
+			message = req.GlobalMessage
+		} else {
+			message = reqItem.Message
 		}
 
-	}
+		userIds := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			userIds = append(userIds, token.UserID)
+		}
+		optedOutUsers, err := getOptedOutUsers(c, message.Category, nil, userIds)
+		if err != nil {
+			c.Logger.Error("BatchPushMessageAsync: failed to get opted out users", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to get user notification preferences")
+		}
 
-	// 如果请求中没有传递 action id 则会生成一个
-	if len(req.ActionId) <= 0 {
-		req.ActionId = uuid.NewString()
+		enqueued, filtered, err := enqueuePushMessages(c, message, nil, tokens, optedOutUsers, req.ActionId)
+		enqueuedCount += enqueued
+		filteredCount += filtered
+		if err != nil {
+			c.Logger.Error("BatchPushMessageAsync: failed to enqueue message", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to add push message to queue")
+		}
 	}
 
 	return api.Ok(PushMessageForAllSpecificClientResp{
-		Status:   1,
-		ActionId: req.ActionId,
+		Status:        1,
+		ActionId:      req.ActionId,
+		FilteredCount: filteredCount,
 	})
 }
 
@@ -215,10 +300,13 @@ func BatchPushMessageAsync(c *api.Context) api.ResponseOptions {
 // @Param message body PushMessageForAllSpecificClientReq true "请求体"
 // @Success 200 {object} api.ResponseEntry{data=PushMessageForAllSpecificClientResp} "ok"
 // @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 409 {object} api.ResponseEntry "相同 action_id 或 Idempotency-Key 的请求正在处理"
+// @Failure 422 {object} api.ResponseEntry "action_id 或 Idempotency-Key 已被不同的请求体使用"
 // @Failure 500 {object} api.ResponseEntry "内部错误"
 // @Router /v1/batch_push_messages [post]
 func PushMessageForAllSpecificClient(c *api.Context) api.ResponseOptions {
 	var req = new(PushMessageForAllSpecificClientReq)
+	auditEntry := c.Audit(audit.OperationPushBroadcast)
 	body, err := c.GetBody()
 	if err != nil {
 		c.Logger.Error("PushMessageForAllSpecificClient: failed to get body", zap.Error(err), zap.ByteString("body", body))
@@ -231,33 +319,52 @@ func PushMessageForAllSpecificClient(c *api.Context) api.ResponseOptions {
 		return api.ErrorWithOpts(http.StatusBadRequest, api.Message("failed to unmarshal request body"))
 	}
 
-	// we need convert []string to []interface{}
-	values := make([]interface{}, len(req.AppIds))
-	for i := range req.AppIds {
-		values[i] = req.AppIds[i]
+	auditEntry.AppIds = req.AppIds
+	auditEntry.MessageSummary = audit.SummarizeMessage(req.Message, req.Variants)
+	if len(req.Segment) > 0 {
+		auditEntry.Target = "segment:" + req.Segment
 	}
-	subQueryIdOrderByUpdateAt :=
-		sql.Select(
-			sql.As(sql.Distinct(userplatformtokens.FieldID), "id"),
-			userplatformtokens.FieldDeviceID,
-			userplatformtokens.FieldUpdatedAt,
-		).
-			From(sql.Table(userplatformtokens.Table)).
-			Where(sql.In(userplatformtokens.FieldAppID, values...)).
-			OrderBy(sql.Desc(userplatformtokens.FieldUpdatedAt)).
-			As("sub_query_id_order_by_update_at")
-
-	ids, err := c.Db.UserPlatformTokens.Query().
-		Where(func(s *sql.Selector) {
-			s.From(subQueryIdOrderByUpdateAt)
-		}).
-		Select(userplatformtokens.FieldID).
-		GroupBy(userplatformtokens.FieldID).
-		Ints(context.Background())
-	if err != nil {
-		return nil
+	switch {
+	case len(req.Variants) > 0:
+		if err = models.ValidateVariants(req.Variants); err != nil {
+			return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid variants: %v", err))
+		}
+	case req.Message == nil:
+		return api.Error(http.StatusBadRequest, "message or variants is required")
+	}
+	if resp := c.AuthorizeApps(req.AppIds...); resp != nil {
+		return resp
+	}
+
+	var seg *segment.Segment
+	if len(req.Segment) > 0 {
+		seg, err = segment.Parse(req.Segment)
+		if err != nil {
+			c.Logger.Warn("PushMessageForAllSpecificClient: invalid segment", zap.String("segment", req.Segment), zap.Error(err))
+			return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid segment: %v", err))
+		}
 	}
 
+	idempotencyKey := getIdempotencyKey(c, req.ActionId)
+	req.ActionId = getActionId(c, req.ActionId)
+	auditEntry.ActionId = req.ActionId
+	c.WithLogFields(zap.String("action_id", req.ActionId))
+
+	return withIdempotency(c, idempotencyKey, body, func() api.ResponseOptions {
+		return pushMessageForAllSpecificClient(c, req, seg)
+	})
+}
+
+func pushMessageForAllSpecificClient(c *api.Context, req *PushMessageForAllSpecificClientReq, seg *segment.Segment) api.ResponseOptions {
+	var (
+		enqueuedCount int64
+		filteredCount int
+	)
+	defer func() {
+		recordEnqueuedCount(c, req.ActionId, enqueuedCount)
+	}()
+
+	ids, filteredCount, err := queryBroadcastTokenIds(c, req.AppIds, seg, req.getCategory())
 	if err != nil {
 		c.Logger.Error("PushMessageForAllSpecificClient: failed to get user_platform_token record ids records by app id list",
 			zap.Strings("app_ids", req.AppIds),
@@ -268,27 +375,142 @@ func PushMessageForAllSpecificClient(c *api.Context) api.ResponseOptions {
 
 	platformTokens := batchQueryUserPlatformTokensById(c, ids)
 
-	for _, token := range platformTokens {
-		msg := req.Message.Clone().SetToken(token.Token).SetAppId(token.AppID)
-		streamValues := msg.ToRedisStreamValues(c, map[string]interface{}{
-			"app_id":    token.AppID,
-			"token":     token.Token,
-			"user_id":   token.UserID,
-			"action_id": req.ActionId,
-		})
-		err = c.Producer.Enqueue(&redisqueue.Message{
-			Stream: mq.PushMessageStreamKey,
-			Values: streamValues,
-		})
+	// the opted out users have been excluded by the query
+	enqueuedCount, _, err = enqueuePushMessages(c, req.Message, req.Variants, platformTokens, nil, req.ActionId)
+	if err != nil {
+		c.Logger.Error("PushMessageForAllSpecificClient: failed to enqueue message", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to add push message to queue")
+	}
+
+	return api.Ok(PushMessageForAllSpecificClientResp{
+		Status:        1,
+		ActionId:      req.ActionId,
+		FilteredCount: filteredCount,
+	})
+}
+
+// enqueuePushMessages adds the message for each device token to the push message stream,
+// the tokens of the users who have opted out the category of message are skipped and counted as filtered.
+// If variants are given, the message of the variant assigned to the user is sent instead.
+func enqueuePushMessages(c *api.Context, message *models.PushMessage, variants []models.MessageVariant, tokens []*ent.UserPlatformTokens, optedOutUsers map[string]struct{}, actionId string) (enqueued int64, filtered int, err error) {
+	appIds := make(map[string]struct{})
+	var variantCounts map[string]int64
+	if len(variants) > 0 {
+		variantCounts = make(map[string]int64, len(variants))
+		defer recordVariantEnqueuedCounts(c, actionId, variantCounts)
+	}
+	// the messages carry the context of the span, so that their consumer spans belong to the trace of request
+	spanCtx, span := tracing.StartProducerSpan(c, mq.PushMessageStreamKey, tracing.ActionIdKey.String(actionId))
+	defer func() {
+		span.SetAttributes(tracing.EnqueuedCountKey.Int64(enqueued))
+		tracing.EndSpan(span, err)
+	}()
+
+	for _, token := range tokens {
+		if isOptedOut(optedOutUsers, token) {
+			filtered++
+			continue
+		}
+
+		var msg *models.PushMessage
+		if len(variants) > 0 {
+			userId := token.UserID
+			if len(userId) <= 0 {
+				userId = token.Token
+			}
+			variant := models.AssignVariant(variants, actionId, userId)
+			msg = variant.Message.Clone().SetVariant(variant.Name)
+		} else {
+			msg = message.Clone()
+		}
+		if _, ok := appIds[token.AppID]; !ok {
+			if err = recordActionApps(c, actionId, token.AppID); err != nil {
+				return enqueued, filtered, err
+			}
+			appIds[token.AppID] = struct{}{}
+		}
+		err = enqueuePushMessage(c, spanCtx, msg.SetToken(token.Token).SetAppId(token.AppID), token, actionId)
 		if err != nil {
-			c.Logger.Error("PushMessageForAllSpecificClient: failed to enqueue message", zap.Error(err))
-			return api.Error(http.StatusInternalServerError, "failed to add push message to queue")
+			return enqueued, filtered, err
+		}
+		enqueued++
+		if variantCounts != nil {
+			variantCounts[msg.Variant]++
 		}
+	}
+	c.Logger.Debug("enqueuePushMessages: add messages to stream successfully", zap.Int64("enqueued", enqueued), zap.Int("filtered", filtered))
+	return enqueued, filtered, nil
+}
+
+// enqueuePushMessage adds the message for the device token to the push message stream, the trace context of spanCtx is carried by the message
+func enqueuePushMessage(c *api.Context, spanCtx context.Context, message *models.PushMessage, token *ent.UserPlatformTokens, actionId string) error {
+	// the tracking id is injected into the data of message, client apps report the open events with it
+	if len(message.TrackingId) <= 0 {
+		message.SetTrackingId(uuid.NewString())
+	}
+	streamValues := message.ToRedisStreamValues(c, map[string]interface{}{
+		"app_id":    token.AppID,
+		"token":     token.Token,
+		"user_id":   token.UserID,
+		"action_id": actionId,
+		"timezone":  token.Timezone,
+	})
+	if requestId := log.GetRequestId(c); len(requestId) > 0 {
+		streamValues["request_id"] = requestId
+	}
+	err := c.Producer.Enqueue(&redisqueue.Message{
+		Stream: mq.PushMessageStreamKey,
+		Values: tracing.InjectStreamValues(spanCtx, streamValues),
+	})
+	if err != nil {
+		return err
+	}
+	metrics.EnqueuedMessages.WithLabelValues(c.FullPath, token.AppID).Inc()
+	return nil
+}
 
-		c.Logger.Info("PushMessageForAllSpecificClient: add message to stream successfully")
+// getAudiencePredicate returns the predicate of the devices on the app id list which match the segment
+// queryBroadcastTokenIds returns the ids of device tokens of the broadcast, the tokens of the users who have opted out
+// the category are excluded by the query and counted as filtered, so the preferences are never loaded into memory.
+// A device only has one token for each app, so the ids are selected directly instead of grouping a sub query,
+// the selector of ent can not build the grouping query from a sub query.
+func queryBroadcastTokenIds(c *api.Context, appIds []string, seg *segment.Segment, category string) (ids []int, filtered int, err error) {
+	timer := metrics.NewTokenQueryTimer("broadcast_ids")
+	ids, err = c.Db.UserPlatformTokens.Query().
+		Where(func(s *sql.Selector) {
+			pred := getAudiencePredicate(appIds, seg)
+			if len(category) > 0 {
+				pred = sql.And(pred, sql.Not(optedOutPredicate(category, s.C)))
+			}
+			s.Where(pred)
+		}).
+		IDs(c)
+	timer.ObserveDuration()
+	if err != nil || len(category) <= 0 {
+		return ids, 0, err
 	}
 
-	return api.Ok(PushMessageForAllSpecificClientResp{Status: 1})
+	timer = metrics.NewTokenQueryTimer("broadcast_opted_out")
+	filtered, err = c.Db.UserPlatformTokens.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sql.And(getAudiencePredicate(appIds, seg), optedOutPredicate(category, s.C)))
+		}).
+		Count(c)
+	timer.ObserveDuration()
+	return ids, filtered, err
+}
+
+func getAudiencePredicate(appIds []string, seg *segment.Segment) *sql.Predicate {
+	// we need convert []string to []interface{}
+	values := make([]interface{}, len(appIds))
+	for i := range appIds {
+		values[i] = appIds[i]
+	}
+	pred := sql.In(userplatformtokens.FieldAppID, values...)
+	if seg != nil {
+		pred = sql.And(pred, seg.P(time.Now()))
+	}
+	return pred
 }
 
 func batchQueryUserPlatformTokensById(appCtx *api.Context, ids []int) []*ent.UserPlatformTokens {
@@ -334,6 +556,7 @@ func batchQueryUserPlatformTokensById(appCtx *api.Context, ids []int) []*ent.Use
 
 func queryUserPlatformTokenById(appCtx *api.Context, wg *sync.WaitGroup, id ...int) ([]*ent.UserPlatformTokens, error) {
 	defer wg.Done()
+	defer metrics.NewTokenQueryTimer("by_ids").ObserveDuration()
 
 	res, err := appCtx.Db.UserPlatformTokens.
 		Query().
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"strconv"
+)
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+// getPagination parses the offset and limit from query string
+func getPagination(c *api.Context) (offset, limit int, err error) {
+	query := c.Req.URL.Query()
+	limit = defaultPageLimit
+
+	if s := query.Get("offset"); len(s) > 0 {
+		offset, err = strconv.Atoi(s)
+		if err != nil || offset < 0 {
+			return 0, 0, fmt.Errorf("invalid offset %s", s)
+		}
+	}
+	if s := query.Get("limit"); len(s) > 0 {
+		limit, err = strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return 0, 0, fmt.Errorf("invalid limit %s", s)
+		}
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	return offset, limit, nil
+}
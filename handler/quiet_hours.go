@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/service"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type SetUserQuietHoursReq struct {
+	// 用户 id
+	UserId string `json:"user_id"`
+	// app id
+	AppId string `json:"app_id"`
+	// (optional, default: true) 是否开启免打扰, 设置为 false 时该用户将不受 app 免打扰时段的限制
+	Enabled *bool `json:"enabled,omitempty"`
+	// 免打扰开始时间, 格式为 HH:MM
+	Start string `json:"start"`
+	// 免打扰结束时间, 格式为 HH:MM; 早于开始时间时表示跨天
+	End string `json:"end"`
+	// (optional) IANA 时区名称, 为空时使用设备的时区
+	Timezone string `json:"timezone"`
+}
+
+func (r *SetUserQuietHoursReq) validate() error {
+	switch {
+	case len(r.UserId) <= 0:
+		return fmt.Errorf("user_id is required")
+	case len(r.AppId) <= 0:
+		return fmt.Errorf("app_id is required")
+	case r.Enabled != nil && !*r.Enabled:
+		// start and end are not required when the quiet hours are turned off
+	default:
+		if _, err := models.ParseClock(r.Start); err != nil {
+			return err
+		}
+		if _, err := models.ParseClock(r.End); err != nil {
+			return err
+		}
+	}
+	if _, err := models.LoadLocation(r.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", r.Timezone)
+	}
+	return nil
+}
+
+// SetUserQuietHours godoc
+// @Summary 设置用户的免打扰时段
+// @Description 设置用户在某个 app 的免打扰时段, 将覆盖 app 配置的免打扰时段; 设置会被缓存, 最长 30s 后在所有实例生效
+// @ID set-user-quiet-hours
+// @Tags quiet-hours
+// @Accept  json
+// @Produce  json
+// @Param message body SetUserQuietHoursReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.UserQuietHours} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/quiet_hours [put]
+func SetUserQuietHours(c *api.Context) api.ResponseOptions {
+	var req = new(SetUserQuietHoursReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("SetUserQuietHours: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("SetUserQuietHours: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	if resp := c.AuthorizeApps(req.AppId); resp != nil {
+		return resp
+	}
+
+	enabled := req.Enabled == nil || *req.Enabled
+	n, err := c.Db.UserQuietHours.Update().
+		Where(
+			userquiethours.UserID(req.UserId),
+			userquiethours.AppID(req.AppId),
+		).
+		SetEnabled(enabled).
+		SetStart(req.Start).
+		SetEnd(req.End).
+		SetTimezone(req.Timezone).
+		Save(c)
+	if err == nil && n <= 0 {
+		_, err = c.Db.UserQuietHours.Create().
+			SetUserID(req.UserId).
+			SetAppID(req.AppId).
+			SetEnabled(enabled).
+			SetStart(req.Start).
+			SetEnd(req.End).
+			SetTimezone(req.Timezone).
+			Save(c)
+	}
+	if err != nil {
+		c.Logger.Error("SetUserQuietHours: failed to save user quiet hours",
+			zap.String("user_id", req.UserId),
+			zap.String("app_id", req.AppId),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to save user quiet hours")
+	}
+	service.InvalidateUserQuietHours(req.AppId, req.UserId)
+
+	record, err := c.Db.UserQuietHours.Query().
+		Where(
+			userquiethours.UserID(req.UserId),
+			userquiethours.AppID(req.AppId),
+		).
+		Only(c)
+	if err != nil {
+		c.Logger.Error("SetUserQuietHours: failed to query user quiet hours", zap.String("user_id", req.UserId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query user quiet hours")
+	}
+
+	return api.Ok(record)
+}
+
+// GetUserQuietHours godoc
+// @Summary 获取用户的免打扰时段
+// @Description 获取用户在各个 app 的免打扰时段设置
+// @ID get-user-quiet-hours
+// @Tags quiet-hours
+// @Produce  json
+// @Param user_id query string true "用户 id"
+// @Param app_id query string false "app id, 为空时返回所有 app 的设置"
+// @Success 200 {object} api.ResponseEntry{data=[]ent.UserQuietHours} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/quiet_hours [get]
+func GetUserQuietHours(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	userId := query.Get("user_id")
+	if len(userId) <= 0 {
+		return api.Error(http.StatusBadRequest, "user_id is required")
+	}
+
+	q := c.Db.UserQuietHours.Query().Where(userquiethours.UserID(userId))
+	if appId := query.Get("app_id"); len(appId) > 0 {
+		if resp := c.AuthorizeApps(appId); resp != nil {
+			return resp
+		}
+		q.Where(userquiethours.AppID(appId))
+	} else if appIds := getScopedAppIds(c); appIds != nil {
+		q.Where(userquiethours.AppIDIn(appIds...))
+	}
+	records, err := q.Order(ent.Asc(userquiethours.FieldAppID)).All(c)
+	if err != nil {
+		c.Logger.Error("GetUserQuietHours: failed to query user quiet hours", zap.String("user_id", userId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query user quiet hours")
+	}
+
+	return api.Ok(records)
+}
+
+// DeleteUserQuietHours godoc
+// @Summary 删除用户的免打扰时段
+// @Description 删除用户的免打扰时段设置, 删除后将使用 app 配置的免打扰时段
+// @ID delete-user-quiet-hours
+// @Tags quiet-hours
+// @Produce  json
+// @Param user_id query string true "用户 id"
+// @Param app_id query string true "app id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/quiet_hours [delete]
+func DeleteUserQuietHours(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	userId, appId := query.Get("user_id"), query.Get("app_id")
+	if len(userId) <= 0 || len(appId) <= 0 {
+		return api.Error(http.StatusBadRequest, "user_id and app_id are required")
+	}
+	if resp := c.AuthorizeApps(appId); resp != nil {
+		return resp
+	}
+
+	_, err := c.Db.UserQuietHours.Delete().
+		Where(
+			userquiethours.UserID(userId),
+			userquiethours.AppID(appId),
+		).
+		Exec(c)
+	if err != nil {
+		c.Logger.Error("DeleteUserQuietHours: failed to delete user quiet hours",
+			zap.String("user_id", userId),
+			zap.String("app_id", appId),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to delete user quiet hours")
+	}
+	service.InvalidateUserQuietHours(appId, userId)
+
+	return api.Ok(nil)
+}
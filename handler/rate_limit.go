@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/limiter"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type GetRateLimitStateResp struct {
+	// 是否开启了限流
+	Enable bool `json:"enable"`
+	// 所有限流令牌桶的当前状态
+	Buckets []*limiter.State `json:"buckets"`
+}
+
+// GetRateLimitState godoc
+// @Summary 获取限流状态
+// @Description 获取按 app id 以及按推送服务提供方配置的所有限流令牌桶的当前状态
+// @ID get-rate-limit-state
+// @Tags rate-limit
+// @Produce  json
+// @Success 200 {object} api.ResponseEntry{data=handler.GetRateLimitStateResp} "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/rate_limits [get]
+func GetRateLimitState(c *api.Context) api.ResponseOptions {
+	conf := c.Config.Get().RateLimit
+	resp := GetRateLimitStateResp{
+		Enable:  conf.Enable,
+		Buckets: make([]*limiter.State, 0),
+	}
+
+	for _, bucket := range limiter.GetAllBuckets(conf) {
+		state, err := limiter.GetState(c, c.RedisClient, bucket)
+		if err != nil {
+			c.Logger.Error("GetRateLimitState: failed to get rate limit bucket state",
+				zap.String("key", bucket.Key()),
+				zap.Error(err),
+			)
+			return api.Error(http.StatusInternalServerError, "failed to get rate limit state")
+		}
+		resp.Buckets = append(resp.Buckets, state)
+	}
+
+	return api.Ok(resp)
+}
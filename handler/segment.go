@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/segment"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+type CountSegmentReq struct {
+	// 待发送的客户端 app id
+	AppIds []string `json:"app_ids"`
+	// (optional) 设备属性的筛选条件, 为空时统计 app 的所有设备
+	Segment string `json:"segment,omitempty"`
+}
+
+type CountSegmentResp struct {
+	// 满足条件的设备数量
+	Count int `json:"count"`
+}
+
+// CountSegment godoc
+// @Summary 统计满足筛选条件的设备数量
+// @Description 试运行全体推送的 segment 筛选条件, 返回将会推送的设备数量, 不会发送任何消息
+// @ID count-segment
+// @Tags segments
+// @Accept  json
+// @Produce  json
+// @Param message body CountSegmentReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=handler.CountSegmentResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/segments/count [post]
+func CountSegment(c *api.Context) api.ResponseOptions {
+	var req = new(CountSegmentReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("CountSegment: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("CountSegment: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if len(req.AppIds) <= 0 {
+		return api.Error(http.StatusBadRequest, "app_ids is required")
+	}
+	if resp := c.AuthorizeApps(req.AppIds...); resp != nil {
+		return resp
+	}
+
+	var seg *segment.Segment
+	if len(req.Segment) > 0 {
+		seg, err = segment.Parse(req.Segment)
+		if err != nil {
+			return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid segment: %v", err))
+		}
+	}
+
+	count, err := c.Db.UserPlatformTokens.Query().
+		Where(func(s *sql.Selector) {
+			s.Where(getAudiencePredicate(req.AppIds, seg))
+		}).
+		Count(c)
+	if err != nil {
+		c.Logger.Error("CountSegment: failed to count device tokens",
+			zap.Strings("app_ids", req.AppIds),
+			zap.String("segment", req.Segment),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to count device tokens")
+	}
+
+	return api.Ok(CountSegmentResp{Count: count})
+}
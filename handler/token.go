@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/userplatformtokens"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/segment"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+type RegisterTokenReq struct {
+	// 设备 token 类型, 1 为 FCM token, 2 为 APNs device token
+	Type uint8 `json:"type"`
+	// 用户 id
+	UserId string `json:"user_id"`
+	// 设备 id
+	DeviceId string `json:"device_id"`
+	// 设备 token
+	Token string `json:"token"`
+	// app id
+	AppId string `json:"app_id"`
+	// (optional) IANA 时区名称, 例如 Asia/Shanghai
+	Timezone string `json:"timezone"`
+	// (optional) app 版本, 例如 3.2.1
+	AppVersion string `json:"app_version"`
+	// (optional) 系统版本, 例如 15.4
+	OsVersion string `json:"os_version"`
+	// (optional) 设备语言, 例如 ja-JP
+	Locale string `json:"locale"`
+	// (optional) 国家代码, 例如 JP
+	Country string `json:"country"`
+	// (optional) 自定义标签
+	Tags []string `json:"tags"`
+}
+
+func (r *RegisterTokenReq) validate() error {
+	switch {
+	case int(r.Type) != models.FcmToken && int(r.Type) != models.AppleDeviceToken:
+		return fmt.Errorf("invalid type %d", r.Type)
+	case len(r.UserId) <= 0:
+		return fmt.Errorf("user_id is required")
+	case len(r.DeviceId) <= 0:
+		return fmt.Errorf("device_id is required")
+	case len(r.Token) <= 0:
+		return fmt.Errorf("token is required")
+	case len(r.AppId) <= 0:
+		return fmt.Errorf("app_id is required")
+	}
+	if _, err := models.LoadLocation(r.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q", r.Timezone)
+	}
+	return nil
+}
+
+// getVersionNum normalizes the version, nil is returned if the version is empty
+func getVersionNum(version string) (*int64, error) {
+	if len(version) <= 0 {
+		return nil, nil
+	}
+	num, err := segment.NormalizeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	return &num, nil
+}
+
+// RegisterToken godoc
+// @Summary 注册设备 token
+// @Description 注册或更新设备的 token 及设备属性, 同一个 app 的同一个设备只会保存一条记录; 设备属性可用于全体推送时的 segment 筛选; 移动端可以使用 Authorization: Bearer <用户 JWT> 调用, user_id 必须与 JWT 的 sub 一致, 设备之前注册给其他用户时 (例如切换账号登录) 将转移给当前用户
+// @ID register-token
+// @Tags tokens
+// @Accept  json
+// @Produce  json
+// @Param message body RegisterTokenReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.UserPlatformTokens} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/tokens [post]
+func RegisterToken(c *api.Context) api.ResponseOptions {
+	var req = new(RegisterTokenReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("RegisterToken: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("RegisterToken: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if err = req.validate(); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	if resp := c.AuthorizeApps(req.AppId); resp != nil {
+		return resp
+	}
+	if resp := c.AuthorizeUser(req.UserId); resp != nil {
+		return resp
+	}
+	appVersionNum, err := getVersionNum(req.AppVersion)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid app_version: %v", err))
+	}
+	osVersionNum, err := getVersionNum(req.OsVersion)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, fmt.Sprintf("invalid os_version: %v", err))
+	}
+
+	now := time.Now()
+	update := c.Db.UserPlatformTokens.Update().
+		Where(
+			userplatformtokens.AppID(req.AppId),
+			userplatformtokens.DeviceID(req.DeviceId),
+		).
+		SetType(req.Type).
+		SetUserID(req.UserId).
+		SetToken(req.Token).
+		SetTimezone(req.Timezone).
+		SetAppVersion(req.AppVersion).
+		SetOsVersion(req.OsVersion).
+		SetLocale(segment.NormalizeLocale(req.Locale)).
+		SetCountry(segment.NormalizeCountry(req.Country)).
+		SetTags(req.Tags).
+		SetLastActiveAt(now).
+		SetUpdatedAt(now)
+	if appVersionNum != nil {
+		update.SetAppVersionNum(*appVersionNum)
+	} else {
+		update.ClearAppVersionNum()
+	}
+	if osVersionNum != nil {
+		update.SetOsVersionNum(*osVersionNum)
+	} else {
+		update.ClearOsVersionNum()
+	}
+	n, err := update.Save(c)
+	if err == nil && n <= 0 {
+		_, err = c.Db.UserPlatformTokens.Create().
+			SetType(req.Type).
+			SetUserID(req.UserId).
+			SetDeviceID(req.DeviceId).
+			SetToken(req.Token).
+			SetAppID(req.AppId).
+			SetTimezone(req.Timezone).
+			SetAppVersion(req.AppVersion).
+			SetNillableAppVersionNum(appVersionNum).
+			SetOsVersion(req.OsVersion).
+			SetNillableOsVersionNum(osVersionNum).
+			SetLocale(segment.NormalizeLocale(req.Locale)).
+			SetCountry(segment.NormalizeCountry(req.Country)).
+			SetTags(req.Tags).
+			SetLastActiveAt(now).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Save(c)
+		if ent.IsConstraintError(err) {
+			// the device is registered by a concurrent request, update it instead
+			_, err = update.Save(c)
+		}
+	}
+	if err != nil {
+		c.Logger.Error("RegisterToken: failed to save device token",
+			zap.String("app_id", req.AppId),
+			zap.String("device_id", req.DeviceId),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to save device token")
+	}
+
+	record, err := c.Db.UserPlatformTokens.Query().
+		Where(
+			userplatformtokens.AppID(req.AppId),
+			userplatformtokens.DeviceID(req.DeviceId),
+		).
+		Order(ent.Desc(userplatformtokens.FieldUpdatedAt)).
+		First(c)
+	if err != nil {
+		c.Logger.Error("RegisterToken: failed to query device token", zap.String("device_id", req.DeviceId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query device token")
+	}
+
+	return api.Ok(record)
+}
+
+// DeleteToken godoc
+// @Summary 删除设备 token
+// @Description 删除设备在某个 app 注册的 token, 例如用户退出登录时调用; 使用用户 JWT 调用时只能删除该用户的 token
+// @ID delete-token
+// @Tags tokens
+// @Produce  json
+// @Param app_id query string true "app id"
+// @Param device_id query string true "设备 id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 403 {object} api.ResponseEntry "没有权限"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/tokens [delete]
+func DeleteToken(c *api.Context) api.ResponseOptions {
+	query := c.Req.URL.Query()
+	appId, deviceId := query.Get("app_id"), query.Get("device_id")
+	if len(appId) <= 0 || len(deviceId) <= 0 {
+		return api.Error(http.StatusBadRequest, "app_id and device_id are required")
+	}
+	if resp := c.AuthorizeApps(appId); resp != nil {
+		return resp
+	}
+
+	d := c.Db.UserPlatformTokens.Delete().
+		Where(
+			userplatformtokens.AppID(appId),
+			userplatformtokens.DeviceID(deviceId),
+		)
+	if c.Caller != nil && c.Caller.IsUser() {
+		// the users can only delete their own tokens
+		d.Where(userplatformtokens.UserID(c.Caller.UserId))
+	}
+	_, err := d.Exec(c)
+	if err != nil {
+		c.Logger.Error("DeleteToken: failed to delete device token",
+			zap.String("app_id", appId),
+			zap.String("device_id", deviceId),
+			zap.Error(err),
+		)
+		return api.Error(http.StatusInternalServerError, "failed to delete device token")
+	}
+
+	return api.Ok(nil)
+}
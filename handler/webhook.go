@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/webhookdelivery"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+	"github.com/shitamachi/push-service/webhook"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type CreateWebhookReq struct {
+	// app id
+	AppId string `json:"app_id"`
+	// 接收事件的 url, 事件以 POST 请求发送
+	Url string `json:"url"`
+	// 订阅的事件类型 delivery.succeeded/delivery.failed/token.invalidated/action.completed
+	Events []string `json:"events"`
+	// (optional) 签名密钥, 为空时自动生成
+	Secret string `json:"secret,omitempty"`
+}
+
+type CreateWebhookResp struct {
+	*ent.WebhookSubscription
+	// 签名密钥, 只在创建时返回
+	Secret string `json:"secret"`
+}
+
+type UpdateWebhookReq struct {
+	// (optional) 接收事件的 url
+	Url *string `json:"url,omitempty"`
+	// (optional) 订阅的事件类型
+	Events []string `json:"events,omitempty"`
+	// (optional) 是否启用
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type ListWebhookDeliveriesResp struct {
+	// 满足条件的投递记录总数
+	Total int `json:"total"`
+	// 投递记录列表
+	Deliveries []*ent.WebhookDelivery `json:"deliveries"`
+}
+
+func validateWebhookUrl(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) <= 0 {
+		return fmt.Errorf("invalid url %q", s)
+	}
+	return nil
+}
+
+func validateWebhookEvents(events []string) error {
+	if len(events) <= 0 {
+		return fmt.Errorf("events is required")
+	}
+	for _, event := range events {
+		if !webhook.IsValidEventType(event) {
+			return fmt.Errorf("unknown event %q", event)
+		}
+	}
+	return nil
+}
+
+// CreateWebhook godoc
+// @Summary 创建 webhook 订阅
+// @Description 订阅 app 的推送事件, 事件发生时将以 POST 请求发送到订阅的 url, 请求头 X-Push-Signature 为使用密钥对 <X-Push-Timestamp>.<body> 计算的 HMAC-SHA256 签名
+// @ID create-webhook
+// @Tags webhooks
+// @Accept  json
+// @Produce  json
+// @Param message body CreateWebhookReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=handler.CreateWebhookResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/webhooks [post]
+func CreateWebhook(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationWebhookCreate)
+	var req = new(CreateWebhookReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("CreateWebhook: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("CreateWebhook: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+	if len(req.AppId) <= 0 {
+		return api.Error(http.StatusBadRequest, "app_id is required")
+	}
+	auditEntry.AppIds = []string{req.AppId}
+	if resp := c.AuthorizeApps(req.AppId); resp != nil {
+		return resp
+	}
+	if err = validateWebhookUrl(req.Url); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+	if err = validateWebhookEvents(req.Events); err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	secret := req.Secret
+	if len(secret) <= 0 {
+		secret, err = webhook.GenerateSecret()
+		if err != nil {
+			c.Logger.Error("CreateWebhook: failed to generate secret", zap.Error(err))
+			return api.Error(http.StatusInternalServerError, "failed to generate secret")
+		}
+	}
+
+	record, err := c.Db.WebhookSubscription.Create().
+		SetAppID(req.AppId).
+		SetURL(req.Url).
+		SetEvents(req.Events).
+		SetSecret(secret).
+		Save(c)
+	if err != nil {
+		c.Logger.Error("CreateWebhook: failed to create webhook subscription", zap.String("app_id", req.AppId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to create webhook subscription")
+	}
+	webhook.InvalidateSubscriptions(req.AppId)
+	auditEntry.Target = fmt.Sprintf("webhook:%d", record.ID)
+
+	return api.Ok(CreateWebhookResp{
+		WebhookSubscription: record,
+		Secret:              secret,
+	})
+}
+
+// ListWebhooks godoc
+// @Summary 获取 webhook 订阅列表
+// @Description 获取 app 的 webhook 订阅列表
+// @ID list-webhooks
+// @Tags webhooks
+// @Produce  json
+// @Param app_id query string false "app id, 为空时返回所有 app 的订阅"
+// @Success 200 {object} api.ResponseEntry{data=[]ent.WebhookSubscription} "ok"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/webhooks [get]
+func ListWebhooks(c *api.Context) api.ResponseOptions {
+	q := c.Db.WebhookSubscription.Query()
+	if appId := c.Req.URL.Query().Get("app_id"); len(appId) > 0 {
+		if resp := c.AuthorizeApps(appId); resp != nil {
+			return resp
+		}
+		q.Where(webhooksubscription.AppID(appId))
+	} else if appIds := getScopedAppIds(c); appIds != nil {
+		q.Where(webhooksubscription.AppIDIn(appIds...))
+	}
+	records, err := q.Order(ent.Asc(webhooksubscription.FieldID)).All(c)
+	if err != nil {
+		c.Logger.Error("ListWebhooks: failed to query webhook subscriptions", zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to query webhook subscriptions")
+	}
+
+	return api.Ok(records)
+}
+
+// UpdateWebhook godoc
+// @Summary 更新 webhook 订阅
+// @Description 更新 webhook 订阅的 url, 事件类型或启用状态, 修改在 30s 内生效
+// @ID update-webhook
+// @Tags webhooks
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path int true "webhook 订阅 id"
+// @Param message body UpdateWebhookReq true "请求体"
+// @Success 200 {object} api.ResponseEntry{data=ent.WebhookSubscription} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "订阅不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/webhooks/{webhook_id} [put]
+func UpdateWebhook(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationWebhookUpdate)
+	auditEntry.Target = "webhook:" + c.Param("webhook_id")
+	subscription, errResp := getWebhookSubscription(c)
+	if errResp != nil {
+		return errResp
+	}
+	auditEntry.AppIds = []string{subscription.AppID}
+	var req = new(UpdateWebhookReq)
+	body, err := c.GetBody()
+	if err != nil {
+		c.Logger.Error("UpdateWebhook: get request body failed", zap.Error(err))
+		return api.ErrorWithOpts(http.StatusInternalServerError, api.Message("get request body failed"))
+	}
+	err = json.Unmarshal(body, req)
+	if err != nil {
+		c.Logger.Warn("UpdateWebhook: deserialize request body failed", zap.Error(err))
+		return api.Error(http.StatusBadRequest, "deserialize request body failed")
+	}
+
+	update := c.Db.WebhookSubscription.UpdateOne(subscription)
+	if req.Url != nil {
+		if err = validateWebhookUrl(*req.Url); err != nil {
+			return api.Error(http.StatusBadRequest, err.Error())
+		}
+		update.SetURL(*req.Url)
+	}
+	if req.Events != nil {
+		if err = validateWebhookEvents(req.Events); err != nil {
+			return api.Error(http.StatusBadRequest, err.Error())
+		}
+		update.SetEvents(req.Events)
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+	}
+	record, err := update.Save(c)
+	if err != nil {
+		c.Logger.Error("UpdateWebhook: failed to update webhook subscription", zap.Int("webhook_id", subscription.ID), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to update webhook subscription")
+	}
+	webhook.InvalidateSubscriptions(record.AppID)
+
+	return api.Ok(record)
+}
+
+// DeleteWebhook godoc
+// @Summary 删除 webhook 订阅
+// @Description 删除 webhook 订阅, 未完成的投递将不再发送
+// @ID delete-webhook
+// @Tags webhooks
+// @Produce  json
+// @Param webhook_id path int true "webhook 订阅 id"
+// @Success 200 {object} api.ResponseEntry "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/webhooks/{webhook_id} [delete]
+func DeleteWebhook(c *api.Context) api.ResponseOptions {
+	auditEntry := c.Audit(audit.OperationWebhookDelete)
+	auditEntry.Target = "webhook:" + c.Param("webhook_id")
+	webhookId, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		return api.Error(http.StatusBadRequest, "invalid webhook_id")
+	}
+
+	record, err := c.Db.WebhookSubscription.Get(c, webhookId)
+	if ent.IsNotFound(err) {
+		return api.Ok(nil)
+	}
+	if err == nil {
+		auditEntry.AppIds = []string{record.AppID}
+		if resp := c.AuthorizeApps(record.AppID); resp != nil {
+			return resp
+		}
+		err = c.Db.WebhookSubscription.DeleteOne(record).Exec(c)
+	}
+	if err != nil {
+		c.Logger.Error("DeleteWebhook: failed to delete webhook subscription", zap.Int("webhook_id", webhookId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to delete webhook subscription")
+	}
+	webhook.InvalidateSubscriptions(record.AppID)
+
+	return api.Ok(nil)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary 获取 webhook 投递记录
+// @Description 获取 webhook 订阅的投递记录, 按创建时间倒序排列
+// @ID list-webhook-deliveries
+// @Tags webhooks
+// @Produce  json
+// @Param webhook_id path int true "webhook 订阅 id"
+// @Param status query string false "投递状态 pending/retrying/succeeded/failed"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "返回的数量, 默认 100, 最大 1000"
+// @Success 200 {object} api.ResponseEntry{data=handler.ListWebhookDeliveriesResp} "ok"
+// @Failure 400 {object} api.ResponseEntry "参数错误"
+// @Failure 404 {object} api.ResponseEntry "订阅不存在"
+// @Failure 500 {object} api.ResponseEntry "内部错误"
+// @Router /v1/webhooks/{webhook_id}/deliveries [get]
+func ListWebhookDeliveries(c *api.Context) api.ResponseOptions {
+	subscription, errResp := getWebhookSubscription(c)
+	if errResp != nil {
+		return errResp
+	}
+	webhookId := subscription.ID
+	offset, limit, err := getPagination(c)
+	if err != nil {
+		return api.Error(http.StatusBadRequest, err.Error())
+	}
+
+	q := c.Db.WebhookDelivery.Query().Where(webhookdelivery.SubscriptionID(webhookId))
+	if status := c.Req.URL.Query().Get("status"); len(status) > 0 {
+		q.Where(webhookdelivery.Status(status))
+	}
+	total, err := q.Clone().Count(c)
+	if err != nil {
+		c.Logger.Error("ListWebhookDeliveries: failed to count webhook deliveries", zap.Int("webhook_id", webhookId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get webhook deliveries")
+	}
+	deliveries, err := q.
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(c)
+	if err != nil {
+		c.Logger.Error("ListWebhookDeliveries: failed to query webhook deliveries", zap.Int("webhook_id", webhookId), zap.Error(err))
+		return api.Error(http.StatusInternalServerError, "failed to get webhook deliveries")
+	}
+
+	return api.Ok(ListWebhookDeliveriesResp{
+		Total:      total,
+		Deliveries: deliveries,
+	})
+}
+
+// getWebhookSubscription gets the subscription by the webhook_id path parameter, the error response is returned if failed
+func getWebhookSubscription(c *api.Context) (*ent.WebhookSubscription, api.ResponseOptions) {
+	webhookId, err := strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		return nil, api.Error(http.StatusBadRequest, "invalid webhook_id")
+	}
+
+	record, err := c.Db.WebhookSubscription.Get(c, webhookId)
+	switch {
+	case ent.IsNotFound(err):
+		return nil, api.Error(http.StatusNotFound, "webhook subscription not found")
+	case err != nil:
+		c.Logger.Error("getWebhookSubscription: failed to query webhook subscription", zap.Int("webhook_id", webhookId), zap.Error(err))
+		return nil, api.Error(http.StatusInternalServerError, "failed to get webhook subscription")
+	}
+	if resp := c.AuthorizeApps(record.AppID); resp != nil {
+		return nil, resp
+	}
+	return record, nil
+}
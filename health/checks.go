@@ -0,0 +1,100 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/push"
+	"sort"
+)
+
+// MysqlCheck checks whether the database is reachable
+func MysqlCheck() CheckFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		return nil, db.Ping(ctx)
+	}
+}
+
+// RedisCheck checks whether the redis is reachable
+func RedisCheck(client *redis.Client) CheckFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		return nil, client.Ping(ctx).Err()
+	}
+}
+
+// ConsumerGroupCheck checks whether the consumer group has been created on each stream, the group is
+// created once the consumer starts running. The pending entries and consumers of group are reported.
+func ConsumerGroupCheck(client *redis.Client, group string, streams ...string) CheckFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		details := make(map[string]interface{}, len(streams))
+		for _, stream := range streams {
+			groups, err := client.XInfoGroups(ctx, stream).Result()
+			if err != nil {
+				return details, fmt.Errorf("failed to get consumer groups of stream %s: %w", stream, err)
+			}
+			var info *redis.XInfoGroup
+			for i := range groups {
+				if groups[i].Name == group {
+					info = &groups[i]
+					break
+				}
+			}
+			if info == nil {
+				return details, fmt.Errorf("consumer group %s of stream %s does not exist", group, stream)
+			}
+			details[stream] = map[string]interface{}{
+				"pending":   info.Pending,
+				"consumers": info.Consumers,
+			}
+		}
+		return details, nil
+	}
+}
+
+// ProviderClientCheck checks whether the push client of every configured app has been initialized
+func ProviderClientCheck(store *config.Store) CheckFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		conf := store.Get()
+		apple := make(map[string]struct{}, len(conf.ApplePushConfig.Items))
+		firebase := make(map[string]struct{}, len(conf.FirebasePushConfig.Items))
+		for bundleId := range conf.ApplePushConfig.Items {
+			apple[bundleId] = struct{}{}
+		}
+		for packageName := range conf.FirebasePushConfig.Items {
+			firebase[packageName] = struct{}{}
+		}
+		for appId, item := range conf.ClientConfig {
+			switch item.PushType {
+			case config_entries.ApplePush:
+				apple[appId] = struct{}{}
+			case config_entries.FirebasePush:
+				firebase[appId] = struct{}{}
+			}
+		}
+
+		var missing []string
+		for appId := range apple {
+			if !push.GlobalApplePushClient.HasClient(appId) {
+				missing = append(missing, appId)
+			}
+		}
+		for appId := range firebase {
+			if !push.GlobalFirebasePushClient.HasClient(appId) {
+				missing = append(missing, appId)
+			}
+		}
+		details := map[string]interface{}{
+			"apple":    len(apple),
+			"firebase": len(firebase),
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			details["missing"] = missing
+			return details, fmt.Errorf("push clients of %d apps are not initialized", len(missing))
+		}
+		return details, nil
+	}
+}
@@ -0,0 +1,138 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/atomic"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const defaultCheckTimeout = 3 * time.Second
+
+type Status string
+
+const (
+	StatusOk           Status = "ok"
+	StatusFail         Status = "fail"
+	StatusShuttingDown Status = "shutting_down"
+)
+
+// CheckFunc checks the component, the returned details are reported even if the check fails
+type CheckFunc func(ctx context.Context) (details map[string]interface{}, err error)
+
+type ComponentReport struct {
+	Status Status `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// the time taken to check the component
+	LatencyMs int64                  `json:"latency_ms"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+type Report struct {
+	Status     Status                      `json:"status"`
+	Components map[string]*ComponentReport `json:"components,omitempty"`
+}
+
+// Checker checks the dependencies of service for the readiness probe
+type Checker struct {
+	names        []string
+	checks       map[string]CheckFunc
+	timeout      time.Duration
+	shuttingDown atomic.Bool
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		checks:  make(map[string]CheckFunc),
+		timeout: defaultCheckTimeout,
+	}
+}
+
+// Register adds the check of component, it must be called before serving the probes
+func (c *Checker) Register(name string, check CheckFunc) {
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+		sort.Strings(c.names)
+	}
+	c.checks[name] = check
+}
+
+// SetShuttingDown makes the readiness probe fail, so that no more requests are routed to the instance
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check runs all the checks concurrently, the report fails if any check fails
+func (c *Checker) Check(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	report := &Report{
+		Status:     StatusOk,
+		Components: make(map[string]*ComponentReport, len(c.names)),
+	}
+	for _, name := range c.names {
+		wg.Add(1)
+		go func(name string, check CheckFunc) {
+			defer wg.Done()
+			start := time.Now()
+			details, err := check(ctx)
+			component := &ComponentReport{
+				Status:    StatusOk,
+				LatencyMs: time.Since(start).Milliseconds(),
+				Details:   details,
+			}
+			if err != nil {
+				component.Status = StatusFail
+				component.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[name] = component
+			if err != nil {
+				report.Status = StatusFail
+			}
+		}(name, c.checks[name])
+	}
+	wg.Wait()
+
+	if c.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+	}
+	return report
+}
+
+// Liveness serves the liveness probe, the process is alive as long as it can respond
+func (c *Checker) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, &Report{Status: StatusOk})
+}
+
+// Readiness serves the readiness probe with the status of each component
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+	code := http.StatusOK
+	if report.Status != StatusOk {
+		code = http.StatusServiceUnavailable
+	}
+	writeReport(w, code, report)
+}
+
+func writeReport(w http.ResponseWriter, code int, report *Report) {
+	bytes, err := json.Marshal(report)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	//goland:noinspection ALL
+	w.Write(bytes)
+}
@@ -0,0 +1,49 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChecker_Readiness(t *testing.T) {
+	checker := NewChecker()
+	checker.Register("ok", func(ctx context.Context) (map[string]interface{}, error) {
+		return map[string]interface{}{"pending": 1}, nil
+	})
+
+	readiness := func() (int, *Report) {
+		w := httptest.NewRecorder()
+		checker.Readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		var report Report
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		return w.Code, &report
+	}
+
+	code, report := readiness()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOk, report.Status)
+	assert.Equal(t, StatusOk, report.Components["ok"].Status)
+
+	checker.Register("failed", func(ctx context.Context) (map[string]interface{}, error) {
+		return nil, errors.New("connection refused")
+	})
+	code, report = readiness()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusFail, report.Status)
+	assert.Equal(t, StatusOk, report.Components["ok"].Status)
+	assert.Equal(t, "connection refused", report.Components["failed"].Error)
+
+	checker.SetShuttingDown()
+	code, report = readiness()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusShuttingDown, report.Status)
+
+	w := httptest.NewRecorder()
+	checker.Liveness(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,223 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	keyPrefix = "push_rate_limit"
+
+	ScopeApp      = "app"
+	ScopeProvider = "provider"
+)
+
+// takeScript checks every bucket in KEYS and only takes a token when all of them have one left,
+// so a message which is throttled by one bucket does not waste the tokens of the others.
+// ARGV holds the (rate, burst) pair of each bucket in the same order as KEYS.
+// It returns {allowed, wait}, wait is the milliseconds to wait before all buckets have a token again.
+var takeScript = redis.NewScript(`
+redis.replicate_commands()
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local tokens = {}
+local wait = 0
+for i = 1, #KEYS do
+    local rate = tonumber(ARGV[i * 2 - 1])
+    local burst = tonumber(ARGV[i * 2])
+    local bucket = redis.call('HMGET', KEYS[i], 'tokens', 'ts')
+    local current = tonumber(bucket[1])
+    local ts = tonumber(bucket[2])
+    if current == nil or ts == nil then
+        current = burst
+        ts = now
+    end
+    current = math.min(burst, current + math.max(0, now - ts) * rate / 1000)
+    tokens[i] = current
+    if current < 1 then
+        wait = math.max(wait, math.ceil((1 - current) * 1000 / rate))
+    end
+end
+local allowed = 0
+if wait == 0 then
+    allowed = 1
+end
+for i = 1, #KEYS do
+    local rate = tonumber(ARGV[i * 2 - 1])
+    local burst = tonumber(ARGV[i * 2])
+    local current = tokens[i]
+    if allowed == 1 then
+        current = current - 1
+    elseif current < 1 then
+        redis.call('HINCRBY', KEYS[i], 'throttled', 1)
+        redis.call('HSET', KEYS[i], 'last_throttled_at', now)
+    end
+    redis.call('HSET', KEYS[i], 'tokens', tostring(current), 'ts', now)
+    redis.call('PEXPIRE', KEYS[i], math.ceil(burst * 1000 / rate) * 2 + 60000)
+end
+return {allowed, wait}
+`)
+
+// Bucket is a token bucket stored in redis, it is shared by all the replicas of the service
+type Bucket struct {
+	// 限流维度 app/provider
+	Scope string `json:"scope"`
+	// app id 或 push type
+	ID string `json:"id"`
+	// 每秒产生的令牌数
+	Rate float64 `json:"rate"`
+	// 令牌桶容量
+	Burst int `json:"burst"`
+}
+
+// NewBucket converts the rate limit config item to a token bucket
+func NewBucket(scope, id string, item config_entries.RateLimitItem) (*Bucket, bool) {
+	if item.Limit <= 0 {
+		return nil, false
+	}
+	period := item.Period
+	if period <= 0 {
+		period = 1
+	}
+	burst := item.Burst
+	if burst <= 0 {
+		burst = item.Limit
+	}
+	return &Bucket{
+		Scope: scope,
+		ID:    id,
+		Rate:  float64(item.Limit) / float64(period),
+		Burst: burst,
+	}, true
+}
+
+func (b *Bucket) Key() string {
+	return fmt.Sprintf("%s:%s:%s", keyPrefix, b.Scope, b.ID)
+}
+
+type Result struct {
+	Allowed bool
+	// 被限流时需要等待的时长
+	RetryAfter time.Duration
+}
+
+// Take tries to take one token from every bucket at once
+func Take(ctx context.Context, client *redis.Client, buckets ...*Bucket) (*Result, error) {
+	if len(buckets) <= 0 {
+		return &Result{Allowed: true}, nil
+	}
+
+	keys := make([]string, 0, len(buckets))
+	args := make([]interface{}, 0, len(buckets)*2)
+	for _, b := range buckets {
+		keys = append(keys, b.Key())
+		args = append(args, strconv.FormatFloat(b.Rate, 'f', -1, 64), b.Burst)
+	}
+
+	res, err := takeScript.Run(ctx, client, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 2 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	return &Result{
+		Allowed:    res[0] == 1,
+		RetryAfter: time.Duration(res[1]) * time.Millisecond,
+	}, nil
+}
+
+type State struct {
+	*Bucket
+	// 当前桶内剩余的令牌数
+	Tokens float64 `json:"tokens"`
+	// 被限流的次数
+	ThrottledCount int64 `json:"throttled_count"`
+	// 最近一次被限流的时间
+	LastThrottledAt *time.Time `json:"last_throttled_at,omitempty"`
+	// 当前是否处于限流状态
+	Throttled bool `json:"throttled"`
+}
+
+// GetState reads the current state of the bucket without taking any token
+func GetState(ctx context.Context, client *redis.Client, bucket *Bucket) (*State, error) {
+	values, err := client.HMGet(ctx, bucket.Key(), "tokens", "ts", "throttled", "last_throttled_at").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{
+		Bucket: bucket,
+		Tokens: float64(bucket.Burst),
+	}
+	tokens, tokensOk := parseFloat(values[0])
+	ts, tsOk := parseFloat(values[1])
+	if tokensOk && tsOk {
+		elapsed := float64(time.Now().UnixMilli()) - ts
+		state.Tokens = math.Min(float64(bucket.Burst), tokens+math.Max(0, elapsed)*bucket.Rate/1000)
+	}
+	if throttled, ok := parseFloat(values[2]); ok {
+		state.ThrottledCount = int64(throttled)
+	}
+	if lastThrottledAt, ok := parseFloat(values[3]); ok {
+		t := time.UnixMilli(int64(lastThrottledAt))
+		state.LastThrottledAt = &t
+	}
+	state.Throttled = state.Tokens < 1
+
+	return state, nil
+}
+
+func parseFloat(v interface{}) (float64, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// GetBuckets returns the buckets which a message of the app should take a token from
+func GetBuckets(conf config_entries.RateLimitConfig, appID string, pushType config_entries.PushType) []*Bucket {
+	var buckets []*Bucket
+	if item, ok := conf.Apps[appID]; ok {
+		if b, ok := NewBucket(ScopeApp, appID, item); ok {
+			buckets = append(buckets, b)
+		}
+	}
+	if item, ok := conf.Providers[pushType]; ok {
+		if b, ok := NewBucket(ScopeProvider, string(pushType), item); ok {
+			buckets = append(buckets, b)
+		}
+	}
+	return buckets
+}
+
+// GetAllBuckets returns all the buckets in config
+func GetAllBuckets(conf config_entries.RateLimitConfig) []*Bucket {
+	var buckets []*Bucket
+	for appID, item := range conf.Apps {
+		if b, ok := NewBucket(ScopeApp, appID, item); ok {
+			buckets = append(buckets, b)
+		}
+	}
+	for pushType, item := range conf.Providers {
+		if b, ok := NewBucket(ScopeProvider, string(pushType), item); ok {
+			buckets = append(buckets, b)
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Key() < buckets[j].Key()
+	})
+	return buckets
+}
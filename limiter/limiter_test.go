@@ -0,0 +1,136 @@
+package limiter
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// newTestRedis starts a redis server whose clock only moves when advance is called
+func newTestRedis(t *testing.T) (advance func(d time.Duration), client *redis.Client) {
+	m := miniredis.RunT(t)
+	now := time.UnixMilli(1650000000000)
+	m.SetTime(now)
+	client = redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return func(d time.Duration) {
+		now = now.Add(d)
+		m.SetTime(now)
+	}, client
+}
+
+func TestNewBucket(t *testing.T) {
+	_, ok := NewBucket(ScopeApp, "app", config_entries.RateLimitItem{})
+	assert.False(t, ok)
+
+	b, ok := NewBucket(ScopeApp, "app", config_entries.RateLimitItem{Limit: 60, Period: 60})
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, b.Rate)
+	// the burst is the limit by default
+	assert.Equal(t, 60, b.Burst)
+	assert.Equal(t, "push_rate_limit:app:app", b.Key())
+}
+
+func TestTake(t *testing.T) {
+	advance, client := newTestRedis(t)
+	ctx := context.Background()
+	bucket := &Bucket{Scope: ScopeApp, ID: "app", Rate: 2, Burst: 3}
+
+	// the bucket is full at first, the burst is allowed at once
+	for i := 0; i < 3; i++ {
+		res, err := Take(ctx, client, bucket)
+		assert.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+	res, err := Take(ctx, client, bucket)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+	// one token is generated every 500ms
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	// half of a token is refilled
+	advance(250 * time.Millisecond)
+	res, err = Take(ctx, client, bucket)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 250*time.Millisecond, res.RetryAfter)
+
+	advance(250 * time.Millisecond)
+	res, err = Take(ctx, client, bucket)
+	assert.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// the refilled tokens never exceed the burst
+	advance(time.Minute)
+	for i := 0; i < 3; i++ {
+		res, err = Take(ctx, client, bucket)
+		assert.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+	res, err = Take(ctx, client, bucket)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	throttled, err := client.HGet(ctx, bucket.Key(), "throttled").Int()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, throttled)
+}
+
+func TestTakeMultipleBuckets(t *testing.T) {
+	advance, client := newTestRedis(t)
+	ctx := context.Background()
+	app := &Bucket{Scope: ScopeApp, ID: "app", Rate: 1, Burst: 1}
+	provider := &Bucket{Scope: ScopeProvider, ID: string(config_entries.ApplePush), Rate: 4, Burst: 2}
+
+	res, err := Take(ctx, client, app, provider)
+	assert.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// the app bucket is empty, the token of provider bucket is not taken
+	res, err = Take(ctx, client, app, provider)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	res, err = Take(ctx, client, provider)
+	assert.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// the wait is the longest one of the buckets
+	res, err = Take(ctx, client, provider)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 250*time.Millisecond, res.RetryAfter)
+	advance(100 * time.Millisecond)
+	res, err = Take(ctx, client, app, provider)
+	assert.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 900*time.Millisecond, res.RetryAfter)
+
+	advance(time.Second)
+	res, err = Take(ctx, client, app, provider)
+	assert.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestGetBuckets(t *testing.T) {
+	conf := config_entries.RateLimitConfig{
+		Apps: map[string]config_entries.RateLimitItem{
+			"app": {Limit: 10},
+		},
+		Providers: map[config_entries.PushType]config_entries.RateLimitItem{
+			config_entries.ApplePush: {Limit: 100, Burst: 200},
+		},
+	}
+	buckets := GetBuckets(conf, "app", config_entries.ApplePush)
+	assert.Len(t, buckets, 2)
+	assert.Equal(t, ScopeApp, buckets[0].Scope)
+	assert.Equal(t, 200, buckets[1].Burst)
+
+	assert.Empty(t, GetBuckets(conf, "other", config_entries.FirebasePush))
+}
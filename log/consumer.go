@@ -0,0 +1,33 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.uber.org/zap"
+)
+
+// messageLogFields are the values of stream message which are attached to the logger of message if present
+var messageLogFields = []string{"request_id", "action_id", "app_id", "user_id"}
+
+// ConsumerFunc wraps the consumer func of stream, so that every log of the message is attributed to it.
+// The logger of the message carries the stream, the message id and the known ids in the message values.
+func ConsumerFunc(stream string, f redisqueue.ConsumerFunc) redisqueue.ConsumerFunc {
+	return func(ctx context.Context, message *redisqueue.Message) error {
+		fields := make([]zap.Field, 0, len(messageLogFields)+2)
+		fields = append(fields, zap.String("stream", stream), zap.String("message_id", message.ID))
+		for _, key := range messageLogFields {
+			if v, ok := message.Values[key]; ok && v != nil {
+				if s := fmt.Sprint(v); len(s) > 0 {
+					fields = append(fields, zap.String(key, s))
+				}
+			}
+		}
+		ctx = SetLoggerToContext(ctx, WithCtx(ctx).With(fields...))
+		if requestId, ok := message.Values["request_id"].(string); ok && len(requestId) > 0 {
+			// the messages added by the consumer func carry the request id as well
+			ctx = SetRequestIdToContext(ctx, requestId)
+		}
+		return f(ctx, message)
+	}
+}
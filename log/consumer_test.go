@@ -0,0 +1,46 @@
+package log
+
+import (
+	"context"
+	"github.com/shitamachi/redisqueue/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"testing"
+)
+
+func TestConsumerFunc(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	ctx := SetLoggerToContext(context.Background(), zap.New(core))
+
+	var requestId string
+	f := ConsumerFunc("push_message", func(ctx context.Context, message *redisqueue.Message) error {
+		requestId = GetRequestId(ctx)
+		WithCtx(ctx).Info("processed")
+		return nil
+	})
+	err := f(ctx, &redisqueue.Message{
+		ID:     "1-0",
+		Stream: "push_message",
+		Values: map[string]interface{}{
+			"request_id": "req-1",
+			"action_id":  "action-1",
+			"app_id":     "app",
+			"user_id":    "",
+			"token":      "token",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "req-1", requestId)
+
+	entries := logs.All()
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, map[string]interface{}{
+			"stream":     "push_message",
+			"message_id": "1-0",
+			"request_id": "req-1",
+			"action_id":  "action-1",
+			"app_id":     "app",
+		}, entries[0].ContextMap())
+	}
+}
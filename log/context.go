@@ -13,6 +13,21 @@ func SetLoggerToContext(ctx context.Context, logger *zap.Logger) context.Context
 	return context.WithValue(ctx, key, logger)
 }
 
+type SetRequestIdToContextKey string
+
+var requestIdKey = SetRequestIdToContextKey("request_id")
+
+// SetRequestIdToContext stores the id of request, it is carried by the stream messages added when handling the request
+func SetRequestIdToContext(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey, requestId)
+}
+
+// GetRequestId returns the id of request, an empty string is returned if the context does not belong to any request
+func GetRequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey).(string)
+	return requestId
+}
+
 func WithCtx(ctx context.Context) *zap.Logger {
 	l := ctx.Value(key).(*zap.Logger)
 
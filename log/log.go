@@ -45,6 +45,13 @@ func InitLogger(config *config.AppConfig) (logger *zap.Logger, err error) {
 		}
 	}
 
+	if config.Loki.IsEnabled() {
+		lokiCore := newLokiCore(config.Loki)
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, lokiCore)
+		}))
+	}
+
 	return
 }
 
@@ -0,0 +1,261 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"go.uber.org/atomic"
+	"go.uber.org/zap/zapcore"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type lokiEntry struct {
+	time       time.Time
+	level      zapcore.Level
+	loggerName string
+	line       string
+}
+
+// lokiStream is the stream of loki push api, values are the [<unix epoch in nanoseconds>, <log line>] pairs
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+// lokiPusher batches the log entries and pushes them to loki in background, the entries are dropped
+// if the buffer is full, so that logging never blocks the caller
+type lokiPusher struct {
+	conf    config_entries.LokiConfig
+	client  *http.Client
+	entries chan *lokiEntry
+	flush   chan chan struct{}
+	dropped atomic.Int64
+	// errors of pushing are written to it since they can not be logged with the logger itself
+	errWriter io.Writer
+}
+
+func newLokiPusher(conf config_entries.LokiConfig) *lokiPusher {
+	p := &lokiPusher{
+		conf:      conf,
+		client:    &http.Client{Timeout: conf.GetTimeout()},
+		entries:   make(chan *lokiEntry, conf.GetBufferSize()),
+		flush:     make(chan chan struct{}),
+		errWriter: os.Stderr,
+	}
+	go p.run()
+	return p
+}
+
+func (p *lokiPusher) add(entry *lokiEntry) {
+	select {
+	case p.entries <- entry:
+	default:
+		p.dropped.Inc()
+	}
+}
+
+// sync pushes the buffered entries, it waits at most the push timeout
+func (p *lokiPusher) sync() {
+	done := make(chan struct{})
+	timer := time.NewTimer(p.conf.GetTimeout())
+	defer timer.Stop()
+	select {
+	case p.flush <- done:
+	case <-timer.C:
+		return
+	}
+	select {
+	case <-done:
+	case <-timer.C:
+	}
+}
+
+func (p *lokiPusher) run() {
+	batchSize := p.conf.GetBatchSize()
+	ticker := time.NewTicker(p.conf.GetFlushInterval())
+	defer ticker.Stop()
+
+	batch := make([]*lokiEntry, 0, batchSize)
+	push := func() {
+		if dropped := p.dropped.Swap(0); dropped > 0 {
+			batch = append(batch, &lokiEntry{
+				time:  time.Now(),
+				level: zapcore.WarnLevel,
+				line:  fmt.Sprintf(`{"level":"WARN","msg":"loki: %d log entries are dropped as the buffer is full"}`, dropped),
+			})
+		}
+		if len(batch) <= 0 {
+			return
+		}
+		if err := p.push(batch); err != nil {
+			//goland:noinspection ALL
+			fmt.Fprintf(p.errWriter, "loki: failed to push %d log entries: %v\n", len(batch), err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case entry := <-p.entries:
+			batch = append(batch, entry)
+			if len(batch) >= batchSize {
+				push()
+			}
+		case <-ticker.C:
+			push()
+		case done := <-p.flush:
+			// drain the entries which have been added before sync is called
+			for n := len(p.entries); n > 0; n-- {
+				batch = append(batch, <-p.entries)
+				if len(batch) >= batchSize {
+					push()
+				}
+			}
+			push()
+			close(done)
+		}
+	}
+}
+
+func (p *lokiPusher) push(batch []*lokiEntry) error {
+	body, err := json.Marshal(p.buildRequest(batch))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.conf.GetTimeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("loki responds %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// buildRequest groups the entries into streams by their labels, the entries of a stream keep their order
+func (p *lokiPusher) buildRequest(batch []*lokiEntry) *lokiPushRequest {
+	streams := make(map[string]*lokiStream)
+	var keys []string
+	for _, entry := range batch {
+		labels := p.labels(entry)
+		key := labelsKey(labels)
+		stream, ok := streams[key]
+		if !ok {
+			stream = &lokiStream{Stream: labels}
+			streams[key] = stream
+			keys = append(keys, key)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.time.UnixNano(), 10), entry.line})
+	}
+	req := &lokiPushRequest{Streams: make([]*lokiStream, 0, len(keys))}
+	for _, key := range keys {
+		req.Streams = append(req.Streams, streams[key])
+	}
+	return req
+}
+
+// labels returns the configured labels with the level and source of entry,
+// the source is suffixed with the name of logger to distinguish the loggers
+func (p *lokiPusher) labels(entry *lokiEntry) map[string]string {
+	labels := make(map[string]string, len(p.conf.Labels)+2)
+	for k, v := range p.conf.Labels {
+		labels[k] = v
+	}
+	labels[p.conf.GetLevelName()] = entry.level.String()
+	source := p.conf.GetSource()
+	if len(entry.loggerName) > 0 {
+		source += "-" + entry.loggerName
+	}
+	labels["source"] = source
+	return labels
+}
+
+func labelsKey(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// lokiCore is the zap core which encodes the entries and hands them to the loki pusher
+type lokiCore struct {
+	zapcore.LevelEnabler
+	encoder zapcore.Encoder
+	pusher  *lokiPusher
+}
+
+// newLokiCore returns the zap core which pushes the logs beyond the send level to loki
+func newLokiCore(conf config_entries.LokiConfig) zapcore.Core {
+	return &lokiCore{
+		LevelEnabler: zapcore.Level(conf.SendLevel),
+		encoder:      newReleaseEncoder(),
+		pusher:       newLokiPusher(conf),
+	}
+}
+
+func (c *lokiCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := &lokiCore{
+		LevelEnabler: c.LevelEnabler,
+		encoder:      c.encoder.Clone(),
+		pusher:       c.pusher,
+	}
+	for i := range fields {
+		fields[i].AddTo(clone.encoder)
+	}
+	return clone
+}
+
+func (c *lokiCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *lokiCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.encoder.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	line := strings.TrimSuffix(buf.String(), "\n")
+	buf.Free()
+	c.pusher.add(&lokiEntry{
+		time:       entry.Time,
+		level:      entry.Level,
+		loggerName: entry.LoggerName,
+		line:       line,
+	})
+	// the process may exit or panic after the entry is written
+	if entry.Level > zapcore.ErrorLevel {
+		c.pusher.sync()
+	}
+	return nil
+}
+
+func (c *lokiCore) Sync() error {
+	c.pusher.sync()
+	return nil
+}
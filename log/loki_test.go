@@ -0,0 +1,64 @@
+package log
+
+import (
+	"encoding/json"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestLokiCore(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []lokiPushRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var req lokiPushRequest
+		assert.NoError(t, json.Unmarshal(body, &req))
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	logger := zap.New(newLokiCore(config_entries.LokiConfig{
+		URL:       server.URL,
+		Source:    "push-service",
+		SendLevel: int8(zap.InfoLevel),
+		Labels:    map[string]string{"env": "test"},
+	}))
+	logger.Debug("not sent")
+	logger.Info("hello", zap.String("app_id", "app"))
+	logger.Named("consumer").Warn("world")
+	assert.NoError(t, logger.Sync())
+
+	mu.Lock()
+	defer mu.Unlock()
+	var streams []*lokiStream
+	for _, req := range requests {
+		streams = append(streams, req.Streams...)
+	}
+	assert.Len(t, streams, 2)
+	assert.Equal(t, map[string]string{"env": "test", "severity": "info", "source": "push-service"}, streams[0].Stream)
+	assert.Len(t, streams[0].Values, 1)
+	assert.Contains(t, streams[0].Values[0][1], `"app_id":"app"`)
+	assert.Equal(t, map[string]string{"env": "test", "severity": "warn", "source": "push-service-consumer"}, streams[1].Stream)
+}
+
+func TestLokiPusher_DropOnOverflow(t *testing.T) {
+	// the pusher is not running, so the buffer is never drained
+	p := &lokiPusher{entries: make(chan *lokiEntry, 1)}
+	p.add(&lokiEntry{line: "1"})
+	p.add(&lokiEntry{line: "2"})
+	p.add(&lokiEntry{line: "3"})
+	assert.Equal(t, int64(2), p.dropped.Load())
+	assert.Len(t, p.entries, 1)
+}
@@ -2,19 +2,25 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/audit"
 	"github.com/shitamachi/push-service/cache"
 	"github.com/shitamachi/push-service/config"
 	"github.com/shitamachi/push-service/db"
 	_ "github.com/shitamachi/push-service/docs" // docs is generated by Swag CLI, you have to import it.
 	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/health"
 	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
 	"github.com/shitamachi/push-service/mq"
 	"github.com/shitamachi/push-service/push"
 	"github.com/shitamachi/push-service/router"
 	"github.com/shitamachi/push-service/service"
+	"github.com/shitamachi/push-service/tracing"
 	"github.com/shitamachi/push-service/utils"
 	"github.com/shitamachi/redisqueue/v2"
 	"go.uber.org/zap"
@@ -40,13 +46,32 @@ import (
 // @host localhost
 // @BasePath /v1
 func main() {
+	configPath := flag.String("config", config.GetPathFromEnv(), "path of the config file, the fields can be overridden by "+config.EnvPrefix+"* environment variables")
+	flag.Parse()
+
 	ctx := context.Background()
 	// init config
-	appConfig := config.InitConfig()
+	appConfig, err := config.LoadConfig(*configPath)
+	if err != nil {
+		// the logger has not been initialized
+		fmt.Fprintf(os.Stderr, "failed to load config %s: %v\n", *configPath, err)
+		os.Exit(1)
+	}
 	//init logger
 	logger, err := log.InitLogger(appConfig)
 	utils.CheckErr(err)
 	ctx = log.SetLoggerToContext(ctx, logger)
+	// init tracer
+	shutdownTracer, err := tracing.InitTracer(ctx, appConfig.Tracing)
+	utils.CheckErr(err)
+	defer func() {
+		// flush the spans which have not been exported
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracer(ctx); err != nil {
+			logger.Error("failed to shutdown tracer", zap.Error(err))
+		}
+	}()
 	// init db
 	client := db.InitDB(appConfig)
 	defer func(client *ent.Client) {
@@ -62,9 +87,16 @@ func main() {
 	// init message producer
 	producer, err := mq.InitProducer(ctx, redisClient)
 	utils.CheckErr(err)
-	// init message consumer
+	// the config is reloaded on SIGHUP and file change, the push clients of changed apps are rebuilt
+	configStore := config.NewStore(*configPath, appConfig, logger)
+	configStore.OnReload(push.ReloadApplePush)
+	configStore.OnReload(push.ReloadFirebasePush)
+	configStore.SetAuditFunc(audit.WriteConfigReload)
+	appContext := api.NewAppContext(configStore, logger, redisClient, client, nil, producer)
+	// init message consumer, the app context is used as the context of consumer
+	// so that the consumer func can get config and other dependencies from it
 	consumer, err := mq.InitConsumer(
-		ctx,
+		appContext,
 		redisClient,
 		logger,
 		mq.PushMessageStreamKey,
@@ -72,26 +104,47 @@ func main() {
 		service.ProcessPushMessage,
 	)
 	utils.CheckErr(err)
+	consumer.Register(mq.WebhookDeliveryStreamKey, mq.InstrumentConsumerFunc(mq.WebhookDeliveryStreamKey, service.ProcessWebhookDelivery))
+	prometheus.MustRegister(metrics.NewStreamCollector(redisClient, logger, mq.PushMessageStreamKey, mq.WebhookDeliveryStreamKey))
+	appContext.Consumer = consumer
 
-	appContext := api.NewAppContext(appConfig, logger, redisClient, client, consumer, producer)
+	// init the dependency checks of readiness probe
+	checker := health.NewChecker()
+	checker.Register("mysql", health.MysqlCheck())
+	checker.Register("redis", health.RedisCheck(redisClient))
+	checker.Register("consumer_group", health.ConsumerGroupCheck(redisClient, mq.PushMessageGroupKey, mq.PushMessageStreamKey, mq.WebhookDeliveryStreamKey))
+	checker.Register("push_clients", health.ProviderClientCheck(configStore))
 
 	// init router
-	r := router.InitRouter(appConfig, appContext)
+	r := router.InitRouter(appConfig, appContext, checker)
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", appConfig.Port),
 		Handler: r,
 	}
 
 	// run consumer and server
-	run(logger, srv, consumer)
+	run(appContext, srv, consumer, checker)
 }
 
-func run(logger *zap.Logger, srv *http.Server, consumer *redisqueue.Consumer) {
+func run(appContext *api.AppContext, srv *http.Server, consumer *redisqueue.Consumer, checker *health.Checker) {
+	logger := appContext.Logger
 	go func() {
 		logger.Info("consumer message start")
 		consumer.Run()
 		logger.Info("consumer message stopped")
 	}()
+	watchCtx, stopWatch := context.WithCancel(appContext)
+	go func() {
+		logger.Info("config watcher start")
+		appContext.Config.Watch(watchCtx)
+		logger.Info("config watcher stopped")
+	}()
+	stopMover := make(chan struct{})
+	go func() {
+		logger.Info("delayed message mover start")
+		mq.RunDelayedMessageMover(appContext, appContext.RedisClient, logger, time.Second, appContext.Config.Get().Mq.DelayedMessageBatchSize, stopMover)
+		logger.Info("delayed message mover stopped")
+	}()
 	// Initializing the server in a goroutine so that
 	// it won't block the graceful shutdown handling below
 	go func() {
@@ -112,6 +165,14 @@ func run(logger *zap.Logger, srv *http.Server, consumer *redisqueue.Consumer) {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	logger.Info("Shutting down server...")
+	checker.SetShuttingDown()
+	close(stopMover)
+	stopWatch()
+	if delay := appContext.Config.Get().ShutdownDelay; delay > 0 {
+		// keep serving until the failed readiness probe removes the instance from the endpoints
+		logger.Info("wait before shutting down server", zap.Int("shutdown_delay", delay))
+		time.Sleep(time.Duration(delay) * time.Second)
+	}
 
 	// The context is used to inform the server it has 5 seconds to finish
 	// the request it is currently handling
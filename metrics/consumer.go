@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+	"github.com/shitamachi/redisqueue/v2"
+	"time"
+)
+
+// ConsumerFunc wraps the consumer func of stream to record the throughput and processing latency
+func ConsumerFunc(stream string, f redisqueue.ConsumerFunc) redisqueue.ConsumerFunc {
+	duration := MessageProcessingDuration.WithLabelValues(stream)
+	succeeded := ConsumedMessages.WithLabelValues(stream, "succeeded")
+	failed := ConsumedMessages.WithLabelValues(stream, "failed")
+	return func(ctx context.Context, message *redisqueue.Message) error {
+		start := time.Now()
+		err := f(ctx, message)
+		duration.Observe(time.Since(start).Seconds())
+		if err != nil {
+			failed.Inc()
+		} else {
+			succeeded.Inc()
+		}
+		return err
+	}
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/shitamachi/redisqueue/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestConsumerFunc(t *testing.T) {
+	const stream = "test_metrics_stream"
+	failedErr := errors.New("failed")
+	f := ConsumerFunc(stream, func(ctx context.Context, message *redisqueue.Message) error {
+		if message.ID == "failed" {
+			return failedErr
+		}
+		return nil
+	})
+
+	assert.NoError(t, f(context.Background(), &redisqueue.Message{ID: "ok"}))
+	assert.NoError(t, f(context.Background(), &redisqueue.Message{ID: "ok"}))
+	assert.ErrorIs(t, f(context.Background(), &redisqueue.Message{ID: "failed"}), failedErr)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(ConsumedMessages.WithLabelValues(stream, "succeeded")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(ConsumedMessages.WithLabelValues(stream, "failed")))
+}
@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "push_service"
+
+const (
+	ProviderApns = "apns"
+	ProviderFcm  = "fcm"
+
+	// ResponseCodeError is the code of provider response when the request failed without response
+	ResponseCodeError = "error"
+)
+
+var (
+	// EnqueuedMessages counts the messages added to the push message stream by the send endpoints
+	EnqueuedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "enqueued_messages_total",
+		Help:      "The number of push messages enqueued, partitioned by route and app.",
+	}, []string{"route", "app_id"})
+
+	// ConsumedMessages counts the messages processed by the consumer, result is succeeded or failed,
+	// the failed messages are reclaimed and processed again by the consumer
+	ConsumedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "consumed_messages_total",
+		Help:      "The number of stream messages processed by the consumer, partitioned by stream and result.",
+	}, []string{"stream", "result"})
+
+	MessageProcessingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "message_processing_duration_seconds",
+		Help:      "The time taken to process a stream message by the consumer.",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"stream"})
+
+	// PushRetries counts the retries of sending message to the provider after timeout
+	PushRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "push_retries_total",
+		Help:      "The number of retries of sending push message to the provider, partitioned by app.",
+	}, []string{"app_id"})
+
+	// ProviderResponses counts the responses of APNs and FCM, code is the http status code
+	// and reason is the APNs reason or FCM error code; code is "error" if no response is received
+	ProviderResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provider_responses_total",
+		Help:      "The number of responses of push providers, partitioned by provider, app, status code and reason.",
+	}, []string{"provider", "app_id", "code", "reason"})
+
+	TokenQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "token_query_duration_seconds",
+		Help:      "The time taken to look up device tokens in database, partitioned by query.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"query"})
+)
+
+// NewTokenQueryTimer returns the timer which observes the duration of device token query once ObserveDuration is called
+func NewTokenQueryTimer(query string) *prometheus.Timer {
+	return prometheus.NewTimer(TokenQueryDuration.WithLabelValues(query))
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"time"
+)
+
+const streamCollectTimeout = 3 * time.Second
+
+var (
+	streamLengthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "stream", "length"),
+		"The number of entries in the redis stream.",
+		[]string{"stream"}, nil,
+	)
+	streamPendingDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "stream", "pending_entries"),
+		"The number of entries delivered to the consumer group but not acknowledged yet.",
+		[]string{"stream", "group"}, nil,
+	)
+	streamConsumersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "stream", "consumers"),
+		"The number of consumers in the consumer group.",
+		[]string{"stream", "group"}, nil,
+	)
+)
+
+// StreamCollector reads the length of redis streams and the pending entries of their consumer groups when scraped
+type StreamCollector struct {
+	client  *redis.Client
+	logger  *zap.Logger
+	streams []string
+}
+
+func NewStreamCollector(client *redis.Client, logger *zap.Logger, streams ...string) *StreamCollector {
+	return &StreamCollector{client: client, logger: logger, streams: streams}
+}
+
+func (s *StreamCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- streamLengthDesc
+	ch <- streamPendingDesc
+	ch <- streamConsumersDesc
+}
+
+func (s *StreamCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), streamCollectTimeout)
+	defer cancel()
+
+	for _, stream := range s.streams {
+		length, err := s.client.XLen(ctx, stream).Result()
+		if err != nil {
+			s.logger.Warn("StreamCollector: failed to get stream length", zap.String("stream", stream), zap.Error(err))
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(streamLengthDesc, prometheus.GaugeValue, float64(length), stream)
+
+		groups, err := s.client.XInfoGroups(ctx, stream).Result()
+		if err != nil {
+			// the stream does not exist until the first message is added
+			if length > 0 {
+				s.logger.Warn("StreamCollector: failed to get consumer groups of stream", zap.String("stream", stream), zap.Error(err))
+			}
+			continue
+		}
+		for _, group := range groups {
+			ch <- prometheus.MustNewConstMetric(streamPendingDesc, prometheus.GaugeValue, float64(group.Pending), stream, group.Name)
+			ch <- prometheus.MustNewConstMetric(streamConsumersDesc, prometheus.GaugeValue, float64(group.Consumers), stream, group.Name)
+		}
+	}
+}
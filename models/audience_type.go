@@ -0,0 +1,10 @@
+package models
+
+type AudienceType = string
+
+const (
+	// 上传的用户列表
+	AudienceUsers AudienceType = "users"
+	// 保存的设备属性筛选条件
+	AudienceSegment AudienceType = "segment"
+)
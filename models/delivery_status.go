@@ -0,0 +1,18 @@
+package models
+
+type DeliveryStatus = string
+
+const (
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	DeliveryFailed    DeliveryStatus = "failed"
+	// 消息由于推送策略等原因未发送, 具体原因见 DeliveryReason
+	DeliverySuppressed DeliveryStatus = "suppressed"
+)
+
+type DeliveryReason = string
+
+const (
+	ReasonCapped    DeliveryReason = "capped"
+	ReasonCancelled DeliveryReason = "cancelled"
+	ReasonExpired   DeliveryReason = "expired"
+)
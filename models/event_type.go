@@ -0,0 +1,13 @@
+package models
+
+type EventType = string
+
+const (
+	// 用户点击打开了通知
+	EventOpened EventType = "opened"
+	// 用户忽略或清除了通知
+	EventDismissed EventType = "dismissed"
+)
+
+// TrackingIdKey 推送消息的 data 中 tracking id 的 key, 客户端上报事件时需要传递该值
+const TrackingIdKey = "tracking_id"
@@ -0,0 +1,10 @@
+package models
+
+type MessagePriority = string
+
+const (
+	// 普通消息, 受频率限制等推送策略的约束
+	NormalPriority MessagePriority = "normal"
+	// 事务类消息, 例如订单状态变更, 不受频率限制等推送策略的约束
+	TransactionalPriority MessagePriority = "transactional"
+)
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+type MessageVariant struct {
+	// 变体名称, 例如 A, B
+	Name string `json:"name"`
+	// 变体的权重, 用户按权重的比例分配到各个变体
+	Weight int `json:"weight"`
+	// 变体的推送消息
+	Message *PushMessage `json:"message"`
+}
+
+// ValidateVariants checks the variants of message, all the variants must be the same category
+// so that the user preferences and push policies apply to the variants equally.
+func ValidateVariants(variants []MessageVariant) error {
+	names := make(map[string]struct{}, len(variants))
+	for i, v := range variants {
+		switch {
+		case len(v.Name) <= 0:
+			return fmt.Errorf("name of variant %d is required", i)
+		case v.Weight <= 0:
+			return fmt.Errorf("weight of variant %s must be greater than 0", v.Name)
+		case v.Message == nil:
+			return fmt.Errorf("message of variant %s is required", v.Name)
+		case v.Message.Category != variants[0].Message.Category:
+			return fmt.Errorf("all the variants must be the same category")
+		}
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("duplicate variant %s", v.Name)
+		}
+		names[v.Name] = struct{}{}
+	}
+	return nil
+}
+
+// AssignVariant assigns the user to one of the variants by the hash of action id and user id,
+// so the user always gets the same variant within an action while different actions split users independently.
+func AssignVariant(variants []MessageVariant, actionId, userId string) *MessageVariant {
+	var total uint32
+	for _, v := range variants {
+		total += uint32(v.Weight)
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(actionId + ":" + userId))
+	n := h.Sum32() % total
+	for i := range variants {
+		if n < uint32(variants[i].Weight) {
+			return &variants[i]
+		}
+		n -= uint32(variants[i].Weight)
+	}
+	return &variants[len(variants)-1]
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAssignVariant(t *testing.T) {
+	variants := []MessageVariant{
+		{Name: "A", Weight: 3, Message: &PushMessage{}},
+		{Name: "B", Weight: 1, Message: &PushMessage{}},
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		userId := fmt.Sprintf("user-%d", i)
+		v := AssignVariant(variants, "action", userId)
+		// the same user always gets the same variant
+		assert.Equal(t, v, AssignVariant(variants, "action", userId))
+		counts[v.Name]++
+	}
+	// the users are split by the weights of variants
+	assert.InDelta(t, 7500, counts["A"], 300)
+	assert.InDelta(t, 2500, counts["B"], 300)
+}
+
+func TestValidateVariants(t *testing.T) {
+	assert.NoError(t, ValidateVariants([]MessageVariant{
+		{Name: "A", Weight: 1, Message: &PushMessage{}},
+		{Name: "B", Weight: 1, Message: &PushMessage{}},
+	}))
+	assert.Error(t, ValidateVariants([]MessageVariant{
+		{Name: "A", Weight: 1, Message: &PushMessage{}},
+		{Name: "A", Weight: 1, Message: &PushMessage{}},
+	}))
+	assert.Error(t, ValidateVariants([]MessageVariant{{Name: "A", Weight: 0, Message: &PushMessage{}}}))
+	assert.Error(t, ValidateVariants([]MessageVariant{{Name: "A", Weight: 1}}))
+	assert.Error(t, ValidateVariants([]MessageVariant{
+		{Name: "A", Weight: 1, Message: &PushMessage{BaseMessage: BaseMessage{Category: "promotions"}}},
+		{Name: "B", Weight: 1, Message: &PushMessage{BaseMessage: BaseMessage{Category: "news"}}},
+	}))
+}
@@ -5,13 +5,14 @@ import (
 	"encoding/json"
 	"firebase.google.com/go/v4/messaging"
 	"fmt"
-	"github.com/shitamachi/push-service/config"
 	"github.com/shitamachi/push-service/config/config_entries"
 	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/registry"
 	"github.com/shitamachi/push-service/utils"
 	"github.com/sideshow/apns2"
 	"github.com/sideshow/apns2/payload"
 	"go.uber.org/zap"
+	"time"
 )
 
 type BaseMessage struct {
@@ -24,6 +25,20 @@ type BaseMessage struct {
 	// bookId: 打开书籍详情所跳转的书籍 book id
 	// link: 打开网页所跳转的网页URL
 	Data map[string]string `json:"data" mapstructure:",remain"`
+	// (optional) 消息的过期时间, unix 时间戳, 单位 s; 过期的消息将被丢弃而不会再发送
+	ExpiresAt int64 `json:"expires_at,omitempty" mapstructure:"expires_at"`
+	// (optional) 消息的有效时长, 单位 s; 消息入队时将会依据 ttl 计算 expires_at, 同时设置了 expires_at 时以 expires_at 为准
+	TTL int64 `json:"ttl,omitempty" mapstructure:"-"`
+	// (optional) 消息分类, 例如 marketing, 用于频率限制等推送策略
+	Category string `json:"category,omitempty" mapstructure:"category"`
+	// (optional, default: normal) 消息优先级 normal/transactional, transactional 消息不受频率限制等推送策略的约束
+	Priority MessagePriority `json:"priority,omitempty" mapstructure:"priority"`
+	// A/B 测试时消息所属的变体名称, 由推送请求的 variants 分配
+	Variant string `json:"-" mapstructure:"variant"`
+	// (optional) 消息模板 id, 用于按模板统计打开率等数据
+	TemplateId string `json:"template_id,omitempty" mapstructure:"template_id"`
+	// 消息的追踪 id, 消息入队时生成并在发送时注入到 data 中
+	TrackingId string `json:"-" mapstructure:"tracking_id"`
 }
 
 type PushMessage struct {
@@ -71,20 +86,41 @@ func (m *PushMessage) SetData(data map[string]string) *PushMessage {
 	return m
 }
 
+func (m *PushMessage) SetExpiresAt(expiresAt int64) *PushMessage {
+	m.ExpiresAt = expiresAt
+	return m
+}
+
+func (m *PushMessage) SetVariant(variant string) *PushMessage {
+	m.Variant = variant
+	return m
+}
+
+func (m *PushMessage) SetTrackingId(trackingId string) *PushMessage {
+	m.TrackingId = trackingId
+	return m
+}
+
 func (m *PushMessage) SetBaseMessage(bs BaseMessage) *PushMessage {
 	m.Body = bs.Body
 	m.Title = bs.Title
 	m.Data = bs.Data
+	m.ExpiresAt = bs.ExpiresAt
+	m.TTL = bs.TTL
+	m.Category = bs.Category
+	m.Priority = bs.Priority
+	m.Variant = bs.Variant
+	m.TemplateId = bs.TemplateId
+	m.TrackingId = bs.TrackingId
 	return m
 }
 
 func (m *PushMessage) ConvertToPushPayload(ctx context.Context, appId string) interface{} {
-	conf := config.GetFromContext(ctx)
-	item, ok := conf.ClientConfig[appId]
+	pushType, ok := registry.GetPushType(ctx, appId)
 	if !ok {
 		return fmt.Errorf("unknown app id")
 	}
-	switch item.PushType {
+	switch pushType {
 	case config_entries.ApplePush:
 		return payload.NewPayload().AlertTitle(m.Title).AlertBody(m.Body)
 	case config_entries.FirebasePush:
@@ -104,25 +140,30 @@ func (m *PushMessage) Build(ctx context.Context) interface{} {
 		log.WithCtx(ctx).Error("PushMessage Build app id or token not set")
 		return nil
 	}
-	item, ok := config.GetFromContext(ctx).ClientConfig[m.appId]
+	pushType, ok := registry.GetPushType(ctx, m.appId)
 	if !ok {
 		log.WithCtx(ctx).Error("PushMessage Build unknown app id")
 		return nil
 	}
-	switch item.PushType {
+	switch pushType {
 	case config_entries.ApplePush:
 		content := payload.NewPayload().AlertTitle(m.Title).AlertBody(m.Body)
-		for k, v := range m.Data {
+		for k, v := range m.GetData() {
 			content.Custom(k, v)
 		}
-		return &apns2.Notification{
+		notification := &apns2.Notification{
 			DeviceToken: m.token,
 			Topic:       m.appId,
 			Payload:     content,
 		}
+		if expiresAt, ok := m.GetExpiresAt(); ok {
+			// apns-expiration, apns will stop trying to deliver the notification after this time
+			notification.Expiration = expiresAt
+		}
+		return notification
 	case config_entries.FirebasePush:
-		return &messaging.Message{
-			Data: m.Data,
+		msg := &messaging.Message{
+			Data: m.GetData(),
 			Notification: &messaging.Notification{
 				Title:    m.Title,
 				Body:     m.Body,
@@ -130,6 +171,14 @@ func (m *PushMessage) Build(ctx context.Context) interface{} {
 			},
 			Token: m.token,
 		}
+		if expiresAt, ok := m.GetExpiresAt(); ok {
+			ttl := time.Until(expiresAt)
+			if ttl < 0 {
+				ttl = 0
+			}
+			msg.Android = &messaging.AndroidConfig{TTL: &ttl}
+		}
+		return msg
 	default:
 		log.WithCtx(ctx).Error("PushMessage Build \t\tlog.Logger.Error(\"PushMessage Build \")\n")
 		return nil
@@ -158,9 +207,16 @@ func (m *PushMessage) Clone() *PushMessage {
 		appId: m.appId,
 		token: m.token,
 		BaseMessage: BaseMessage{
-			Title: m.Title,
-			Body:  m.Body,
-			Data:  m.Data,
+			Title:      m.Title,
+			Body:       m.Body,
+			Data:       m.Data,
+			ExpiresAt:  m.ExpiresAt,
+			TTL:        m.TTL,
+			Category:   m.Category,
+			Priority:   m.Priority,
+			Variant:    m.Variant,
+			TemplateId: m.TemplateId,
+			TrackingId: m.TrackingId,
 		},
 	}
 }
@@ -174,13 +230,56 @@ func (m *PushMessage) ToMap() map[string]interface{} {
 }
 
 func (m *PushMessage) ToRedisStreamValues(ctx context.Context, other map[string]interface{}) map[string]interface{} {
+	var expiresAt int64
+	if t, ok := m.GetExpiresAt(); ok {
+		expiresAt = t.Unix()
+	}
 	return utils.MergeMap(map[string]interface{}{
-		"title": m.Title,
-		"body":  m.Body,
-		"data":  m.BaseMessage.EncodeData(ctx),
+		"title":       m.Title,
+		"body":        m.Body,
+		"data":        m.BaseMessage.EncodeData(ctx),
+		"expires_at":  expiresAt,
+		"category":    m.Category,
+		"priority":    m.Priority,
+		"variant":     m.Variant,
+		"template_id": m.TemplateId,
+		"tracking_id": m.TrackingId,
 	}, other)
 }
 
+// GetExpiresAt returns the expiration time of message, if only ttl is set it is counted from now
+func (bm *BaseMessage) GetExpiresAt() (time.Time, bool) {
+	switch {
+	case bm.ExpiresAt > 0:
+		return time.Unix(bm.ExpiresAt, 0), true
+	case bm.TTL > 0:
+		return time.Now().Add(time.Duration(bm.TTL) * time.Second), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func (bm *BaseMessage) IsExpired(now time.Time) bool {
+	return bm.ExpiresAt > 0 && now.Unix() >= bm.ExpiresAt
+}
+
+// GetData returns the data sent to the device, the tracking id is injected if it is set
+func (bm *BaseMessage) GetData() map[string]string {
+	if len(bm.TrackingId) <= 0 {
+		return bm.Data
+	}
+	data := make(map[string]string, len(bm.Data)+1)
+	for k, v := range bm.Data {
+		data[k] = v
+	}
+	data[TrackingIdKey] = bm.TrackingId
+	return data
+}
+
+func (bm *BaseMessage) IsTransactional() bool {
+	return bm.Priority == TransactionalPriority
+}
+
 func (bm *BaseMessage) EncodeData(ctx context.Context) string {
 	bytes, err := json.Marshal(bm.Data)
 	if err != nil {
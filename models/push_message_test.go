@@ -0,0 +1,108 @@
+package models
+
+import (
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/log"
+	"github.com/sideshow/apns2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPushMessage_ExpiresAtOfStreamValues(t *testing.T) {
+	ctx := log.SetLoggerToContext(context.Background(), zap.NewNop())
+	now := time.Now().Unix()
+	tests := []struct {
+		name    string
+		message BaseMessage
+		// the expected expires_at relative to now, 0 means never expires
+		want int64
+	}{
+		{name: "no expiration", message: BaseMessage{}},
+		{name: "ttl", message: BaseMessage{TTL: 60}, want: 60},
+		{name: "expires at", message: BaseMessage{ExpiresAt: now + 30}, want: 30},
+		{name: "expires at takes precedence over ttl", message: BaseMessage{ExpiresAt: now + 30, TTL: 60}, want: 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &PushMessage{BaseMessage: tt.message}
+			expiresAt := m.ToRedisStreamValues(ctx, nil)["expires_at"].(int64)
+			if tt.want <= 0 {
+				assert.Zero(t, expiresAt)
+				return
+			}
+			// the ttl is counted from the time of enqueue
+			assert.InDelta(t, now+tt.want, expiresAt, 1)
+		})
+	}
+}
+
+func TestBaseMessage_IsExpired(t *testing.T) {
+	now := time.Unix(1650000000, 0)
+	tests := []struct {
+		name      string
+		expiresAt int64
+		want      bool
+	}{
+		{name: "never expires", expiresAt: 0},
+		{name: "not expired", expiresAt: now.Unix() + 1},
+		{name: "expires now", expiresAt: now.Unix(), want: true},
+		{name: "expired", expiresAt: now.Unix() - 1, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &BaseMessage{ExpiresAt: tt.expiresAt}
+			assert.Equal(t, tt.want, m.IsExpired(now))
+		})
+	}
+}
+
+func TestPushMessage_BuildExpiration(t *testing.T) {
+	ctx := log.SetLoggerToContext(context.Background(), zap.NewNop())
+	ctx = config.SetToContext(ctx, &config.AppConfig{
+		ClientConfig: map[string]config_entries.ClientConfigItem{
+			"ios":     {PushType: config_entries.ApplePush},
+			"android": {PushType: config_entries.FirebasePush},
+		},
+	})
+	now := time.Now()
+	tests := []struct {
+		name    string
+		message BaseMessage
+		// the expected expiration relative to now, nil means it is not set
+		want *time.Duration
+	}{
+		{name: "no expiration", message: BaseMessage{}},
+		{name: "ttl", message: BaseMessage{TTL: 60}, want: durationPtr(time.Minute)},
+		{name: "expires at", message: BaseMessage{ExpiresAt: now.Add(time.Hour).Unix()}, want: durationPtr(time.Hour)},
+		{name: "expired", message: BaseMessage{ExpiresAt: now.Add(-time.Hour).Unix()}, want: durationPtr(-time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := (&PushMessage{appId: "ios", token: "token", BaseMessage: tt.message}).Build(ctx).(*apns2.Notification)
+			msg := (&PushMessage{appId: "android", token: "token", BaseMessage: tt.message}).Build(ctx).(*messaging.Message)
+			if tt.want == nil {
+				assert.True(t, notification.Expiration.IsZero())
+				assert.Nil(t, msg.Android)
+				return
+			}
+
+			// apns-expiration is the absolute time, the expired one is passed as it is
+			assert.WithinDuration(t, now.Add(*tt.want), notification.Expiration, time.Second)
+			// the android ttl is the remaining time, it is 0 for the expired message
+			expected := *tt.want
+			if expected < 0 {
+				expected = 0
+			}
+			assert.InDelta(t, expected, *msg.Android.TTL, float64(time.Second))
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// QuietHours is a daily do-not-disturb window in a time zone
+type QuietHours struct {
+	// 开始时间 HH:MM
+	Start string
+	// 结束时间 HH:MM, 早于开始时间时表示跨天
+	End      string
+	Location *time.Location
+}
+
+// ParseClock parses the HH:MM string to the minutes since midnight
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid clock %q, should be HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// LoadLocation loads the time zone by IANA name, the first non-empty name is used and UTC is the default one
+func LoadLocation(names ...string) (*time.Location, error) {
+	for _, name := range names {
+		if len(name) > 0 {
+			return time.LoadLocation(name)
+		}
+	}
+	return time.UTC, nil
+}
+
+// GetEnd returns the end of the window if now is within the quiet hours
+func (q *QuietHours) GetEnd(now time.Time) (time.Time, bool, error) {
+	start, err := ParseClock(q.Start)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	end, err := ParseClock(q.End)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if start == end {
+		return time.Time{}, false, nil
+	}
+
+	loc := q.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	current := local.Hour()*60 + local.Minute()
+	endOfToday := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, loc)
+
+	switch {
+	case start < end && current >= start && current < end:
+		return endOfToday, true, nil
+	case start > end && current >= start:
+		// the window crosses midnight and ends tomorrow
+		return endOfToday.AddDate(0, 0, 1), true, nil
+	case start > end && current < end:
+		return endOfToday, true, nil
+	default:
+		return time.Time{}, false, nil
+	}
+}
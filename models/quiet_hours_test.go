@@ -0,0 +1,81 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestQuietHours_GetEnd(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		q       QuietHours
+		now     time.Time
+		want    time.Time
+		wantIn  bool
+		wantErr bool
+	}{
+		{
+			name:   "overnight window before midnight",
+			q:      QuietHours{Start: "22:00", End: "08:00", Location: shanghai},
+			now:    time.Date(2022, 6, 1, 23, 30, 0, 0, shanghai),
+			want:   time.Date(2022, 6, 2, 8, 0, 0, 0, shanghai),
+			wantIn: true,
+		},
+		{
+			name:   "overnight window after midnight",
+			q:      QuietHours{Start: "22:00", End: "08:00", Location: shanghai},
+			now:    time.Date(2022, 6, 2, 7, 59, 0, 0, shanghai),
+			want:   time.Date(2022, 6, 2, 8, 0, 0, 0, shanghai),
+			wantIn: true,
+		},
+		{
+			name:   "overnight window not in",
+			q:      QuietHours{Start: "22:00", End: "08:00", Location: shanghai},
+			now:    time.Date(2022, 6, 2, 8, 0, 0, 0, shanghai),
+			wantIn: false,
+		},
+		{
+			name:   "window within a day",
+			q:      QuietHours{Start: "12:00", End: "14:00", Location: shanghai},
+			now:    time.Date(2022, 6, 2, 13, 0, 0, 0, shanghai),
+			want:   time.Date(2022, 6, 2, 14, 0, 0, 0, shanghai),
+			wantIn: true,
+		},
+		{
+			name:   "now is converted to the time zone of window",
+			q:      QuietHours{Start: "22:00", End: "08:00", Location: shanghai},
+			now:    time.Date(2022, 6, 1, 15, 0, 0, 0, time.UTC),
+			want:   time.Date(2022, 6, 2, 8, 0, 0, 0, shanghai),
+			wantIn: true,
+		},
+		{
+			name:   "empty window",
+			q:      QuietHours{Start: "08:00", End: "08:00"},
+			now:    time.Date(2022, 6, 2, 8, 0, 0, 0, time.UTC),
+			wantIn: false,
+		},
+		{
+			name:    "invalid clock",
+			q:       QuietHours{Start: "25:00", End: "08:00"},
+			now:     time.Now(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, in, err := tt.q.GetEnd(tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetEnd() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.wantIn, in)
+			if tt.wantIn {
+				assert.True(t, tt.want.Equal(got), "GetEnd() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,11 +3,21 @@ package mq
 import (
 	"context"
 	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
+	"github.com/shitamachi/push-service/tracing"
 	"github.com/shitamachi/redisqueue/v2"
 	"go.uber.org/zap"
 	"time"
 )
 
+const (
+	// the pending message is reclaimed by another consumer if it is not acked within the visibility timeout
+	VisibilityTimeout = 10 * time.Second
+	// the pending message is acked without processing after it is reclaimed more than the times
+	ReclaimMaxRetryCount = 5
+)
+
 func InitConsumer(
 	ctx context.Context,
 	redisClient *redis.Client,
@@ -18,10 +28,10 @@ func InitConsumer(
 	c, err := redisqueue.NewConsumerWithOptions(&redisqueue.ConsumerOptions{
 		Ctx:                  ctx,
 		GroupName:            group,
-		VisibilityTimeout:    10 * time.Second,
+		VisibilityTimeout:    VisibilityTimeout,
 		BlockingTimeout:      3 * time.Second,
 		ReclaimInterval:      5 * time.Second,
-		ReclaimMaxRetryCount: 5,
+		ReclaimMaxRetryCount: ReclaimMaxRetryCount,
 		BufferSize:           100,
 		Concurrency:          10,
 		RedisClient:          redisClient,
@@ -30,7 +40,7 @@ func InitConsumer(
 		return c, err
 	}
 
-	c.Register(stream, consumerFunc)
+	c.Register(stream, InstrumentConsumerFunc(stream, consumerFunc))
 
 	go func() {
 		for err := range c.Errors {
@@ -41,3 +51,28 @@ func InitConsumer(
 
 	return c, err
 }
+
+// IsLastDelivery reports whether the message will not be reclaimed again if it fails this time, that is
+// it has been delivered more than ReclaimMaxRetryCount times or it is not pending any more
+func IsLastDelivery(ctx context.Context, redisClient *redis.Client, group string, message *redisqueue.Message) (bool, error) {
+	res, err := redisClient.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: message.Stream,
+		Group:  group,
+		Start:  message.ID,
+		End:    message.ID,
+		Count:  1,
+	}).Result()
+	if err != nil {
+		return false, err
+	}
+	if len(res) <= 0 {
+		return true, nil
+	}
+	return res[0].RetryCount > ReclaimMaxRetryCount, nil
+}
+
+// InstrumentConsumerFunc wraps the consumer func of stream to record the metrics, process each message
+// in a span and attribute the logs of message to it
+func InstrumentConsumerFunc(stream string, f redisqueue.ConsumerFunc) redisqueue.ConsumerFunc {
+	return tracing.ConsumerFunc(stream, log.ConsumerFunc(stream, metrics.ConsumerFunc(stream, f)))
+}
@@ -3,4 +3,8 @@ package mq
 const (
 	PushMessageStreamKey = "push_message_stream"
 	PushMessageGroupKey  = "push_message_group"
+	// 延迟投递的消息所在的 sorted set, score 为消息可以重新投递的时间戳(ms)
+	DelayedMessageKey = "push_message_delayed"
+	// webhook 投递任务所在的 stream, 每条消息对应一条 WebhookDelivery 记录
+	WebhookDeliveryStreamKey = "webhook_delivery_stream"
 )
@@ -0,0 +1,105 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+)
+
+// moveDueScript pops the due messages from the delayed sorted set and adds them back to their streams
+// in a single step, so messages will not be lost or duplicated when several replicas run the mover.
+// ARGV[1] is the max number of messages to move. The streams are not trimmed here, otherwise a burst of due messages
+// could trim the unconsumed messages of stream.
+var moveDueScript = redis.NewScript(`
+redis.replicate_commands()
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local members = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', now, 'LIMIT', 0, tonumber(ARGV[1]))
+for _, member in ipairs(members) do
+    local m = cjson.decode(member)
+    local args = {'XADD', m['stream'], '*'}
+    for k, v in pairs(m['values']) do
+        table.insert(args, k)
+        table.insert(args, tostring(v))
+    end
+    redis.call(unpack(args))
+    redis.call('ZREM', KEYS[1], member)
+end
+return #members
+`)
+
+type delayedMessage struct {
+	// make every member of the sorted set unique
+	ID     string                 `json:"id"`
+	Stream string                 `json:"stream"`
+	Values map[string]interface{} `json:"values"`
+}
+
+// Delay stores the message values and enqueues them to the stream again once the time is reached
+func Delay(ctx context.Context, client *redis.Client, stream string, values map[string]interface{}, at time.Time) error {
+	member, err := json.Marshal(&delayedMessage{
+		ID:     uuid.NewString(),
+		Stream: stream,
+		Values: values,
+	})
+	if err != nil {
+		return err
+	}
+	return client.ZAdd(ctx, DelayedMessageKey, &redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: string(member),
+	}).Err()
+}
+
+// the default max number of messages moved by a single script call
+const defaultDelayedMessageBatchSize = 1000
+
+// RunDelayedMessageMover moves the due delayed messages back to their streams periodically until stop is closed,
+// the messages are moved batch by batch until there are no more due messages in every tick
+func RunDelayedMessageMover(ctx context.Context, client *redis.Client, logger *zap.Logger, interval time.Duration, batchSize int, stop <-chan struct{}) {
+	if batchSize <= 0 {
+		batchSize = defaultDelayedMessageBatchSize
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			total, err := moveAllDueMessages(ctx, client, batchSize, stop)
+			if err != nil {
+				logger.Error("DelayedMessageMover: failed to move due messages to stream", zap.Error(err))
+			}
+			if total > 0 {
+				logger.Debug("DelayedMessageMover: move due messages to stream", zap.Int("count", total))
+			}
+		}
+	}
+}
+
+// moveAllDueMessages moves the due messages until a batch is not full or stop is closed, the number of moved messages is returned
+func moveAllDueMessages(ctx context.Context, client *redis.Client, batchSize int, stop <-chan struct{}) (int, error) {
+	var total int
+	for {
+		n, err := moveDueMessages(ctx, client, batchSize)
+		total += n
+		if err != nil || n < batchSize {
+			return total, err
+		}
+		select {
+		case <-stop:
+			return total, nil
+		default:
+		}
+	}
+}
+
+// moveDueMessages moves at most batchSize due messages back to their streams, the number of moved messages is returned
+func moveDueMessages(ctx context.Context, client *redis.Client, batchSize int) (int, error) {
+	return moveDueScript.Run(ctx, client, []string{DelayedMessageKey}, batchSize).Int()
+}
@@ -0,0 +1,111 @@
+package mq
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestDelay(t *testing.T) {
+	m := miniredis.RunT(t)
+	now := time.UnixMilli(1650000000000)
+	m.SetTime(now)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+
+	values := map[string]interface{}{"action_id": "action", "token": "token"}
+	assert.NoError(t, Delay(ctx, client, testStreamKey, values, now.Add(time.Second)))
+	// the same values can be delayed more than once
+	assert.NoError(t, Delay(ctx, client, testStreamKey, values, now.Add(time.Second)))
+	assert.NoError(t, Delay(ctx, client, testStreamKey, map[string]interface{}{"action_id": "later"}, now.Add(time.Minute)))
+	score, err := client.ZScore(ctx, DelayedMessageKey, client.ZRange(ctx, DelayedMessageKey, 0, 0).Val()[0]).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, float64(now.Add(time.Second).UnixMilli()), score)
+
+	// nothing is due
+	n, err := moveDueMessages(ctx, client, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, int64(0), client.XLen(ctx, testStreamKey).Val())
+
+	m.SetTime(now.Add(time.Second))
+	n, err = moveDueMessages(ctx, client, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	messages, err := client.XRange(ctx, testStreamKey, "-", "+").Result()
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	for _, message := range messages {
+		assert.Equal(t, values, message.Values)
+	}
+	assert.Equal(t, int64(1), client.ZCard(ctx, DelayedMessageKey).Val())
+
+	// the moved messages are not moved again
+	n, err = moveDueMessages(ctx, client, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestRunDelayedMessageMover(t *testing.T) {
+	m := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+
+	assert.NoError(t, Delay(ctx, client, testStreamKey, map[string]interface{}{"action_id": "action"}, time.Now().Add(-time.Second)))
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		RunDelayedMessageMover(ctx, client, zap.NewNop(), 10*time.Millisecond, 0, stop)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		return client.XLen(ctx, testStreamKey).Val() == 1
+	}, time.Second, 10*time.Millisecond)
+	close(stop)
+	<-done
+}
+
+func TestMoveAllDueMessages(t *testing.T) {
+	m := miniredis.RunT(t)
+	now := time.UnixMilli(1650000000000)
+	m.SetTime(now)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+
+	for i := 0; i < 25; i++ {
+		assert.NoError(t, Delay(ctx, client, testStreamKey, map[string]interface{}{"action_id": "action"}, now))
+	}
+	// all the due messages are moved in a single tick
+	total, err := moveAllDueMessages(ctx, client, 10, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, total)
+	assert.Equal(t, int64(25), client.XLen(ctx, testStreamKey).Val())
+	assert.Equal(t, int64(0), client.ZCard(ctx, DelayedMessageKey).Val())
+}
+
+func TestMoveDueMessagesNotTrimStream(t *testing.T) {
+	m := miniredis.RunT(t)
+	now := time.UnixMilli(1650000000000)
+	m.SetTime(now)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+
+	for i := 0; i < streamMaxLength; i++ {
+		assert.NoError(t, client.XAdd(ctx, &redis.XAddArgs{Stream: testStreamKey, Values: map[string]interface{}{"i": i}}).Err())
+	}
+	assert.NoError(t, Delay(ctx, client, testStreamKey, map[string]interface{}{"action_id": "action"}, now))
+	n, err := moveDueMessages(ctx, client, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	// the unconsumed messages are kept
+	assert.Equal(t, int64(streamMaxLength+1), client.XLen(ctx, testStreamKey).Val())
+}
@@ -3,15 +3,33 @@ package mq
 import (
 	"context"
 	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/tracing"
 	"github.com/shitamachi/redisqueue/v2"
 )
 
+const streamMaxLength = 10000
+
 func InitProducer(ctx context.Context, client *redis.Client) (*redisqueue.Producer, error) {
 	p, err := redisqueue.NewProducerWithOptions(&redisqueue.ProducerOptions{
 		Ctx:                  ctx,
-		StreamMaxLength:      10000,
+		StreamMaxLength:      streamMaxLength,
 		ApproximateMaxLength: true,
 		RedisClient:          client,
 	})
 	return p, err
 }
+
+// Add adds the message values to the stream, it is used where the producer is not available, e.g. in consumer func.
+// The trace context and request id of ctx are carried by the message.
+func Add(ctx context.Context, client *redis.Client, stream string, values map[string]interface{}) error {
+	if requestId := log.GetRequestId(ctx); len(requestId) > 0 {
+		values["request_id"] = requestId
+	}
+	return client.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		MaxLen: streamMaxLength,
+		Approx: true,
+		Values: tracing.InjectStreamValues(ctx, values),
+	}).Err()
+}
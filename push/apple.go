@@ -5,18 +5,27 @@ import (
 	"errors"
 	"fmt"
 	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
 	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
 	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/registry"
+	"github.com/shitamachi/push-service/tracing"
 	"github.com/sideshow/apns2"
 	"github.com/sideshow/apns2/token"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
 	"go.uber.org/zap"
 	"net/http"
 	"reflect"
+	"strconv"
 	"sync"
 )
 
 type ApplePushClient struct {
-	clients sync.Map
+	// the clients of apps in config file
+	clients         sync.Map
+	registryClients registryClients
 }
 
 func NewApplePushClient() *ApplePushClient {
@@ -39,14 +48,45 @@ func InitApplePush(ctx context.Context, appConfig *config.AppConfig) {
 	}
 }
 
+// ReloadApplePush rebuilds the push clients of the apps whose config is added or changed, and removes the clients
+// of the removed apps. The client is replaced only if the new one is created, the in-flight sends keep using the old one.
+func ReloadApplePush(ctx context.Context, oldConfig, newConfig *config.AppConfig) {
+	for bundleID, item := range newConfig.ApplePushConfig.Items {
+		if oldItem, ok := oldConfig.ApplePushConfig.Items[bundleID]; ok && oldItem == item && GlobalApplePushClient.HasClient(bundleID) {
+			continue
+		}
+		pushClientItem, err := NewApplePushClientItem(ctx, newConfig, bundleID)
+		if err != nil {
+			log.WithCtx(ctx).Error("ReloadApplePush: can not create apple push client, keep the current one",
+				zap.String("bundle_id", bundleID),
+				zap.Error(err),
+			)
+			continue
+		}
+		GlobalApplePushClient.clients.Store(bundleID, pushClientItem)
+		log.WithCtx(ctx).Info("ReloadApplePush: rebuild apple push client successfully", zap.String("bundle_id", bundleID))
+	}
+	for bundleID := range oldConfig.ApplePushConfig.Items {
+		if _, ok := newConfig.ApplePushConfig.Items[bundleID]; !ok {
+			GlobalApplePushClient.clients.Delete(bundleID)
+			log.WithCtx(ctx).Info("ReloadApplePush: remove apple push client", zap.String("bundle_id", bundleID))
+		}
+	}
+}
+
 func NewApplePushClientItem(ctx context.Context, appConfig *config.AppConfig, bundleID string) (*apns2.Client, error) {
 	pushConfigItem, ok := appConfig.ApplePushConfig.Items[bundleID]
 	if !ok {
 		return nil, NewWrappedError(fmt.Sprintf("init apple %s push client failed", bundleID), CanNotGetClientFromConfig)
 	}
+	return NewAppleClient(ctx, appConfig.Mode, bundleID, pushConfigItem)
+}
+
+// NewAppleClient creates the apns client with the credentials, the development environment is used unless mode is release
+func NewAppleClient(ctx context.Context, mode string, bundleID string, pushConfigItem config_entries.ApplePushSecretConfigItem) (*apns2.Client, error) {
 	authKey, err := token.AuthKeyFromBytes([]byte(pushConfigItem.AuthKey))
 	if err != nil {
-		log.WithCtx(ctx).Fatal("NewApplePushClient: get auth key from config failed", zap.Error(err))
+		log.WithCtx(ctx).Error("NewApplePushClient: get auth key from config failed", zap.String("bundle_id", bundleID), zap.Error(err))
 		return nil, err
 	}
 
@@ -59,20 +99,27 @@ func NewApplePushClientItem(ctx context.Context, appConfig *config.AppConfig, bu
 	}
 
 	var client *apns2.Client
-	switch appConfig.Mode {
-	case "debug", "test":
-		client = apns2.NewTokenClient(appleToken).Development()
-		log.WithCtx(ctx).Info("NewApplePushClient: init development apple push client successfully", zap.String("bundle_id", pushConfigItem.BundleID))
+	switch mode {
 	case "release":
 		client = apns2.NewTokenClient(appleToken).Production()
-		log.WithCtx(ctx).Info("NewApplePushClient: init production apple push client successfully", zap.String("bundle_id", pushConfigItem.BundleID))
+		log.WithCtx(ctx).Info("NewApplePushClient: init production apple push client successfully", zap.String("bundle_id", bundleID))
+	default:
+		client = apns2.NewTokenClient(appleToken).Development()
+		log.WithCtx(ctx).Info("NewApplePushClient: init development apple push client successfully", zap.String("bundle_id", bundleID))
 	}
 
 	return client, nil
 }
 
+// GetClientByAppID returns the client of app in config file, or the client of app in registry which is built on demand
 func (a *ApplePushClient) GetClientByAppID(ctx context.Context, appID string) (interface{}, bool) {
 	value, ok := a.clients.Load(appID)
+	if ok {
+		return value, true
+	}
+	value, ok = a.registryClients.get(ctx, appID, config_entries.ApplePush, func(app *registry.App) (interface{}, error) {
+		return NewAppleClient(ctx, config.GetFromContext(ctx).Mode, appID, app.Apple)
+	})
 	if !ok {
 		log.WithCtx(ctx).Error("GetClientByAppID: can not get apple push client from global", zap.String("bundle_id", appID))
 		return nil, false
@@ -80,6 +127,13 @@ func (a *ApplePushClient) GetClientByAppID(ctx context.Context, appID string) (i
 	return value, true
 }
 
+// HasClient reports whether the push client of app has been initialized
+func (a *ApplePushClient) HasClient(appID string) bool {
+	v, _ := a.clients.Load(appID)
+	client, ok := v.(*apns2.Client)
+	return ok && client != nil
+}
+
 func (a *ApplePushClient) Push(ctx context.Context, message *models.PushMessage) (interface{}, error) {
 	v, ok := a.GetClientByAppID(ctx, message.GetAppId())
 	if !ok || v == nil {
@@ -100,16 +154,31 @@ func (a *ApplePushClient) Push(ctx context.Context, message *models.PushMessage)
 		return nil, NewWrappedError("convert to *apns2.Notification failed", ConvertToSpecificPlatformMessageFailed)
 	}
 
-	rep, err := client.PushWithContext(ctx, notification)
+	spanCtx, span := tracing.StartProviderSpan(ctx, metrics.ProviderApns, message.GetAppId())
+	rep, err := client.PushWithContext(spanCtx, notification)
 	if err != nil {
+		tracing.EndSpan(span, err)
+		metrics.ProviderResponses.WithLabelValues(metrics.ProviderApns, message.GetAppId(), metrics.ResponseCodeError, "").Inc()
 		log.WithCtx(ctx).Error("ApplePush: push notification failed", zap.Error(err))
 		return nil, err
 	}
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(rep.StatusCode), tracing.ProviderReasonKey.String(rep.Reason))
+	if rep.StatusCode != http.StatusOK {
+		span.SetStatus(codes.Error, rep.Reason)
+	}
+	span.End()
+	metrics.ProviderResponses.WithLabelValues(metrics.ProviderApns, message.GetAppId(), strconv.Itoa(rep.StatusCode), rep.Reason).Inc()
 
 	switch {
 	case rep.StatusCode == http.StatusOK:
 		log.WithCtx(ctx).Debug("ApplePush: send push message response ok", zap.Any("message", message))
 		return rep, nil
+	case rep.StatusCode == http.StatusGone || rep.Reason == apns2.ReasonBadDeviceToken || rep.Reason == apns2.ReasonUnregistered:
+		log.WithCtx(ctx).Info("ApplePush: device token is invalidated",
+			zap.Int("code", rep.StatusCode),
+			zap.String("reason", rep.Reason),
+		)
+		return rep, NewWrappedError(fmt.Sprintf("apple push: %s", rep.Reason), TokenInvalidated)
 	case rep.StatusCode != http.StatusOK:
 		log.WithCtx(ctx).Error("ApplePush: request send ok but apple response not ok",
 			zap.Int("code", rep.StatusCode),
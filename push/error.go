@@ -11,6 +11,8 @@ var (
 	ConvertToSpecificPlatformClientFailed  = errors.New("convert to specific platform client failed")
 	ConvertToSpecificPlatformMessageFailed = errors.New("convert to specific platform message failed")
 	SendMessageResponseNotOk               = errors.New("request send message to platform push service reply http status code not ok")
+	// 设备 token 已失效, 例如 app 被卸载; 该 token 不应再被用于推送
+	TokenInvalidated = errors.New("device token is invalidated")
 )
 
 type WrappedError struct {
@@ -4,19 +4,28 @@ import (
 	"context"
 	"errors"
 	firebase "firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/errorutils"
 	"firebase.google.com/go/v4/messaging"
 	"fmt"
 	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
 	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
 	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/registry"
+	"github.com/shitamachi/push-service/tracing"
 	"go.uber.org/zap"
 	"google.golang.org/api/option"
+	"net/http"
 	"reflect"
+	"strconv"
 	"sync"
 )
 
 type FirebasePushClient struct {
-	clients sync.Map
+	// the clients of apps in config file
+	clients         sync.Map
+	registryClients registryClients
 }
 
 type MessageFirebaseItem struct {
@@ -45,11 +54,42 @@ func InitFirebasePush(ctx context.Context, appConfig *config.AppConfig) {
 	}
 }
 
+// ReloadFirebasePush rebuilds the push clients of the apps whose config is added or changed, and removes the clients
+// of the removed apps. The client is replaced only if the new one is created, the in-flight sends keep using the old one.
+func ReloadFirebasePush(ctx context.Context, oldConfig, newConfig *config.AppConfig) {
+	for packageName, item := range newConfig.FirebasePushConfig.Items {
+		if oldItem, ok := oldConfig.FirebasePushConfig.Items[packageName]; ok && oldItem == item && GlobalFirebasePushClient.HasClient(packageName) {
+			continue
+		}
+		client, err := NewFirebasePushClientItem(ctx, newConfig, packageName)
+		if err != nil {
+			log.WithCtx(ctx).Error("ReloadFirebasePush: can not create firebase push client, keep the current one",
+				zap.String("package_name", packageName),
+				zap.Error(err),
+			)
+			continue
+		}
+		GlobalFirebasePushClient.clients.Store(packageName, client)
+		log.WithCtx(ctx).Info("ReloadFirebasePush: rebuild firebase message client successfully", zap.String("package_name", packageName))
+	}
+	for packageName := range oldConfig.FirebasePushConfig.Items {
+		if _, ok := newConfig.FirebasePushConfig.Items[packageName]; !ok {
+			GlobalFirebasePushClient.clients.Delete(packageName)
+			log.WithCtx(ctx).Info("ReloadFirebasePush: remove firebase message client", zap.String("package_name", packageName))
+		}
+	}
+}
+
 func NewFirebasePushClientItem(ctx context.Context, appConfig *config.AppConfig, packageName string) (*messaging.Client, error) {
 	configItem, ok := appConfig.FirebasePushConfig.Items[packageName]
 	if !ok {
 		return nil, NewWrappedError(fmt.Sprintf("init google %s push client failed", packageName), CanNotGetClientFromConfig)
 	}
+	return NewFirebaseClient(ctx, packageName, configItem)
+}
+
+// NewFirebaseClient creates the firebase messaging client with the service account
+func NewFirebaseClient(ctx context.Context, packageName string, configItem config_entries.FirebaseConfigItem) (*messaging.Client, error) {
 	opts := option.WithCredentialsJSON([]byte(configItem.ServiceAccountFileContent))
 	app, err := firebase.NewApp(context.Background(), nil, opts)
 	if err != nil {
@@ -64,8 +104,15 @@ func NewFirebasePushClientItem(ctx context.Context, appConfig *config.AppConfig,
 	return client, nil
 }
 
+// GetClientByAppID returns the client of app in config file, or the client of app in registry which is built on demand
 func (f *FirebasePushClient) GetClientByAppID(ctx context.Context, appID string) (interface{}, bool) {
 	value, ok := f.clients.Load(appID)
+	if ok {
+		return value, true
+	}
+	value, ok = f.registryClients.get(ctx, appID, config_entries.FirebasePush, func(app *registry.App) (interface{}, error) {
+		return NewFirebaseClient(ctx, appID, app.Firebase)
+	})
 	if !ok {
 		log.WithCtx(ctx).Error("GetClientByAppID: can not get client from global one", zap.String("package_name", appID))
 		return nil, false
@@ -73,6 +120,63 @@ func (f *FirebasePushClient) GetClientByAppID(ctx context.Context, appID string)
 	return value, true
 }
 
+// HasClient reports whether the push client of app has been initialized
+func (f *FirebasePushClient) HasClient(appID string) bool {
+	v, _ := f.clients.Load(appID)
+	client, ok := v.(*messaging.Client)
+	return ok && client != nil
+}
+
+// isFirebaseTokenInvalidated reports whether the error of send response means the registration token can not be used anymore
+func isFirebaseTokenInvalidated(err error) bool {
+	return err != nil && (messaging.IsRegistrationTokenNotRegistered(err) || messaging.IsUnregistered(err))
+}
+
+// firebaseErrorCode returns the FCM error code of the error of send response
+func firebaseErrorCode(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case messaging.IsUnregistered(err):
+		return "UNREGISTERED"
+	case messaging.IsInvalidArgument(err):
+		return "INVALID_ARGUMENT"
+	case messaging.IsSenderIDMismatch(err):
+		return "SENDER_ID_MISMATCH"
+	case messaging.IsQuotaExceeded(err):
+		return "QUOTA_EXCEEDED"
+	case messaging.IsThirdPartyAuthError(err):
+		return "THIRD_PARTY_AUTH_ERROR"
+	case messaging.IsUnavailable(err):
+		return "UNAVAILABLE"
+	case messaging.IsInternal(err):
+		return "INTERNAL"
+	default:
+		return "UNSPECIFIED_ERROR"
+	}
+}
+
+func recordFirebaseResponses(appId string, res *messaging.BatchResponse, err error) {
+	if err != nil {
+		code := metrics.ResponseCodeError
+		if resp := errorutils.HTTPResponse(err); resp != nil {
+			code = strconv.Itoa(resp.StatusCode)
+		}
+		metrics.ProviderResponses.WithLabelValues(metrics.ProviderFcm, appId, code, firebaseErrorCode(err)).Inc()
+		return
+	}
+	for _, resp := range res.Responses {
+		code := strconv.Itoa(http.StatusOK)
+		if resp.Error != nil {
+			code = metrics.ResponseCodeError
+			if httpResp := errorutils.HTTPResponse(resp.Error); httpResp != nil {
+				code = strconv.Itoa(httpResp.StatusCode)
+			}
+		}
+		metrics.ProviderResponses.WithLabelValues(metrics.ProviderFcm, appId, code, firebaseErrorCode(resp.Error)).Inc()
+	}
+}
+
 func (f *FirebasePushClient) Push(ctx context.Context, message *models.PushMessage) (interface{}, error) {
 	if message == nil {
 		log.WithCtx(ctx).Error("Firebase Push: get param message is nil")
@@ -99,7 +203,15 @@ func (f *FirebasePushClient) Push(ctx context.Context, message *models.PushMessa
 			zap.String("type", reflect.TypeOf(msg).String()))
 		return nil, NewWrappedError("can not convert message to firebase *messaging.Message", ConvertToSpecificPlatformMessageFailed)
 	}
-	res, err := client.SendAll(ctx, []*messaging.Message{msg})
+	spanCtx, span := tracing.StartProviderSpan(ctx, metrics.ProviderFcm, message.GetAppId())
+	res, err := client.SendAll(spanCtx, []*messaging.Message{msg})
+	recordFirebaseResponses(message.GetAppId(), res, err)
+	if err == nil && res.FailureCount > 0 && len(res.Responses) > 0 {
+		span.SetAttributes(tracing.ProviderReasonKey.String(firebaseErrorCode(res.Responses[0].Error)))
+		tracing.EndSpan(span, res.Responses[0].Error)
+	} else {
+		tracing.EndSpan(span, err)
+	}
 
 	if err != nil {
 		log.WithCtx(ctx).Error("FirebasePush: send push request to firebase failed",
@@ -110,6 +222,14 @@ func (f *FirebasePushClient) Push(ctx context.Context, message *models.PushMessa
 		return nil, err
 	}
 
+	if res.SuccessCount <= 0 && len(res.Responses) > 0 && isFirebaseTokenInvalidated(res.Responses[0].Error) {
+		log.WithCtx(ctx).Info("FirebasePush: device token is invalidated",
+			zap.String("app", message.GetAppId()),
+			zap.Error(res.Responses[0].Error),
+		)
+		return res, NewWrappedError(res.Responses[0].Error.Error(), TokenInvalidated)
+	}
+
 	if res.SuccessCount <= 0 {
 		log.WithCtx(ctx).Error("FirebasePush: no message send to firebase successfully",
 			zap.Int("success_count", res.SuccessCount),
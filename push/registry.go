@@ -0,0 +1,66 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/registry"
+	"go.uber.org/zap"
+	"sync"
+)
+
+type registryClient struct {
+	client interface{}
+	// the revision of app which the client is built with
+	revision int64
+}
+
+// registryClients holds the clients of apps in registry, the client of app is built on first use
+// and rebuilt once the app is updated. The in-flight sends keep using the client they have got.
+type registryClients struct {
+	// mu prevents building the client of the same app concurrently
+	mu      sync.Mutex
+	clients sync.Map
+}
+
+func (r *registryClients) get(ctx context.Context, appID string, pushType config_entries.PushType, build func(app *registry.App) (interface{}, error)) (interface{}, bool) {
+	app, err := registry.Get(ctx, appID)
+	if errors.Is(err, registry.ErrAppNotFound) {
+		// the app is deleted or disabled
+		r.clients.Delete(appID)
+		return nil, false
+	}
+	if err != nil {
+		log.WithCtx(ctx).Error("GetClientByAppID: failed to get app from registry", zap.String("app_id", appID), zap.Error(err))
+		return nil, false
+	}
+	if app.Static || app.PushType != pushType {
+		return nil, false
+	}
+	if client, ok := r.load(appID, app.Revision); ok {
+		return client, true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if client, ok := r.load(appID, app.Revision); ok {
+		return client, true
+	}
+	client, err := build(app)
+	if err != nil {
+		log.WithCtx(ctx).Error("GetClientByAppID: failed to create push client of app in registry", zap.String("app_id", appID), zap.Error(err))
+		return nil, false
+	}
+	r.clients.Store(appID, &registryClient{client: client, revision: app.Revision})
+	return client, true
+}
+
+func (r *registryClients) load(appID string, revision int64) (interface{}, bool) {
+	v, ok := r.clients.Load(appID)
+	if !ok {
+		return nil, false
+	}
+	c := v.(*registryClient)
+	return c.client, c.revision == revision
+}
@@ -0,0 +1,181 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/app"
+	"github.com/shitamachi/push-service/secret"
+	"sync"
+	"time"
+)
+
+var (
+	ErrAppNotFound = errors.New("app not found")
+	ErrDisabled    = errors.New("app registry is disabled, the encryption key is not configured")
+)
+
+// App is the app which the messages are pushed to, the apps in config file take precedence over the apps in database
+type App struct {
+	AppId    string
+	PushType config_entries.PushType
+	Apple    config_entries.ApplePushSecretConfigItem
+	Firebase config_entries.FirebaseConfigItem
+	// Static reports whether the app is in config file, its push client is built at startup and on reload
+	Static bool
+	// Revision is the version of app in database, the push client is rebuilt once it is changed
+	Revision int64
+}
+
+// Credentials are the push credentials of app, they are encrypted as a whole when stored in database
+type Credentials struct {
+	Apple    *config_entries.ApplePushSecretConfigItem `json:"apple,omitempty"`
+	Firebase *config_entries.FirebaseConfigItem        `json:"firebase,omitempty"`
+}
+
+type cachedApp struct {
+	// nil if the app does not exist, so that the unknown apps do not query the database each time
+	app       *App
+	expiresAt time.Time
+}
+
+// the apps are resolved for every push message, so the apps in database are cached for a while.
+// The changes made by the other instances take effect after the cache expires.
+var appCache = struct {
+	sync.Mutex
+	items map[string]cachedApp
+}{items: make(map[string]cachedApp)}
+
+// Invalidate removes the cached app, it is called when the app is changed
+func Invalidate(appId string) {
+	appCache.Lock()
+	delete(appCache.items, appId)
+	appCache.Unlock()
+}
+
+// IsStatic reports whether the app is in config file, such apps can not be managed by the api
+func IsStatic(conf *config.AppConfig, appId string) bool {
+	_, ok := conf.ClientConfig[appId]
+	return ok
+}
+
+// Get returns the app from config file or database, ErrAppNotFound is returned if it does not exist or is disabled
+func Get(ctx context.Context, appId string) (*App, error) {
+	conf := config.GetFromContext(ctx)
+	if item, ok := conf.ClientConfig[appId]; ok {
+		return &App{
+			AppId:    appId,
+			PushType: item.PushType,
+			Apple:    conf.ApplePushConfig.Items[appId],
+			Firebase: conf.FirebasePushConfig.Items[appId],
+			Static:   true,
+		}, nil
+	}
+	if len(appId) <= 0 || !conf.AppRegistry.IsEnabled() {
+		return nil, ErrAppNotFound
+	}
+
+	now := time.Now()
+	appCache.Lock()
+	cached, ok := appCache.items[appId]
+	appCache.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		if cached.app == nil {
+			return nil, ErrAppNotFound
+		}
+		return cached.app, nil
+	}
+
+	a, err := queryApp(ctx, conf, appId)
+	if err != nil && !errors.Is(err, ErrAppNotFound) {
+		return nil, err
+	}
+	appCache.Lock()
+	appCache.items[appId] = cachedApp{
+		app:       a,
+		expiresAt: now.Add(conf.AppRegistry.GetCacheTTL()),
+	}
+	appCache.Unlock()
+	return a, err
+}
+
+// GetPushType returns the push type of app, false is returned if the app can not be resolved
+func GetPushType(ctx context.Context, appId string) (config_entries.PushType, bool) {
+	a, err := Get(ctx, appId)
+	if err != nil {
+		return "", false
+	}
+	return a.PushType, true
+}
+
+func queryApp(ctx context.Context, conf *config.AppConfig, appId string) (*App, error) {
+	client := db.GetFromContext(ctx)
+	if client == nil {
+		return nil, errors.New("can not get db client from context")
+	}
+	record, err := client.App.Query().
+		Where(app.AppID(appId), app.Enabled(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAppNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := DecryptCredentials(conf, record.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	a := &App{
+		AppId:    record.AppID,
+		PushType: config_entries.PushType(record.PushType),
+		Revision: record.Revision,
+	}
+	if credentials.Apple != nil {
+		a.Apple = *credentials.Apple
+	}
+	if credentials.Firebase != nil {
+		a.Firebase = *credentials.Firebase
+	}
+	return a, nil
+}
+
+// EncryptCredentials encrypts the credentials with the encryption key of app registry
+func EncryptCredentials(conf *config.AppConfig, credentials *Credentials) ([]byte, error) {
+	if !conf.AppRegistry.IsEnabled() {
+		return nil, ErrDisabled
+	}
+	c, err := secret.NewCipher(conf.AppRegistry.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return nil, err
+	}
+	return c.Encrypt(plaintext)
+}
+
+// DecryptCredentials decrypts the credentials stored in database
+func DecryptCredentials(conf *config.AppConfig, ciphertext []byte) (*Credentials, error) {
+	if !conf.AppRegistry.IsEnabled() {
+		return nil, ErrDisabled
+	}
+	c, err := secret.NewCipher(conf.AppRegistry.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := c.Decrypt(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	var credentials Credentials
+	if err = json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, err
+	}
+	return &credentials, nil
+}
@@ -0,0 +1,61 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetStaticApp(t *testing.T) {
+	conf := &config.AppConfig{
+		ClientConfig: map[string]config_entries.ClientConfigItem{
+			"ios_app": {PushType: config_entries.ApplePush},
+		},
+		ApplePushConfig: config_entries.ApplePushSecretConfig{
+			Items: map[string]config_entries.ApplePushSecretConfigItem{
+				"ios_app": {BundleID: "ios_app", AuthKey: "key", KeyID: "key id", TeamID: "team id"},
+			},
+		},
+	}
+	ctx := config.SetToContext(context.Background(), conf)
+
+	a, err := Get(ctx, "ios_app")
+	assert.NoError(t, err)
+	assert.True(t, a.Static)
+	assert.Equal(t, "key id", a.Apple.KeyID)
+	pushType, ok := GetPushType(ctx, "ios_app")
+	assert.True(t, ok)
+	assert.Equal(t, config_entries.ApplePush, pushType)
+
+	// the database is not queried if the registry is disabled
+	_, err = Get(ctx, "android_app")
+	assert.True(t, errors.Is(err, ErrAppNotFound))
+	_, ok = GetPushType(ctx, "android_app")
+	assert.False(t, ok)
+}
+
+func TestCredentials(t *testing.T) {
+	conf := &config.AppConfig{}
+	_, err := EncryptCredentials(conf, &Credentials{})
+	assert.True(t, errors.Is(err, ErrDisabled))
+
+	conf.AppRegistry.EncryptionKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	credentials := &Credentials{Firebase: &config_entries.FirebaseConfigItem{
+		PackageName:               "android_app",
+		ServiceAccountFileContent: `{"type": "service_account"}`,
+	}}
+	encrypted, err := EncryptCredentials(conf, credentials)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "service_account")
+
+	decrypted, err := DecryptCredentials(conf, encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, credentials, decrypted)
+
+	conf.AppRegistry.EncryptionKey = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+	_, err = DecryptCredentials(conf, encrypted)
+	assert.Error(t, err)
+}
@@ -3,14 +3,17 @@ package router
 import (
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/shitamachi/push-service/api"
+	"github.com/shitamachi/push-service/auth"
 	"github.com/shitamachi/push-service/config"
 	"github.com/shitamachi/push-service/handler"
+	"github.com/shitamachi/push-service/health"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func InitRouter(config *config.AppConfig, appCtx *api.AppContext) *gin.Engine {
+func InitRouter(config *config.AppConfig, appCtx *api.AppContext, checker *health.Checker) *gin.Engine {
 	if config.Mode == "release" {
 		gin.SetMode(gin.ReleaseMode)
 	} else {
@@ -18,16 +21,71 @@ func InitRouter(config *config.AppConfig, appCtx *api.AppContext) *gin.Engine {
 	}
 
 	r := gin.New()
+	r.Use(api.RequestId())
 	ctx := api.Context{
 		AppContext: appCtx,
 	}
 
-	r.POST("/v1/batch_push_messages_async", ctx.WrapperGinHandleFunc(handler.BatchPushMessageAsync))
-	r.POST("/v1/push_messages_for_all", ctx.WrapperGinHandleFunc(handler.PushMessageForAllSpecificClient))
+	r.POST("/v1/batch_push_messages_async", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.BatchPushMessageAsync))
+	r.POST("/v1/push_messages_for_all", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.PushMessageForAllSpecificClient))
+	r.GET("/v1/rate_limits", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.GetRateLimitState))
+	r.POST("/v1/segments/count", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.CountSegment))
 
-	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/v1/audiences", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.ListAudiences))
+	r.POST("/v1/audiences", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.CreateAudience))
+	r.GET("/v1/audiences/:audience_id", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.GetAudience))
+	r.DELETE("/v1/audiences/:audience_id", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.DeleteAudience))
+	r.POST("/v1/audiences/:audience_id/members", ctx.WrapperGinHandleFunc(auth.PermissionBroadcast, handler.UploadAudienceMembers))
+
+	r.POST("/v1/events", ctx.WrapperGinHandleFuncForUser(auth.PermissionTokens, handler.ReportEvent))
+	r.GET("/v1/events/stats", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.GetEventStats))
+
+	r.GET("/v1/api_keys", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ListApiKeys))
+	r.POST("/v1/api_keys", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.CreateApiKey))
+	r.DELETE("/v1/api_keys/:key_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.RevokeApiKey))
+	r.POST("/v1/api_keys/:key_id/rotate_signing_secret", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.RotateSigningSecret))
+
+	r.GET("/v1/webhooks", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ListWebhooks))
+	r.POST("/v1/webhooks", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.CreateWebhook))
+	r.PUT("/v1/webhooks/:webhook_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.UpdateWebhook))
+	r.DELETE("/v1/webhooks/:webhook_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.DeleteWebhook))
+	r.GET("/v1/webhooks/:webhook_id/deliveries", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ListWebhookDeliveries))
+
+	r.GET("/v1/apps", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ListApps))
+	r.POST("/v1/apps", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.CreateApp))
+	r.GET("/v1/apps/:app_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.GetApp))
+	r.PUT("/v1/apps/:app_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.UpdateApp))
+	r.DELETE("/v1/apps/:app_id", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.DeleteApp))
+
+	r.GET("/v1/audit_logs", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ListAuditLogs))
+	r.GET("/v1/audit_logs/export", ctx.WrapperGinHandleFunc(auth.PermissionAdmin, handler.ExportAuditLogs))
+
+	r.POST("/v1/tokens", ctx.WrapperGinHandleFuncForUser(auth.PermissionTokens, handler.RegisterToken))
+	r.DELETE("/v1/tokens", ctx.WrapperGinHandleFuncForUser(auth.PermissionTokens, handler.DeleteToken))
+
+	r.GET("/v1/quiet_hours", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.GetUserQuietHours))
+	r.PUT("/v1/quiet_hours", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.SetUserQuietHours))
+	r.DELETE("/v1/quiet_hours", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.DeleteUserQuietHours))
+
+	r.GET("/v1/preferences", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.GetUserPreferences))
+	r.PUT("/v1/preferences", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.SetUserPreferences))
+	r.DELETE("/v1/preferences", ctx.WrapperGinHandleFunc(auth.PermissionTokens, handler.DeleteUserPreferences))
+
+	r.GET("/v1/actions/:action_id", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.GetAction))
+	r.GET("/v1/actions/:action_id/results", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.GetActionResults))
+	r.POST("/v1/actions/:action_id/cancel", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.CancelAction))
+	r.POST("/v1/actions/:action_id/pause", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.PauseAction))
+	r.POST("/v1/actions/:action_id/resume", ctx.WrapperGinHandleFunc(auth.PermissionSend, handler.ResumeAction))
+
+	// the probes of kubernetes are not authenticated
+	r.GET("/healthz", gin.WrapF(checker.Liveness))
+	r.GET("/readyz", gin.WrapF(checker.Readiness))
+
+	// the scrapers use the api key with only the metrics permission
+	r.GET("/metrics", ctx.RequirePermission(auth.PermissionMetrics), gin.WrapH(promhttp.Handler()))
+	r.GET("/swagger/*any", ctx.RequirePermission(auth.PermissionAdmin), ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	//pprof
-	pprof.RouteRegister(r.Group(""))
+	pprof.RouteRegister(r.Group("", ctx.RequirePermission(auth.PermissionAdmin)))
 	return r
 }
@@ -0,0 +1,98 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	SchemeFile  = "file"
+	SchemeEnv   = "env"
+	SchemeVault = "vault"
+)
+
+var ErrNotFound = errors.New("secret not found")
+
+// Provider gets the secret of the reference, the reference is the part after "<scheme>://"
+type Provider interface {
+	Get(ctx context.Context, ref string) (string, error)
+}
+
+// ProviderFunc adapts the func as a provider
+type ProviderFunc func(ctx context.Context, ref string) (string, error)
+
+func (f ProviderFunc) Get(ctx context.Context, ref string) (string, error) {
+	return f(ctx, ref)
+}
+
+// Resolver resolves the secret references by the provider of their scheme
+type Resolver struct {
+	providers map[string]Provider
+}
+
+// NewResolver returns the resolver with the file and env providers
+func NewResolver() *Resolver {
+	r := &Resolver{providers: make(map[string]Provider)}
+	r.Register(SchemeFile, FileProvider{})
+	r.Register(SchemeEnv, EnvProvider{})
+	return r
+}
+
+// Register adds the provider of scheme, the provider of the same scheme is replaced
+func (r *Resolver) Register(scheme string, provider Provider) {
+	r.providers[scheme] = provider
+}
+
+// Resolve returns the secret of value if it is a reference of the registered scheme,
+// otherwise the value itself is returned as the inline secret
+func (r *Resolver) Resolve(ctx context.Context, value string) (string, error) {
+	scheme, ref, ok := strings.Cut(value, "://")
+	if !ok {
+		return value, nil
+	}
+	provider, ok := r.providers[scheme]
+	if !ok {
+		return value, nil
+	}
+	secret, err := provider.Get(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret %s://%s: %w", scheme, ref, err)
+	}
+	return secret, nil
+}
+
+// IsReference reports whether the value is a reference of the registered scheme
+func (r *Resolver) IsReference(value string) bool {
+	scheme, _, ok := strings.Cut(value, "://")
+	if !ok {
+		return false
+	}
+	_, ok = r.providers[scheme]
+	return ok
+}
+
+// FileProvider reads the secret from the file of path, e.g. file:///run/secrets/apns.p8.
+// The trailing line break is trimmed, since most editors and tools add it.
+type FileProvider struct{}
+
+func (FileProvider) Get(ctx context.Context, ref string) (string, error) {
+	bytes, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(bytes), "\r\n"), nil
+}
+
+// EnvProvider reads the secret from the environment variable, e.g. env://APNS_AUTH_KEY
+type EnvProvider struct{}
+
+func (EnvProvider) Get(ctx context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
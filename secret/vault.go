@@ -0,0 +1,85 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// VaultProvider reads the secret from the KV secrets engine of Vault, the reference is <path>#<key>,
+// where path is the api path of secret, e.g. vault://secret/data/push/apns#auth_key.
+// Both the KV version 1 and 2 are supported.
+type VaultProvider struct {
+	address   string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+func NewVaultProvider(conf config_entries.VaultConfig) *VaultProvider {
+	return &VaultProvider{
+		address:   strings.TrimSuffix(conf.GetAddress(), "/"),
+		token:     conf.GetToken(),
+		namespace: conf.Namespace,
+		client:    &http.Client{Timeout: conf.GetTimeout()},
+	}
+}
+
+type vaultSecretResp struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+func (p *VaultProvider) Get(ctx context.Context, ref string) (string, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok || len(path) <= 0 || len(key) <= 0 {
+		return "", fmt.Errorf("vault reference must be <path>#<key>")
+	}
+	if len(p.address) <= 0 {
+		return "", fmt.Errorf("vault address is not configured")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.address+"/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	if len(p.namespace) > 0 {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", ErrNotFound
+	case resp.StatusCode/100 != 2:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("vault responds %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var secretResp vaultSecretResp
+	if err := json.NewDecoder(resp.Body).Decode(&secretResp); err != nil {
+		return "", fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	data := secretResp.Data
+	// the data of KV version 2 is nested with its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("value of key %s is not a string", key)
+	}
+	return s, nil
+}
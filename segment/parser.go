@@ -0,0 +1,269 @@
+package segment
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// the segment expression is written by the caller, limit its size to avoid building a huge query
+const maxExpressionLength = 4096
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// keyword returns the upper case keyword if the token is an unquoted word
+func (t token) keyword() string {
+	if t.kind != tokenWord {
+		return ""
+	}
+	return strings.ToUpper(t.value)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("._-+:/", r)
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, value: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, value: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", pos: i})
+			i++
+		case r == '=':
+			tokens = append(tokens, token{kind: tokenOperator, value: "=", pos: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || r == '<' && runes[i+1] == '>') {
+				op += string(runes[i+1])
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected character '!' at %d", i)
+			}
+			if op == "<>" {
+				op = "!="
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: op, pos: i})
+			i += len(op)
+		case r == '\'' || r == '"':
+			start := i
+			i++
+			var sb strings.Builder
+			for i < len(runes) && runes[i] != r {
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at %d", start)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, value: sb.String(), pos: start})
+		case isWordRune(r):
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: string(runes[start:i]), pos: start})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at %d", r, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, value string) error {
+	t := p.next()
+	if t.kind != kind {
+		return fmt.Errorf("expected %q at %d", value, t.pos)
+	}
+	return nil
+}
+
+// parseOr parses: and (OR and)*
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{left}
+	for p.peek().keyword() == "OR" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, right)
+	}
+	if len(nodes) == 1 {
+		return left, nil
+	}
+	return &orNode{nodes: nodes}, nil
+}
+
+// parseAnd parses: unary (AND unary)*
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{left}
+	for p.peek().keyword() == "AND" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, right)
+	}
+	if len(nodes) == 1 {
+		return left, nil
+	}
+	return &andNode{nodes: nodes}, nil
+}
+
+// parseUnary parses: NOT unary | '(' or ')' | condition
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	switch {
+	case t.keyword() == "NOT":
+		p.next()
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{node: n}, nil
+	case t.kind == tokenLeftParen:
+		p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokenRightParen, ")"); err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return p.parseCondition()
+	}
+}
+
+// parseCondition parses: field operator value | field [NOT] IN '(' value (',' value)* ')' | field CONTAINS value
+func (p *parser) parseCondition() (node, error) {
+	t := p.next()
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected field name at %d", t.pos)
+	}
+	f, ok := fields[strings.ToLower(t.value)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q at %d", t.value, t.pos)
+	}
+
+	op := p.next()
+	switch {
+	case op.kind == tokenOperator:
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return f.newCondition(op.value, v)
+	case op.keyword() == "IN":
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		return f.newCondition("IN", values...)
+	case op.keyword() == "NOT":
+		if p.next().keyword() != "IN" {
+			return nil, fmt.Errorf("expected IN after NOT at %d", op.pos)
+		}
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		return f.newCondition("NOT IN", values...)
+	case op.keyword() == "CONTAINS":
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return f.newCondition("CONTAINS", v)
+	default:
+		return nil, fmt.Errorf("expected operator after %q at %d", t.value, op.pos)
+	}
+}
+
+func (p *parser) parseValue() (string, error) {
+	t := p.next()
+	if t.kind != tokenWord && t.kind != tokenString {
+		return "", fmt.Errorf("expected value at %d", t.pos)
+	}
+	return t.value, nil
+}
+
+func (p *parser) parseValueList() ([]string, error) {
+	if err := p.expect(tokenLeftParen, "("); err != nil {
+		return nil, err
+	}
+	var values []string
+	for {
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+
+		t := p.next()
+		switch t.kind {
+		case tokenComma:
+			continue
+		case tokenRightParen:
+			return values, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or ')' at %d", t.pos)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+// Package segment compiles the audience segment expression of the device attributes into SQL predicate, e.g.
+//
+//	app_version >= 3.2 AND locale IN (ja, zh) AND NOT tags CONTAINS beta
+//
+// Conditions are combined with AND, OR, NOT and parentheses. Supported operators are =, !=, >, >=, <, <=,
+// IN (...), NOT IN (...) and CONTAINS, the time value of last_active_at can be a date, RFC 3339 time
+// or a duration relative to now such as -7d.
+package segment
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"fmt"
+	"github.com/shitamachi/push-service/ent/predicate"
+	"github.com/shitamachi/push-service/ent/userplatformtokens"
+	"github.com/shitamachi/push-service/models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type fieldKind int
+
+const (
+	kindString fieldKind = iota
+	kindCountry
+	kindPlatform
+	kindLocale
+	kindVersion
+	kindTime
+	kindTags
+)
+
+var kindOperators = map[fieldKind][]string{
+	kindString:   {"=", "!=", "IN", "NOT IN"},
+	kindCountry:  {"=", "!=", "IN", "NOT IN"},
+	kindPlatform: {"=", "!=", "IN", "NOT IN"},
+	kindLocale:   {"=", "!=", "IN", "NOT IN"},
+	kindVersion:  {"=", "!=", ">", ">=", "<", "<=", "IN", "NOT IN"},
+	kindTime:     {"=", "!=", ">", ">=", "<", "<="},
+	kindTags:     {"CONTAINS", "IN", "NOT IN"},
+}
+
+type field struct {
+	column string
+	kind   fieldKind
+}
+
+// fields maps the field name used in the expression to the column of user_platform_tokens
+var fields = map[string]field{
+	"app_id":         {column: userplatformtokens.FieldAppID, kind: kindString},
+	"user_id":        {column: userplatformtokens.FieldUserID, kind: kindString},
+	"timezone":       {column: userplatformtokens.FieldTimezone, kind: kindString},
+	"country":        {column: userplatformtokens.FieldCountry, kind: kindCountry},
+	"platform":       {column: userplatformtokens.FieldType, kind: kindPlatform},
+	"locale":         {column: userplatformtokens.FieldLocale, kind: kindLocale},
+	"app_version":    {column: userplatformtokens.FieldAppVersionNum, kind: kindVersion},
+	"os_version":     {column: userplatformtokens.FieldOsVersionNum, kind: kindVersion},
+	"last_active_at": {column: userplatformtokens.FieldLastActiveAt, kind: kindTime},
+	"tags":           {column: userplatformtokens.FieldTags, kind: kindTags},
+}
+
+// platforms maps the platform name to the type of user_platform_tokens
+var platforms = map[string]models.PlatformTokenType{
+	"android": models.FcmToken,
+	"fcm":     models.FcmToken,
+	"ios":     models.AppleDeviceToken,
+	"apns":    models.AppleDeviceToken,
+}
+
+// NormalizeLocale converts the locale to the form stored in database, e.g. zh_CN to zh-CN
+func NormalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+}
+
+// NormalizeCountry converts the country code to the form stored in database, e.g. jp to JP
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+type node interface {
+	predicate(now time.Time) *sql.Predicate
+}
+
+type andNode struct {
+	nodes []node
+}
+
+func (n *andNode) predicate(now time.Time) *sql.Predicate {
+	preds := make([]*sql.Predicate, len(n.nodes))
+	for i := range n.nodes {
+		preds[i] = n.nodes[i].predicate(now)
+	}
+	return sql.And(preds...)
+}
+
+type orNode struct {
+	nodes []node
+}
+
+func (n *orNode) predicate(now time.Time) *sql.Predicate {
+	preds := make([]*sql.Predicate, len(n.nodes))
+	for i := range n.nodes {
+		preds[i] = n.nodes[i].predicate(now)
+	}
+	return sql.Or(preds...)
+}
+
+type notNode struct {
+	node node
+}
+
+func (n *notNode) predicate(now time.Time) *sql.Predicate {
+	return sql.Not(n.node.predicate(now))
+}
+
+// timeValue is either an absolute time or a duration relative to the time the predicate is built
+type timeValue struct {
+	at       time.Time
+	offset   time.Duration
+	relative bool
+}
+
+func (v timeValue) resolve(now time.Time) time.Time {
+	if v.relative {
+		return now.Add(v.offset)
+	}
+	return v.at
+}
+
+type condition struct {
+	field
+	op     string
+	values []interface{}
+}
+
+func (f field) newCondition(op string, values ...string) (node, error) {
+	if !isOperatorAllowed(f.kind, op) {
+		return nil, fmt.Errorf("operator %s is not supported by %s", op, f.column)
+	}
+
+	c := &condition{field: f, op: op, values: make([]interface{}, len(values))}
+	for i, v := range values {
+		var err error
+		switch f.kind {
+		case kindCountry:
+			c.values[i] = NormalizeCountry(v)
+		case kindLocale:
+			c.values[i] = NormalizeLocale(v)
+		case kindPlatform:
+			t, ok := platforms[strings.ToLower(v)]
+			if !ok {
+				return nil, fmt.Errorf("unknown platform %q", v)
+			}
+			c.values[i] = t
+		case kindVersion:
+			c.values[i], err = NormalizeVersion(v)
+		case kindTime:
+			c.values[i], err = parseTimeValue(v)
+		default:
+			c.values[i] = v
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *condition) predicate(now time.Time) *sql.Predicate {
+	switch c.kind {
+	case kindLocale:
+		return c.localePredicate()
+	case kindTags:
+		return c.tagsPredicate()
+	}
+
+	values := c.values
+	if c.kind == kindTime {
+		values = []interface{}{c.values[0].(timeValue).resolve(now)}
+	}
+	switch c.op {
+	case "=":
+		return sql.EQ(c.column, values[0])
+	case "!=":
+		return sql.NEQ(c.column, values[0])
+	case ">":
+		return sql.GT(c.column, values[0])
+	case ">=":
+		return sql.GTE(c.column, values[0])
+	case "<":
+		return sql.LT(c.column, values[0])
+	case "<=":
+		return sql.LTE(c.column, values[0])
+	case "NOT IN":
+		return sql.NotIn(c.column, values...)
+	default:
+		return sql.In(c.column, values...)
+	}
+}
+
+// localePredicate matches the language with any region, e.g. ja matches ja and ja-JP
+func (c *condition) localePredicate() *sql.Predicate {
+	preds := make([]*sql.Predicate, 0, len(c.values))
+	for _, v := range c.values {
+		locale := v.(string)
+		if strings.Contains(locale, "-") {
+			preds = append(preds, sql.EQ(c.column, locale))
+		} else {
+			preds = append(preds, sql.Or(sql.EQ(c.column, locale), sql.HasPrefix(c.column, locale+"-")))
+		}
+	}
+	pred := sql.Or(preds...)
+	if c.op == "!=" || c.op == "NOT IN" {
+		return sql.Not(pred)
+	}
+	return pred
+}
+
+// tagsPredicate matches the devices which have any of the tags
+func (c *condition) tagsPredicate() *sql.Predicate {
+	preds := make([]*sql.Predicate, 0, len(c.values))
+	for _, v := range c.values {
+		preds = append(preds, sqljson.ValueContains(c.column, v))
+	}
+	pred := sql.Or(preds...)
+	if c.op == "NOT IN" {
+		return sql.Not(pred)
+	}
+	return pred
+}
+
+func isOperatorAllowed(kind fieldKind, op string) bool {
+	for _, allowed := range kindOperators[kind] {
+		if allowed == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeValue parses a date, RFC 3339 time or a duration relative to now such as -7d, -12h
+func parseTimeValue(v string) (timeValue, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return timeValue{at: t}, nil
+	}
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return timeValue{at: t}, nil
+	}
+
+	if strings.HasSuffix(v, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(v, "d"))
+		if err != nil {
+			return timeValue{}, fmt.Errorf("invalid time %q", v)
+		}
+		return timeValue{offset: time.Duration(days) * 24 * time.Hour, relative: true}, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return timeValue{}, fmt.Errorf("invalid time %q", v)
+	}
+	return timeValue{offset: d, relative: true}, nil
+}
+
+// Segment is the compiled segment expression
+type Segment struct {
+	expr string
+	root node
+}
+
+// Parse parses the segment expression
+func Parse(expr string) (*Segment, error) {
+	expr = strings.TrimSpace(expr)
+	switch {
+	case len(expr) <= 0:
+		return nil, fmt.Errorf("segment is empty")
+	case len(expr) > maxExpressionLength:
+		return nil, fmt.Errorf("segment is longer than %d characters", maxExpressionLength)
+	}
+
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at %d", t.value, t.pos)
+	}
+	return &Segment{expr: expr, root: root}, nil
+}
+
+func (s *Segment) String() string {
+	return s.expr
+}
+
+// P returns the SQL predicate of the segment, the relative time is resolved with now
+func (s *Segment) P(now time.Time) *sql.Predicate {
+	return s.root.predicate(now)
+}
+
+// Predicate returns the segment as the predicate of ent query
+func (s *Segment) Predicate() predicate.UserPlatformTokens {
+	return func(selector *sql.Selector) {
+		selector.Where(s.P(time.Now()))
+	}
+}
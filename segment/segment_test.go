@@ -0,0 +1,121 @@
+package segment
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	now := time.Date(2022, 6, 8, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		wantSQL  string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "version and locale",
+			expr:     "app_version >= 3.2 AND locale IN (ja, zh)",
+			wantSQL:  "SELECT * FROM `user_platform_tokens` WHERE `app_version_num` >= ? AND ((`locale` = ? OR `locale` LIKE ?) OR (`locale` = ? OR `locale` LIKE ?))",
+			wantArgs: []interface{}{int64(3000002000000), "ja", "ja-%", "zh", "zh-%"},
+		},
+		{
+			name:     "locale with region matches exactly",
+			expr:     "locale = zh_CN",
+			wantSQL:  "SELECT * FROM `user_platform_tokens` WHERE `locale` = ?",
+			wantArgs: []interface{}{"zh-CN"},
+		},
+		{
+			name:     "or has lower precedence than and",
+			expr:     "country = jp OR platform = ios AND os_version < 15",
+			wantSQL:  "SELECT * FROM `user_platform_tokens` WHERE `country` = ? OR (`type` = ? AND `os_version_num` < ?)",
+			wantArgs: []interface{}{"JP", 2, int64(15000000000000)},
+		},
+		{
+			name:     "not and parentheses",
+			expr:     "NOT (tags CONTAINS beta OR country NOT IN ('US', 'CA')) and last_active_at >= -7d",
+			wantSQL:  "SELECT * FROM `user_platform_tokens` WHERE (NOT (JSON_CONTAINS(`tags`, ?, \"$\") = ? OR `country` NOT IN (?, ?))) AND `last_active_at` >= ?",
+			wantArgs: []interface{}{`"beta"`, 1, "US", "CA", time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "absolute time",
+			expr:     "last_active_at < 2022-01-01",
+			wantSQL:  "SELECT * FROM `user_platform_tokens` WHERE `last_active_at` < ?",
+			wantArgs: []interface{}{time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if !assert.NoError(t, err) {
+				return
+			}
+			query, args := sql.Dialect(dialect.MySQL).
+				Select("*").
+				From(sql.Table("user_platform_tokens")).
+				Where(s.P(now)).
+				Query()
+			assert.Equal(t, tt.wantSQL, query)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	tests := []string{
+		"",
+		"unknown = 1",
+		"app_version >= ",
+		"app_version >= abc",
+		"locale > ja",
+		"tags = vip",
+		"platform = windows",
+		"last_active_at >= yesterday",
+		"(country = JP",
+		"country = JP)",
+		"country IN (JP,",
+		"country = 'JP",
+		"country = JP AND",
+		"country NOT JP",
+	}
+	for _, expr := range tests {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    int64
+		wantErr bool
+	}{
+		{version: "3", want: 3000000000000},
+		{version: "3.2", want: 3000002000000},
+		{version: "3.2.0", want: 3000002000000},
+		{version: "v3.10.1", want: 3000010000001},
+		{version: "3.2.1-beta", want: 3000002000001},
+		{version: "3.2.1.4", want: 3000002000001},
+		{version: "", wantErr: true},
+		{version: "beta", wantErr: true},
+		{version: "3..1", wantErr: true},
+		{version: "1000000.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeVersion(tt.version)
+		if tt.wantErr {
+			assert.Error(t, err, tt.version)
+			continue
+		}
+		assert.NoError(t, err, tt.version)
+		assert.Equal(t, tt.want, got, tt.version)
+	}
+	// versions are compared numerically rather than lexically
+	v9, _ := NormalizeVersion("3.9")
+	v10, _ := NormalizeVersion("3.10")
+	assert.Less(t, v9, v10)
+}
@@ -0,0 +1,43 @@
+package segment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	versionParts   = 3
+	versionPartMax = 1000000
+)
+
+// NormalizeVersion converts a dotted version such as 3.2.1 to a sortable number,
+// missing parts are treated as 0 and a pre-release suffix such as -beta is ignored.
+func NormalizeVersion(version string) (int64, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexFunc(v, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }); i >= 0 {
+		v = v[:i]
+	}
+	if len(v) <= 0 {
+		return 0, fmt.Errorf("invalid version %q", version)
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > versionParts {
+		parts = parts[:versionParts]
+	}
+
+	var num int64
+	for i := 0; i < versionParts; i++ {
+		num *= versionPartMax
+		if i >= len(parts) {
+			continue
+		}
+		n, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil || n >= versionPartMax {
+			return 0, fmt.Errorf("invalid version %q", version)
+		}
+		num += n
+	}
+	return num, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.uber.org/zap"
+	"time"
+)
+
+// the paused message will be checked again after this duration
+const pausedMessageRecheckInterval = 10 * time.Second
+
+// checkActionState checks whether the action of message is paused or cancelled before sending it.
+// The cancelled message is dropped and the paused one is delayed, in both cases skip is true and the message should be acked.
+func checkActionState(ctx context.Context, psm *PushStreamMessage, message *redisqueue.Message) (skip bool, err error) {
+	redisClient := cache.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || redisClient == nil {
+		return false, nil
+	}
+
+	state, err := action.GetState(ctx, redisClient, psm.ActionId)
+	if err != nil {
+		log.WithCtx(ctx).Error("Push: failed to get action state", zap.Error(err))
+		return false, err
+	}
+
+	switch state {
+	case action.StateCancelled:
+		log.WithCtx(ctx).Info("Push: action is cancelled, drop the message")
+		recordActionStat(ctx, psm, action.StatCancelled, 1)
+		recordDeliveryResult(ctx, psm, models.DeliverySuppressed, models.ReasonCancelled, nil)
+		return true, nil
+	case action.StatePaused:
+		err = mq.Delay(ctx, redisClient, message.Stream, message.Values, time.Now().Add(pausedMessageRecheckInterval))
+		if err != nil {
+			log.WithCtx(ctx).Error("Push: failed to delay the message of paused action", zap.Error(err))
+			return false, err
+		}
+		log.WithCtx(ctx).Debug("Push: action is paused, delay the message")
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// recordActionStat increases the stat of the action, the stat of variant is increased too if the message is a variant
+func recordActionStat(ctx context.Context, psm *PushStreamMessage, stat string, n int64) {
+	redisClient := cache.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || redisClient == nil {
+		return
+	}
+
+	var err error
+	if len(psm.Variant) > 0 {
+		err = action.IncrVariantStat(ctx, redisClient, psm.ActionId, psm.Variant, stat, n)
+	} else {
+		err = action.IncrStat(ctx, redisClient, psm.ActionId, stat, n)
+	}
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to record action stat",
+			zap.String("variant", psm.Variant),
+			zap.String("stat", stat),
+			zap.Error(err),
+		)
+	}
+}
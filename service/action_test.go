@@ -0,0 +1,43 @@
+package service
+
+import (
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/redisqueue/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCheckActionState(t *testing.T) {
+	ctx, _ := newTestContext(t)
+	client := cache.GetFromContext(ctx)
+	psm := &PushStreamMessage{ActionId: "action", Token: "token"}
+	message := &redisqueue.Message{ID: "1-0", Stream: mq.PushMessageStreamKey, Values: map[string]interface{}{"action_id": "action"}}
+
+	skip, err := checkActionState(ctx, psm, message)
+	assert.NoError(t, err)
+	assert.False(t, skip)
+
+	// the message of paused action is delayed
+	_, err = action.Transition(ctx, client, "action", action.StatePaused)
+	assert.NoError(t, err)
+	skip, err = checkActionState(ctx, psm, message)
+	assert.NoError(t, err)
+	assert.True(t, skip)
+	assert.Equal(t, int64(1), client.ZCard(ctx, mq.DelayedMessageKey).Val())
+	stats, err := action.GetStats(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.Zero(t, stats[action.StatCancelled])
+
+	// the message of cancelled action is dropped and counted
+	_, err = action.Transition(ctx, client, "action", action.StateCancelled)
+	assert.NoError(t, err)
+	skip, err = checkActionState(ctx, psm, message)
+	assert.NoError(t, err)
+	assert.True(t, skip)
+	assert.Equal(t, int64(1), client.ZCard(ctx, mq.DelayedMessageKey).Val())
+	stats, err = action.GetStats(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), stats[action.StatCancelled])
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent/deliveryresult"
+	"github.com/shitamachi/push-service/log"
+	"go.uber.org/zap"
+)
+
+const maxDeliveryReasonLength = 255
+
+// recordDeliveryResult saves the delivery result of the message, there is only one result for each token of an action,
+// so the result of a retried message overwrites the previous one.
+func recordDeliveryResult(ctx context.Context, psm *PushStreamMessage, status, reason string, platformResp interface{}) {
+	client := db.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || client == nil {
+		return
+	}
+
+	// the reason is saved in a varchar column
+	reason = truncate(reason, maxDeliveryReasonLength)
+
+	var resp string
+	if platformResp != nil {
+		bytes, err := json.Marshal(platformResp)
+		if err != nil {
+			log.WithCtx(ctx).Warn("Push: failed to encode platform response of delivery result", zap.Error(err))
+		} else {
+			resp = string(bytes)
+		}
+	}
+
+	n, err := client.DeliveryResult.Update().
+		Where(
+			deliveryresult.ActionID(psm.ActionId),
+			deliveryresult.Token(psm.Token),
+		).
+		SetStatus(status).
+		SetReason(reason).
+		SetPlatformResp(resp).
+		Save(ctx)
+	if err == nil && n <= 0 {
+		_, err = client.DeliveryResult.Create().
+			SetActionID(psm.ActionId).
+			SetAppID(psm.AppId).
+			SetUserID(psm.UserId).
+			SetToken(psm.Token).
+			SetCategory(psm.Category).
+			SetVariant(psm.Variant).
+			SetTemplateID(psm.TemplateId).
+			SetTrackingID(psm.TrackingId).
+			SetStatus(status).
+			SetReason(reason).
+			SetPlatformResp(resp).
+			Save(ctx)
+	}
+	if err != nil {
+		log.WithCtx(ctx).Error("Push: failed to save delivery result",
+			zap.String("status", status),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/log"
+	"go.uber.org/zap"
+	"time"
+)
+
+const frequencyCapKeyPrefix = "push_frequency_cap"
+
+// capScript checks the sliding windows of a user, ARGV[1] is the member which identifies a push,
+// ARGV[i * 2] and ARGV[i * 2 + 1] are the limit and period (ms) of KEYS[i].
+// The push is only counted when it does not exceed any of the windows,
+// a push which has been counted before (e.g. the same action to another device of the user) is always allowed.
+var capScript = redis.NewScript(`
+redis.replicate_commands()
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local member = ARGV[1]
+for i = 1, #KEYS do
+    local limit = tonumber(ARGV[i * 2])
+    local period = tonumber(ARGV[i * 2 + 1])
+    redis.call('ZREMRANGEBYSCORE', KEYS[i], '-inf', now - period)
+    if redis.call('ZSCORE', KEYS[i], member) == false and redis.call('ZCARD', KEYS[i]) >= limit then
+        return 0
+    end
+end
+for i = 1, #KEYS do
+    local period = tonumber(ARGV[i * 2 + 1])
+    if redis.call('ZSCORE', KEYS[i], member) == false then
+        redis.call('ZADD', KEYS[i], now, member)
+    end
+    redis.call('PEXPIRE', KEYS[i], period)
+end
+return 1
+`)
+
+// isFrequencyCapped checks whether the user has received too many pushes of the category within the configured periods.
+// The transactional messages and the messages without user id are never capped.
+// The push is counted when it is checked before sending rather than after it is sent, so that the concurrent consumers
+// can never exceed the cap; the tradeoff is that a push which fails to send still counts against the windows of user.
+func isFrequencyCapped(ctx context.Context, psm *PushStreamMessage, messageId string) bool {
+	conf := config.GetFromContext(ctx).FrequencyCap
+	if !conf.Enable || psm.IsTransactional() || len(psm.UserId) <= 0 {
+		return false
+	}
+	redisClient := cache.GetFromContext(ctx)
+	if redisClient == nil {
+		return false
+	}
+
+	var (
+		keys []string
+		args []interface{}
+	)
+	member := psm.ActionId
+	if len(member) <= 0 {
+		member = messageId
+	}
+	args = append(args, member)
+	for _, rule := range conf.Apps[psm.AppId] {
+		if rule.Limit <= 0 || rule.Period <= 0 || (len(rule.Category) > 0 && rule.Category != psm.Category) {
+			continue
+		}
+		category := rule.Category
+		if len(category) <= 0 {
+			category = "*"
+		}
+		keys = append(keys, fmt.Sprintf("%s:%s:%s:%d:%s", frequencyCapKeyPrefix, psm.AppId, category, rule.Period, psm.UserId))
+		args = append(args, rule.Limit, (time.Duration(rule.Period) * time.Second).Milliseconds())
+	}
+	if len(keys) <= 0 {
+		return false
+	}
+
+	allowed, err := capScript.Run(ctx, redisClient, keys, args...).Int()
+	if err != nil {
+		// the push should not be blocked when the frequency cap is unavailable
+		log.WithCtx(ctx).Warn("FrequencyCap: failed to check frequency cap, skip it",
+			zap.Error(err),
+		)
+		return false
+	}
+	return allowed != 1
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/push-service/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestIsFrequencyCapped(t *testing.T) {
+	ctx, m := newTestContext(t)
+	now := time.UnixMilli(1650000000000)
+	m.SetTime(now)
+	config.GetFromContext(ctx).FrequencyCap = config_entries.FrequencyCapConfig{
+		Enable: true,
+		Apps: map[string][]config_entries.FrequencyCapRule{
+			"app": {
+				// at most 2 pushes of any category per hour
+				{Limit: 2, Period: 3600},
+				// at most 1 promotion per day
+				{Category: "promotions", Limit: 1, Period: 86400},
+			},
+		},
+	}
+	newMessage := func(actionId, category string) *PushStreamMessage {
+		psm := &PushStreamMessage{AppId: "app", UserId: "user", ActionId: actionId}
+		psm.Category = category
+		return psm
+	}
+
+	assert.False(t, isFrequencyCapped(ctx, newMessage("a1", "promotions"), "1-0"))
+	// the promotions rule is full, the push is not counted by the other rule
+	assert.True(t, isFrequencyCapped(ctx, newMessage("a2", "promotions"), "2-0"))
+	assert.False(t, isFrequencyCapped(ctx, newMessage("a3", "news"), "3-0"))
+	// the category * rule applies to the messages of all categories
+	assert.True(t, isFrequencyCapped(ctx, newMessage("a4", "news"), "4-0"))
+	// the same action to another device of the user has been counted
+	assert.False(t, isFrequencyCapped(ctx, newMessage("a3", "news"), "5-0"))
+
+	// the transactional messages, the messages without user id and the other apps are never capped
+	transactional := newMessage("a5", "news")
+	transactional.Priority = models.TransactionalPriority
+	assert.False(t, isFrequencyCapped(ctx, transactional, "6-0"))
+	assert.False(t, isFrequencyCapped(ctx, &PushStreamMessage{AppId: "app", ActionId: "a6"}, "7-0"))
+	assert.False(t, isFrequencyCapped(ctx, &PushStreamMessage{AppId: "other", UserId: "user", ActionId: "a7"}, "8-0"))
+
+	// the window slides
+	m.SetTime(now.Add(time.Hour))
+	assert.False(t, isFrequencyCapped(ctx, newMessage("a8", "news"), "9-0"))
+	assert.True(t, isFrequencyCapped(ctx, newMessage("a9", "promotions"), "10-0"))
+	m.SetTime(now.Add(24 * time.Hour))
+	assert.False(t, isFrequencyCapped(ctx, newMessage("a9", "promotions"), "10-0"))
+}
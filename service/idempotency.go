@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/mq"
+	"go.uber.org/zap"
+)
+
+const (
+	sentMarkKeyPrefix = "push_sent"
+	sentMarkSending   = "sending"
+	// the sent mark is followed by the id of the message which is sent, e.g. sent:1526919030474-55
+	sentMarkSentPrefix = "sent:"
+	sentMarkFailed     = "failed"
+	// the sending mark expires when the message is reclaimed, so that it can be sent again if the consumer crashed
+	sendingMarkTTL = mq.VisibilityTimeout
+)
+
+type sendClaim int
+
+const (
+	// the message of the action has been sent to the token by another message
+	claimRejected sendClaim = iota
+	// the message is sent to the token for the first time
+	claimFirstTime
+	// the message was failed to send before and is retried now
+	claimRetried
+	// the message is being sent by another consumer
+	claimInProgress
+	// the message itself has been sent
+	claimSent
+)
+
+// claimScript marks the message as sending if it has never been sent or was failed to send
+var claimScript = redis.NewScript(`
+local mark = redis.call('GET', KEYS[1])
+if mark == false or mark == ARGV[2] then
+    redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[3])
+    if mark == false then
+        return 1
+    end
+    return 2
+end
+if mark == ARGV[1] then
+    return 3
+end
+if mark == ARGV[4] then
+    return 4
+end
+return 0
+`)
+
+func getSentMarkKey(psm *PushStreamMessage) string {
+	return fmt.Sprintf("%s:%s:%s", sentMarkKeyPrefix, psm.ActionId, psm.Token)
+}
+
+// claimSend marks the message of the action as being sent to the token,
+// claimSent is returned if the message has been sent, claimRejected if another message has been sent to the token
+// and claimInProgress if it is being sent by another consumer.
+func claimSend(ctx context.Context, psm *PushStreamMessage, messageId string) sendClaim {
+	redisClient := cache.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || redisClient == nil {
+		return claimFirstTime
+	}
+
+	res, err := claimScript.Run(ctx, redisClient, []string{getSentMarkKey(psm)},
+		sentMarkSending, sentMarkFailed, sendingMarkTTL.Milliseconds(), sentMarkSentPrefix+messageId).Int()
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to claim message sending, send it anyway",
+			zap.Error(err),
+		)
+		return claimFirstTime
+	}
+	return sendClaim(res)
+}
+
+// markSent keeps the sent mark within the idempotency window after the message is sent successfully
+func markSent(ctx context.Context, psm *PushStreamMessage, messageId string) {
+	setSentMark(ctx, psm, sentMarkSentPrefix+messageId)
+}
+
+// markFailed allows the failed message to be sent again when it is retried
+func markFailed(ctx context.Context, psm *PushStreamMessage) {
+	setSentMark(ctx, psm, sentMarkFailed)
+}
+
+// releaseSend restores the mark before the message is claimed, it is used when the message is not sent but deferred
+func releaseSend(ctx context.Context, psm *PushStreamMessage, claim sendClaim) {
+	redisClient := cache.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || redisClient == nil {
+		return
+	}
+
+	if claim == claimRetried {
+		markFailed(ctx, psm)
+		return
+	}
+
+	err := redisClient.Del(ctx, getSentMarkKey(psm)).Err()
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to release message sending mark",
+			zap.Error(err),
+		)
+	}
+}
+
+func setSentMark(ctx context.Context, psm *PushStreamMessage, mark string) {
+	redisClient := cache.GetFromContext(ctx)
+	if len(psm.ActionId) <= 0 || redisClient == nil {
+		return
+	}
+
+	window := config.GetFromContext(ctx).Idempotency.GetWindow()
+	err := redisClient.Set(ctx, getSentMarkKey(psm), mark, window).Err()
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to set message sent mark",
+			zap.String("mark", mark),
+			zap.Error(err),
+		)
+	}
+}
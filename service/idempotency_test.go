@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+)
+
+// newTestContext returns the context with the config, logger and a redis client of miniredis
+func newTestContext(t *testing.T) (context.Context, *miniredis.Miniredis) {
+	m := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: m.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	ctx := config.SetToContext(context.Background(), &config.AppConfig{})
+	ctx = log.SetLoggerToContext(ctx, zap.NewNop())
+	ctx = cache.SetToContext(ctx, client)
+	return ctx, m
+}
+
+func TestClaimSend(t *testing.T) {
+	ctx, m := newTestContext(t)
+	psm := &PushStreamMessage{ActionId: "action", Token: "token"}
+
+	assert.Equal(t, claimFirstTime, claimSend(ctx, psm, "1-0"))
+	assert.Equal(t, sendingMarkTTL, m.TTL(getSentMarkKey(psm)))
+	// e.g. the token appears twice in the request
+	assert.Equal(t, claimInProgress, claimSend(ctx, psm, "2-0"))
+
+	// the failed message can be retried
+	markFailed(ctx, psm)
+	assert.Equal(t, claimRetried, claimSend(ctx, psm, "1-0"))
+
+	markSent(ctx, psm, "1-0")
+	// the message was sent but not acked
+	assert.Equal(t, claimSent, claimSend(ctx, psm, "1-0"))
+	// another message of the action is sent to the token
+	assert.Equal(t, claimRejected, claimSend(ctx, psm, "2-0"))
+
+	// the message without action id is always sent
+	assert.Equal(t, claimFirstTime, claimSend(ctx, &PushStreamMessage{Token: "token"}, "3-0"))
+}
+
+func TestClaimSendAfterCrash(t *testing.T) {
+	ctx, m := newTestContext(t)
+	psm := &PushStreamMessage{ActionId: "action", Token: "token"}
+
+	assert.Equal(t, claimFirstTime, claimSend(ctx, psm, "1-0"))
+	// the consumer crashed, the message is reclaimed after the sending mark expires
+	m.FastForward(sendingMarkTTL)
+	assert.Equal(t, claimFirstTime, claimSend(ctx, psm, "1-0"))
+}
+
+func TestReleaseSend(t *testing.T) {
+	ctx, m := newTestContext(t)
+	psm := &PushStreamMessage{ActionId: "action", Token: "token"}
+
+	claim := claimSend(ctx, psm, "1-0")
+	releaseSend(ctx, psm, claim)
+	assert.False(t, m.Exists(getSentMarkKey(psm)))
+
+	markFailed(ctx, psm)
+	claim = claimSend(ctx, psm, "1-0")
+	assert.Equal(t, claimRetried, claim)
+	releaseSend(ctx, psm, claim)
+	assert.Equal(t, claimRetried, claimSend(ctx, psm, "1-0"))
+}
@@ -6,46 +6,133 @@ import (
 	"fmt"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/mitchellh/mapstructure"
-	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
 	"github.com/shitamachi/push-service/config/config_entries"
 	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/metrics"
 	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/mq"
 	"github.com/shitamachi/push-service/push"
+	"github.com/shitamachi/push-service/registry"
+	"github.com/shitamachi/push-service/webhook"
 	"github.com/shitamachi/redisqueue/v2"
 	"go.uber.org/zap"
 	"time"
 )
 
+const (
+	// the timeout of a single push request to the provider
+	pushRequestTimeout = 2 * time.Second
+	// the push request is retried in process until the budget runs out, the message is redelivered after it
+	pushRetryBudget = 4 * time.Second
+)
+
+var errMessageInProgress = errors.New("message is being sent by another consumer")
+
 type PushStreamMessage struct {
 	models.BaseMessage `mapstructure:",squash"`
 	AppId              string `json:"app_id" mapstructure:"app_id"`
 	Token              string `json:"token" mapstructure:"token"`
 	UserId             string `json:"user_id" mapstructure:"user_id"`
 	ActionId           string `json:"action_id" mapstructure:"action_id"`
+	Timezone           string `json:"timezone" mapstructure:"timezone"`
 }
 
 func ProcessPushMessage(ctx context.Context, message *redisqueue.Message) (err error) {
-	var psm = new(PushStreamMessage)
-	psm.Data = psm.BaseMessage.DecodeData(ctx)
-	err = mapstructure.Decode(message.Values, psm)
+	psm, err := decodePushStreamMessage(ctx, message)
+	if err != nil {
+		log.WithCtx(ctx).Error("Push: can not decode map to struct", zap.Any("message", message), zap.Error(err))
+		// the message can never be sent, count it as failed so that the action can still be completed
+		psm = &PushStreamMessage{ActionId: getMessageValue(message, "action_id")}
+		psm.Variant = getMessageValue(message, "variant")
+		recordActionStat(ctx, psm, action.StatFailed, 1)
+		webhook.NotifyActionCompleted(ctx, psm.ActionId)
+		return nil
+	}
+	// the action is completed once its last message is processed
+	defer webhook.NotifyActionCompleted(ctx, psm.ActionId)
+
+	if psm.IsExpired(time.Now()) {
+		log.WithCtx(ctx).Info("Push: message is expired, drop it",
+			zap.Int64("expires_at", psm.ExpiresAt),
+		)
+		recordActionStat(ctx, psm, action.StatExpired, 1)
+		recordDeliveryResult(ctx, psm, models.DeliverySuppressed, models.ReasonExpired, nil)
+		return nil
+	}
+
+	skip, err := checkActionState(ctx, psm, message)
 	if err != nil {
-		log.WithCtx(ctx).Error("Push: can not decode map to struct", zap.Any("message", message))
-		return fmt.Errorf("can not decode map to struct PushStreamMessage")
+		return failOrRetry(ctx, psm, message, err)
+	}
+	if skip {
+		return nil
 	}
 
 	client, err := getPushClientByAppId(ctx, psm.AppId)
 	if err != nil {
-		log.WithCtx(ctx).Warn("Push: can not get push message client by app id", zap.String("app_id", psm.AppId))
-		return
+		log.WithCtx(ctx).Warn("Push: can not get push message client by app id")
+		return failOrRetry(ctx, psm, message, err)
+	}
+
+	deferred, err := deferForQuietHours(ctx, psm, message)
+	if err != nil {
+		return failOrRetry(ctx, psm, message, err)
+	}
+	if deferred {
+		return nil
+	}
+
+	claim := claimSend(ctx, psm, message.ID)
+	switch claim {
+	case claimSent:
+		// the message was processed but not acked, e.g. the consumer crashed, it has been counted
+		log.WithCtx(ctx).Info("Push: message has been sent, skip it")
+		return nil
+	case claimRejected:
+		// e.g. the token appears more than once in the request
+		log.WithCtx(ctx).Info("Push: message of the action has been sent to the token, drop it")
+		recordActionStat(ctx, psm, action.StatDuplicated, 1)
+		return nil
+	case claimInProgress:
+		// keep the message pending, it is reclaimed after the sending mark expires in case the other consumer crashed
+		log.WithCtx(ctx).Info("Push: message of the action is being sent to the token, check it later")
+		return errMessageInProgress
+	}
+
+	if isFrequencyCapped(ctx, psm, message.ID) {
+		log.WithCtx(ctx).Info("Push: user has received too many pushes, drop the message",
+			zap.String("category", psm.Category),
+		)
+		markSent(ctx, psm, message.ID)
+		recordActionStat(ctx, psm, action.StatCapped, 1)
+		recordDeliveryResult(ctx, psm, models.DeliverySuppressed, models.ReasonCapped, nil)
+		return nil
+	}
+
+	deferred, err = waitForRateLimit(ctx, psm, message)
+	if err != nil || deferred {
+		releaseSend(ctx, psm, claim)
+	}
+	if err != nil {
+		return failOrRetry(ctx, psm, message, err)
+	}
+	if deferred {
+		return nil
 	}
 
+	var (
+		platformResp interface{}
+		permanent    bool
+	)
 	err = backoff.RetryNotify(
 		// operation func
 		func() error {
-			ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			ctx, cancel := context.WithTimeout(ctx, pushRequestTimeout)
 			defer cancel()
 
-			_, err = client.Push(ctx, models.NewPushMessage(psm.AppId, psm.Token).SetBaseMessage(psm.BaseMessage))
+			platformResp, err = client.Push(ctx, models.NewPushMessage(psm.AppId, psm.Token).SetBaseMessage(psm.BaseMessage))
 			switch {
 			case errors.Is(err, context.DeadlineExceeded):
 				log.WithCtx(ctx).Warn("Push: send push message timeout")
@@ -54,57 +141,143 @@ func ProcessPushMessage(ctx context.Context, message *redisqueue.Message) (err e
 				// should not retry
 				log.WithCtx(ctx).Info("Push: push notification failed",
 					zap.Error(err),
-					zap.String("app_id", psm.AppId),
 					zap.Any("message", psm.BaseMessage),
 				)
+				permanent = true
 				return backoff.Permanent(err)
 			}
 			return err
 		},
 		// backoff policy
-		backoff.WithMaxRetries(backoff.NewExponentialBackOff(), 3),
+		newPushBackOff(),
 		// notify func
 		func(err error, duration time.Duration) {
+			metrics.PushRetries.WithLabelValues(psm.AppId).Inc()
 			log.WithCtx(ctx).Info("Push: failed to push message, will retry push again",
-				zap.String("app_id", psm.AppId),
 				zap.Error(err),
 			)
 		})
 
 	if err != nil {
+		markFailed(ctx, psm)
+		// the message is redelivered later unless the error is permanent or it is the last delivery,
+		// only the final outcome is recorded and published
+		if !permanent && !isLastDelivery(ctx, message) {
+			log.WithCtx(ctx).Warn("Push: failed to push message, it will be redelivered",
+				zap.Error(err),
+			)
+			return err
+		}
+		recordFailure(ctx, psm, err.Error(), platformResp)
+		if errors.Is(err, push.TokenInvalidated) {
+			publishEvent(ctx, psm.AppId, webhook.EventTokenInvalidated, webhook.TokenInvalidatedData{
+				UserId: psm.UserId,
+				Token:  psm.Token,
+				Reason: err.Error(),
+			})
+		}
 		log.WithCtx(ctx).Error("Push: failed to push message",
-			zap.String("app_id", psm.AppId),
 			zap.Error(err),
 			zap.Any("message", psm.BaseMessage),
 		)
+		return nil
+	}
+
+	markSent(ctx, psm, message.ID)
+	recordActionStat(ctx, psm, action.StatSucceeded, 1)
+	recordDeliveryResult(ctx, psm, models.DeliverySucceeded, "", platformResp)
+	publishDeliveryEvent(ctx, psm, models.DeliverySucceeded, "")
+	return nil
+}
+
+// newPushBackOff returns the backoff policy of push requests, the retries stop once pushRetryBudget has elapsed,
+// so a push takes at most pushRetryBudget + pushRequestTimeout
+func newPushBackOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = pushRetryBudget
+	return backoff.WithMaxRetries(b, 3)
+}
+
+// failOrRetry returns the err so that the message is redelivered later,
+// but if it is the last delivery the message is counted as failed and nil is returned to ack it
+func failOrRetry(ctx context.Context, psm *PushStreamMessage, message *redisqueue.Message, err error) error {
+	if !isLastDelivery(ctx, message) {
+		return err
+	}
+	log.WithCtx(ctx).Error("Push: failed to process message, give up", zap.Error(err))
+	recordFailure(ctx, psm, err.Error(), nil)
+	return nil
+}
+
+// recordFailure counts the message as failed and publishes the result, it is called once for the final failure
+func recordFailure(ctx context.Context, psm *PushStreamMessage, reason string, platformResp interface{}) {
+	recordActionStat(ctx, psm, action.StatFailed, 1)
+	recordDeliveryResult(ctx, psm, models.DeliveryFailed, reason, platformResp)
+	publishDeliveryEvent(ctx, psm, models.DeliveryFailed, reason)
+}
+
+// isLastDelivery reports whether the message will not be redelivered if it fails this time
+func isLastDelivery(ctx context.Context, message *redisqueue.Message) bool {
+	redisClient := cache.GetFromContext(ctx)
+	if redisClient == nil {
+		return true
 	}
+	last, err := mq.IsLastDelivery(ctx, redisClient, mq.PushMessageGroupKey, message)
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to get delivery count of message", zap.Error(err))
+		return false
+	}
+	return last
+}
 
-	return
+func getMessageValue(message *redisqueue.Message, key string) string {
+	v, ok := message.Values[key]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func decodePushStreamMessage(ctx context.Context, message *redisqueue.Message) (*PushStreamMessage, error) {
+	var psm = new(PushStreamMessage)
+	// all the values read from redis stream are strings, weakly typed input is required to decode the numeric fields
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           psm,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = decoder.Decode(message.Values)
+	if err != nil {
+		return nil, err
+	}
+	// the encoded data is collected by the remain field, decode it back to the original data map
+	psm.Data = psm.BaseMessage.DecodeData(ctx)
+	return psm, nil
 }
 
 func getPushClientByAppId(ctx context.Context, appID string) (push.Pusher, error) {
-	conf := config.GetFromContext(ctx)
 	err := fmt.Errorf("can not get push client item form config by app id=\"%s\"", appID)
 
 	if len(appID) <= 0 {
 		return nil, err
 	}
 
-	item, ok := conf.ClientConfig[appID]
+	// the app is in config file or in the app registry
+	pushType, ok := registry.GetPushType(ctx, appID)
 	if ok {
-		switch item.PushType {
+		switch pushType {
 		case config_entries.ApplePush:
 			return push.GlobalApplePushClient, nil
 		case config_entries.FirebasePush:
 			return push.GlobalFirebasePushClient, nil
 		default:
-			log.WithCtx(ctx).Warn("Push: can not match app id with anyone in config",
-				zap.String("app_id", appID))
+			log.WithCtx(ctx).Warn("Push: can not match app id with anyone in config")
 			return nil, err
 		}
 	} else {
-		log.WithCtx(ctx).Warn("Push: can not get push client item form config by app id",
-			zap.String("app_id", appID))
+		log.WithCtx(ctx).Warn("Push: can not get push client item form config by app id")
 		return nil, err
 	}
 }
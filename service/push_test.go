@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/redisqueue/v2"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestProcessPushMessageExpired(t *testing.T) {
+	ctx, m := newTestContext(t)
+	client := cache.GetFromContext(ctx)
+	message := &redisqueue.Message{
+		ID:     "1-0",
+		Stream: mq.PushMessageStreamKey,
+		Values: map[string]interface{}{
+			"app_id":     "app",
+			"token":      "token",
+			"action_id":  "action",
+			"title":      "title",
+			"expires_at": strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10),
+		},
+	}
+
+	// the expired message is dropped before anything else, it is acked and counted as expired
+	assert.NoError(t, ProcessPushMessage(ctx, message))
+	stats, err := action.GetStats(ctx, client, "action")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), stats[action.StatExpired])
+	assert.Zero(t, stats[action.StatFailed])
+	assert.False(t, m.Exists(getSentMarkKey(&PushStreamMessage{ActionId: "action", Token: "token"})))
+}
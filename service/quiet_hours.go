@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/userquiethours"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const (
+	// the quiet hours of user are read for every non-transactional message, so they are cached for a while
+	// instead of being queried each time. The changes made on other replicas take effect after the cache expires.
+	userQuietHoursCacheTTL = 30 * time.Second
+	// the expired items are removed when the cache is full, the whole cache is dropped if it is still full
+	maxCachedUserQuietHours = 100000
+)
+
+type cachedUserQuietHours struct {
+	// nil if the user does not set the quiet hours
+	quietHours *ent.UserQuietHours
+	expiresAt  time.Time
+}
+
+var userQuietHoursCache = struct {
+	sync.Mutex
+	items map[string]cachedUserQuietHours
+}{items: make(map[string]cachedUserQuietHours)}
+
+func getUserQuietHoursCacheKey(appId, userId string) string {
+	return appId + ":" + userId
+}
+
+// InvalidateUserQuietHours removes the cached quiet hours of user, it is called when the quiet hours are changed
+func InvalidateUserQuietHours(appId, userId string) {
+	userQuietHoursCache.Lock()
+	delete(userQuietHoursCache.items, getUserQuietHoursCacheKey(appId, userId))
+	userQuietHoursCache.Unlock()
+}
+
+// getUserQuietHours returns the quiet hours set by the user, nil is returned if there is no setting
+func getUserQuietHours(ctx context.Context, client *ent.Client, appId, userId string) (*ent.UserQuietHours, error) {
+	key := getUserQuietHoursCacheKey(appId, userId)
+	now := time.Now()
+	userQuietHoursCache.Lock()
+	cached, ok := userQuietHoursCache.items[key]
+	userQuietHoursCache.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.quietHours, nil
+	}
+
+	userQuietHours, err := client.UserQuietHours.Query().
+		Where(
+			userquiethours.UserID(userId),
+			userquiethours.AppID(appId),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		userQuietHours = nil
+	case err != nil:
+		return nil, err
+	}
+
+	userQuietHoursCache.Lock()
+	if len(userQuietHoursCache.items) >= maxCachedUserQuietHours {
+		for k, item := range userQuietHoursCache.items {
+			if !now.Before(item.expiresAt) {
+				delete(userQuietHoursCache.items, k)
+			}
+		}
+		if len(userQuietHoursCache.items) >= maxCachedUserQuietHours {
+			userQuietHoursCache.items = make(map[string]cachedUserQuietHours)
+		}
+	}
+	userQuietHoursCache.items[key] = cachedUserQuietHours{
+		quietHours: userQuietHours,
+		expiresAt:  now.Add(userQuietHoursCacheTTL),
+	}
+	userQuietHoursCache.Unlock()
+	return userQuietHours, nil
+}
+
+// getQuietHours returns the quiet hours of the user, the user's own setting overrides the one of app
+func getQuietHours(ctx context.Context, psm *PushStreamMessage) (*models.QuietHours, error) {
+	appQuietHours, hasAppQuietHours := config.GetFromContext(ctx).QuietHours.Apps[psm.AppId]
+
+	client := db.GetFromContext(ctx)
+	if len(psm.UserId) > 0 && client != nil {
+		userQuietHours, err := getUserQuietHours(ctx, client, psm.AppId, psm.UserId)
+		switch {
+		case err != nil:
+			return nil, err
+		case userQuietHours == nil:
+		case !userQuietHours.Enabled:
+			return nil, nil
+		default:
+			loc, err := models.LoadLocation(userQuietHours.Timezone, psm.Timezone, appQuietHours.Timezone)
+			if err != nil {
+				return nil, err
+			}
+			return &models.QuietHours{
+				Start:    userQuietHours.Start,
+				End:      userQuietHours.End,
+				Location: loc,
+			}, nil
+		}
+	}
+
+	if !hasAppQuietHours {
+		return nil, nil
+	}
+	loc, err := models.LoadLocation(psm.Timezone, appQuietHours.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return &models.QuietHours{
+		Start:    appQuietHours.Start,
+		End:      appQuietHours.End,
+		Location: loc,
+	}, nil
+}
+
+// deferForQuietHours delays the non-transactional message until the end of the quiet hours of user,
+// deferred is true if the message is delayed and it should be acked.
+func deferForQuietHours(ctx context.Context, psm *PushStreamMessage, message *redisqueue.Message) (deferred bool, err error) {
+	if psm.IsTransactional() {
+		return false, nil
+	}
+	redisClient := cache.GetFromContext(ctx)
+	if redisClient == nil {
+		return false, nil
+	}
+
+	quietHours, err := getQuietHours(ctx, psm)
+	if err != nil {
+		// the message should still be sent when the quiet hours are not available
+		log.WithCtx(ctx).Warn("QuietHours: failed to get quiet hours, skip it",
+			zap.Error(err),
+		)
+		return false, nil
+	}
+	if quietHours == nil {
+		return false, nil
+	}
+
+	end, in, err := quietHours.GetEnd(time.Now())
+	if err != nil {
+		log.WithCtx(ctx).Warn("QuietHours: invalid quiet hours, skip it",
+			zap.Error(err),
+		)
+		return false, nil
+	}
+	if !in {
+		return false, nil
+	}
+
+	err = mq.Delay(ctx, redisClient, message.Stream, message.Values, end)
+	if err != nil {
+		log.WithCtx(ctx).Error("QuietHours: failed to delay message", zap.Error(err))
+		return false, err
+	}
+	log.WithCtx(ctx).Info("QuietHours: user is in quiet hours, delay the message",
+		zap.Time("until", end),
+	)
+	recordActionStat(ctx, psm, action.StatDeferred, 1)
+	return true, nil
+}
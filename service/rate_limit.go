@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/config"
+	"github.com/shitamachi/push-service/limiter"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/push-service/registry"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.uber.org/zap"
+	"time"
+)
+
+// the in-process wait plus the push retries must finish within the visibility timeout of the consumer,
+// otherwise the message is reclaimed and sent again by another consumer. It is also the default max wait time.
+const maxRateLimitWaitTime = mq.VisibilityTimeout - pushRequestTimeout - pushRetryBudget - time.Second
+
+// waitForRateLimit blocks until the message is allowed by the rate limits of its app and provider.
+// If the message has to wait longer than the max wait time, it will be delayed and delivered to the stream
+// again later instead of holding the consumer, in this case deferred is true and the message should be acked.
+func waitForRateLimit(ctx context.Context, psm *PushStreamMessage, message *redisqueue.Message) (deferred bool, err error) {
+	conf := config.GetFromContext(ctx)
+	if !conf.RateLimit.Enable {
+		return false, nil
+	}
+	redisClient := cache.GetFromContext(ctx)
+	if redisClient == nil {
+		log.WithCtx(ctx).Warn("RateLimit: can not get redis client from context, skip rate limit")
+		return false, nil
+	}
+	pushType, _ := registry.GetPushType(ctx, psm.AppId)
+	buckets := limiter.GetBuckets(conf.RateLimit, psm.AppId, pushType)
+	if len(buckets) <= 0 {
+		return false, nil
+	}
+
+	maxWaitTime := maxRateLimitWaitTime
+	if wait := time.Duration(conf.RateLimit.MaxWaitTime) * time.Millisecond; wait > 0 && wait < maxWaitTime {
+		maxWaitTime = wait
+	}
+	deadline := time.Now().Add(maxWaitTime)
+
+	for {
+		res, err := limiter.Take(ctx, redisClient, buckets...)
+		if err != nil {
+			// the push should not be blocked when the rate limiter is unavailable
+			log.WithCtx(ctx).Warn("RateLimit: failed to take token from bucket, skip rate limit",
+				zap.Error(err),
+			)
+			return false, nil
+		}
+		if res.Allowed {
+			return false, nil
+		}
+
+		if time.Now().Add(res.RetryAfter).After(deadline) {
+			err = mq.Delay(ctx, redisClient, message.Stream, message.Values, time.Now().Add(res.RetryAfter))
+			if err != nil {
+				log.WithCtx(ctx).Error("RateLimit: failed to delay throttled message", zap.Error(err))
+				return false, err
+			}
+			log.WithCtx(ctx).Info("RateLimit: message is throttled, delay it",
+				zap.Duration("retry_after", res.RetryAfter),
+			)
+			return true, nil
+		}
+
+		log.WithCtx(ctx).Debug("RateLimit: message is throttled, wait for token",
+			zap.Duration("retry_after", res.RetryAfter),
+		)
+		time.Sleep(res.RetryAfter)
+	}
+}
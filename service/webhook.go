@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/models"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/push-service/webhook"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// the delivery is given up after the number of requests
+	maxWebhookAttempts = 8
+	// each request is sent once per delivery of the message, it must finish well within the visibility timeout,
+	// otherwise the message is reclaimed and the request is sent concurrently
+	webhookRequestTimeout = 5 * time.Second
+	// the failed request is delayed and the delay doubles after each attempt with jitter
+	webhookRetryBaseDelay = 30 * time.Second
+	webhookRetryMaxDelay  = time.Hour
+	maxWebhookErrorLength = 255
+)
+
+// ProcessWebhookDelivery posts the webhook delivery to the url of its subscription
+func ProcessWebhookDelivery(ctx context.Context, message *redisqueue.Message) error {
+	client := db.GetFromContext(ctx)
+	deliveryId, err := strconv.Atoi(fmt.Sprint(message.Values["delivery_id"]))
+	if err != nil {
+		log.WithCtx(ctx).Error("Webhook: invalid delivery id", zap.Any("message", message), zap.Error(err))
+		return nil
+	}
+
+	delivery, err := client.WebhookDelivery.Get(ctx, deliveryId)
+	switch {
+	case ent.IsNotFound(err):
+		log.WithCtx(ctx).Warn("Webhook: delivery not found", zap.Int("delivery_id", deliveryId))
+		return nil
+	case err != nil:
+		log.WithCtx(ctx).Error("Webhook: failed to get delivery", zap.Int("delivery_id", deliveryId), zap.Error(err))
+		return err
+	case delivery.Status == webhook.StatusSucceeded || delivery.Status == webhook.StatusFailed:
+		return nil
+	}
+
+	subscription, err := client.WebhookSubscription.Get(ctx, delivery.SubscriptionID)
+	switch {
+	case ent.IsNotFound(err) || err == nil && !subscription.Enabled:
+		return client.WebhookDelivery.UpdateOne(delivery).
+			SetStatus(webhook.StatusFailed).
+			SetError("subscription is deleted or disabled").
+			Exec(ctx)
+	case err != nil:
+		log.WithCtx(ctx).Error("Webhook: failed to get subscription", zap.Int("subscription_id", delivery.SubscriptionID), zap.Error(err))
+		return err
+	}
+
+	attempts := delivery.Attempts + 1
+	sendCtx, cancel := context.WithTimeout(ctx, webhookRequestTimeout)
+	code, err := webhook.Send(sendCtx, subscription, delivery)
+	cancel()
+	status, delay, err := nextWebhookState(attempts, code, err)
+
+	update := client.WebhookDelivery.UpdateOne(delivery).SetAttempts(attempts).SetStatus(status)
+	if code > 0 {
+		update.SetResponseCode(code)
+	}
+	switch status {
+	case webhook.StatusSucceeded:
+		update.SetError("")
+	case webhook.StatusFailed:
+		log.WithCtx(ctx).Error("Webhook: failed to send webhook, give up",
+			zap.Int("delivery_id", delivery.ID),
+			zap.Int("attempts", attempts),
+			zap.Error(err),
+		)
+		update.SetError(truncate(err.Error(), maxWebhookErrorLength))
+	default:
+		at := time.Now().Add(delay)
+		if delayErr := mq.Delay(ctx, cache.GetFromContext(ctx), message.Stream, message.Values, at); delayErr != nil {
+			log.WithCtx(ctx).Error("Webhook: failed to delay the delivery", zap.Int("delivery_id", delivery.ID), zap.Error(delayErr))
+			return delayErr
+		}
+		log.WithCtx(ctx).Info("Webhook: failed to send webhook, retry it later",
+			zap.Int("delivery_id", delivery.ID),
+			zap.Time("at", at),
+			zap.Error(err),
+		)
+		update.SetError(truncate(err.Error(), maxWebhookErrorLength))
+	}
+
+	err = update.Exec(ctx)
+	if err != nil {
+		log.WithCtx(ctx).Error("Webhook: failed to update delivery", zap.Int("delivery_id", delivery.ID), zap.Error(err))
+	}
+	return nil
+}
+
+// nextWebhookState returns the status of delivery after it is sent for the attempts-th time with the result code and err,
+// the delivery should be sent again after the delay if it is retrying. The returned error describes the failure.
+func nextWebhookState(attempts, code int, err error) (webhook.DeliveryStatus, time.Duration, error) {
+	switch {
+	case err != nil:
+	case code >= http.StatusOK && code < http.StatusMultipleChoices:
+		return webhook.StatusSucceeded, 0, nil
+	case code == http.StatusTooManyRequests || code >= http.StatusInternalServerError:
+		err = fmt.Errorf("webhook endpoint responded with status %d", code)
+	default:
+		// the other client errors will not be fixed by retrying
+		return webhook.StatusFailed, 0, fmt.Errorf("webhook endpoint responded with status %d", code)
+	}
+	if attempts >= maxWebhookAttempts {
+		return webhook.StatusFailed, 0, err
+	}
+	return webhook.StatusRetrying, getWebhookRetryDelay(attempts), err
+}
+
+// getWebhookRetryDelay returns the delay before the next attempt, the backoff policy is stateless between the attempts
+// as each attempt is sent by a delivery of message, so the backoff is replayed to the attempts-th interval
+func getWebhookRetryDelay(attempts int) time.Duration {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = webhookRetryBaseDelay
+	b.Multiplier = 2
+	b.MaxInterval = webhookRetryMaxDelay
+	// the delivery is given up by maxWebhookAttempts
+	b.MaxElapsedTime = 0
+	b.Reset()
+	var delay time.Duration
+	for i := 0; i < attempts; i++ {
+		delay = b.NextBackOff()
+	}
+	return delay
+}
+
+// publishDeliveryEvent publishes the delivery.succeeded or delivery.failed event of the message
+func publishDeliveryEvent(ctx context.Context, psm *PushStreamMessage, status, reason string) {
+	eventType := webhook.EventDeliverySucceeded
+	if status == models.DeliveryFailed {
+		eventType = webhook.EventDeliveryFailed
+	}
+	publishEvent(ctx, psm.AppId, eventType, webhook.DeliveryEventData{
+		ActionId:   psm.ActionId,
+		UserId:     psm.UserId,
+		Token:      psm.Token,
+		Category:   psm.Category,
+		Variant:    psm.Variant,
+		TrackingId: psm.TrackingId,
+		Status:     status,
+		Reason:     truncate(reason, maxDeliveryReasonLength),
+	})
+}
+
+func publishEvent(ctx context.Context, appId string, eventType webhook.EventType, data interface{}) {
+	err := webhook.Publish(ctx, appId, eventType, data)
+	if err != nil {
+		log.WithCtx(ctx).Warn("Push: failed to publish webhook event",
+			zap.String("app_id", appId),
+			zap.String("event", eventType),
+			zap.Error(err),
+		)
+	}
+}
+
+// truncate returns the first n bytes of s at most, the multi-byte character at the end is not split
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
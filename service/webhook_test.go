@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"github.com/shitamachi/push-service/mq"
+	"github.com/shitamachi/push-service/webhook"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNextWebhookState(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		code     int
+		err      error
+		status   webhook.DeliveryStatus
+		delay    time.Duration
+		wantErr  bool
+	}{
+		{name: "succeeded", attempts: 1, code: http.StatusOK, status: webhook.StatusSucceeded},
+		{name: "succeeded after retries", attempts: 3, code: http.StatusNoContent, status: webhook.StatusSucceeded},
+		{name: "server error", attempts: 1, code: http.StatusBadGateway, status: webhook.StatusRetrying, delay: webhookRetryBaseDelay, wantErr: true},
+		{name: "too many requests", attempts: 2, code: http.StatusTooManyRequests, status: webhook.StatusRetrying, delay: 2 * webhookRetryBaseDelay, wantErr: true},
+		{name: "request error", attempts: 3, err: context.DeadlineExceeded, status: webhook.StatusRetrying, delay: 4 * webhookRetryBaseDelay, wantErr: true},
+		{name: "client error", attempts: 1, code: http.StatusNotFound, status: webhook.StatusFailed, wantErr: true},
+		{name: "redirect", attempts: 1, code: http.StatusFound, status: webhook.StatusFailed, wantErr: true},
+		{name: "give up", attempts: maxWebhookAttempts, code: http.StatusServiceUnavailable, status: webhook.StatusFailed, wantErr: true},
+		{name: "give up after request error", attempts: maxWebhookAttempts, err: context.DeadlineExceeded, status: webhook.StatusFailed, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, delay, err := nextWebhookState(tt.attempts, tt.code, tt.err)
+			assert.Equal(t, tt.status, status)
+			assertDelay(t, tt.delay, delay)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestWebhookRetryUntilGiveUp(t *testing.T) {
+	var (
+		attempts int
+		status   = webhook.StatusPending
+	)
+	for status != webhook.StatusFailed {
+		attempts++
+		var delay time.Duration
+		status, delay, _ = nextWebhookState(attempts, http.StatusInternalServerError, nil)
+		if status == webhook.StatusRetrying {
+			assertDelay(t, webhookRetryBaseDelay<<(attempts-1), delay)
+		}
+	}
+	assert.Equal(t, maxWebhookAttempts, attempts)
+	// a single request never outlives the visibility timeout of the consumer
+	assert.Less(t, int64(webhookRequestTimeout), int64(mq.VisibilityTimeout))
+}
+
+func TestGetWebhookRetryDelay(t *testing.T) {
+	// the delay never exceeds the max delay with jitter
+	for i := 0; i < 10; i++ {
+		assert.LessOrEqual(t, int64(getWebhookRetryDelay(20)), int64(webhookRetryMaxDelay*3/2))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	// "推送" is 6 bytes, the second character is not split
+	assert.Equal(t, "推", truncate("推送", 4))
+	assert.Equal(t, "", truncate("推送", 2))
+}
+
+// assertDelay asserts the delay is within the jitter of the expected interval
+func assertDelay(t *testing.T, expected, delay time.Duration) {
+	t.Helper()
+	assert.GreaterOrEqual(t, int64(delay), int64(expected/2))
+	assert.LessOrEqual(t, int64(delay), int64(expected*3/2))
+}
@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"entgo.io/ent/dialect"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver is the ent driver which records every query in a span
+type Driver struct {
+	dialect.Driver
+}
+
+// NewDriver wraps the driver to trace the queries
+func NewDriver(d dialect.Driver) dialect.Driver {
+	return &Driver{d}
+}
+
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return traceQuery(ctx, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return traceQuery(ctx, d.Dialect(), query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+// Tx is the transaction which records every query in a span
+type Tx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *Tx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return traceQuery(ctx, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *Tx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return traceQuery(ctx, t.dialect, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func traceQuery(ctx context.Context, system, query string, f func(ctx context.Context) error) error {
+	// the queries out of any request or message are not traced, e.g. the migration
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return f(ctx)
+	}
+	ctx, span := Tracer().Start(ctx, "db query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemKey.String(system), semconv.DBStatementKey.String(query)),
+	)
+	err := f(ctx)
+	EndSpan(span, err)
+	return err
+}
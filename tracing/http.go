@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// StartServerSpan starts the span of handling the request, the span is the child of the
+// trace context in the request header if any
+func StartServerSpan(r *http.Request, route string) (context.Context, trace.Span) {
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	name := route
+	if len(name) <= 0 {
+		name = r.URL.Path
+	}
+	return Tracer().Start(ctx, r.Method+" "+name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest("", route, r)...),
+	)
+}
+
+// SetServerSpanStatus records the status code of response in the span of handling the request
+func SetServerSpanStatus(span trace.Span, statusCode int) {
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(statusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(statusCode, trace.SpanKindServer))
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/shitamachi/redisqueue/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// streamValuesCarrier carries the trace context inside the values of stream message,
+// e.g. traceparent and tracestate, so the consumer spans belong to the trace of originating request
+type streamValuesCarrier map[string]interface{}
+
+func (c streamValuesCarrier) Get(key string) string {
+	v, ok := c[key]
+	if !ok || v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func (c streamValuesCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c streamValuesCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectStreamValues adds the trace context of ctx to the values of stream message
+func InjectStreamValues(ctx context.Context, values map[string]interface{}) map[string]interface{} {
+	otel.GetTextMapPropagator().Inject(ctx, streamValuesCarrier(values))
+	return values
+}
+
+// ExtractStreamValues returns the context with the trace context carried by the values of stream message
+func ExtractStreamValues(ctx context.Context, values map[string]interface{}) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, streamValuesCarrier(values))
+}
+
+// StartProducerSpan starts the span of adding messages to the stream, the context of span should be injected to the messages
+func StartProducerSpan(ctx context.Context, stream string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, stream+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKey.String("redis"), semconv.MessagingDestinationKey.String(stream)),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// ConsumerFunc wraps the consumer func of stream to process each message in a consumer span,
+// the span is the child of the span which added the message to the stream
+func ConsumerFunc(stream string, f redisqueue.ConsumerFunc) redisqueue.ConsumerFunc {
+	return func(ctx context.Context, message *redisqueue.Message) error {
+		ctx, span := Tracer().Start(ExtractStreamValues(ctx, message.Values), stream+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKey.String("redis"),
+				semconv.MessagingDestinationKey.String(stream),
+				semconv.MessagingOperationProcess,
+				semconv.MessagingMessageIDKey.String(message.ID),
+			),
+		)
+		err := f(ctx, message)
+		EndSpan(span, err)
+		return err
+	}
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"github.com/shitamachi/redisqueue/v2"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+func TestConsumerSpanContinuesProducerTrace(t *testing.T) {
+	_, err := InitTracer(context.Background(), config_entries.TracingConfig{})
+	assert.NoError(t, err)
+	var buf bytes.Buffer
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(&buf))
+	assert.NoError(t, err)
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, producerSpan := StartProducerSpan(context.Background(), "test_stream")
+	values := InjectStreamValues(ctx, map[string]interface{}{"token": "t"})
+	producerSpan.End()
+	assert.NotEmpty(t, values["traceparent"])
+
+	var consumerSpanContext trace.SpanContext
+	f := ConsumerFunc("test_stream", func(ctx context.Context, message *redisqueue.Message) error {
+		consumerSpanContext = trace.SpanContextFromContext(ctx)
+		return nil
+	})
+	assert.NoError(t, f(context.Background(), &redisqueue.Message{ID: "1-0", Stream: "test_stream", Values: values}))
+
+	assert.Equal(t, producerSpan.SpanContext().TraceID(), consumerSpanContext.TraceID())
+	assert.NotEqual(t, producerSpan.SpanContext().SpanID(), consumerSpanContext.SpanID())
+	assert.Contains(t, buf.String(), "test_stream process")
+}
@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"github.com/shitamachi/push-service/config/config_entries"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"os"
+)
+
+const instrumentationName = "github.com/shitamachi/push-service"
+
+const (
+	// ApiKeyIdKey is the id of API key which the request is authenticated with
+	ApiKeyIdKey      = attribute.Key("push.api_key_id")
+	ActionIdKey      = attribute.Key("push.action_id")
+	AppIdKey         = attribute.Key("push.app_id")
+	EnqueuedCountKey = attribute.Key("push.enqueued_count")
+	ProviderKey      = attribute.Key("push.provider")
+	// ProviderReasonKey is the APNs reason or FCM error code of the provider response
+	ProviderReasonKey = attribute.Key("push.provider_reason")
+)
+
+// Tracer returns the tracer of service, the spans are dropped if tracing is not enabled
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// InitTracer sets the global tracer provider which exports the spans with the configured exporter,
+// the returned func must be called before exiting to flush the spans
+func InitTracer(ctx context.Context, conf config_entries.TracingConfig) (func(context.Context) error, error) {
+	// the trace context of incoming request is propagated even if tracing is not enabled
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !conf.Enable {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(conf.GetServiceName()),
+	))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.GetSampleRatio()))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartProviderSpan starts the span of sending the message to the push provider
+func StartProviderSpan(ctx context.Context, provider, appId string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, provider+" push",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(ProviderKey.String(provider), AppIdKey.String(appId)),
+	)
+}
+
+// EndSpan records the error in the span if any and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func newExporter(ctx context.Context, conf config_entries.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch conf.GetExporter() {
+	case config_entries.TracingExporterOtlp:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(conf.Headers)}
+		if len(conf.Endpoint) > 0 {
+			opts = append(opts, otlptracegrpc.WithEndpoint(conf.Endpoint))
+		}
+		if conf.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case config_entries.TracingExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", conf.Exporter)
+	}
+}
@@ -0,0 +1,79 @@
+package webhook
+
+type EventType = string
+
+const (
+	// 消息推送成功
+	EventDeliverySucceeded EventType = "delivery.succeeded"
+	// 消息推送失败
+	EventDeliveryFailed EventType = "delivery.failed"
+	// 设备 token 已失效
+	EventTokenInvalidated EventType = "token.invalidated"
+	// 推送动作入队的所有消息都已处理完成
+	EventActionCompleted EventType = "action.completed"
+)
+
+var EventTypes = []EventType{
+	EventDeliverySucceeded,
+	EventDeliveryFailed,
+	EventTokenInvalidated,
+	EventActionCompleted,
+}
+
+func IsValidEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is the payload posted to the url of webhook subscription
+type Event struct {
+	// 事件 id, 同一事件投递给不同的订阅时 id 相同, 可用于去重
+	Id string `json:"id"`
+	// 事件类型
+	Type EventType `json:"type"`
+	// app id
+	AppId string `json:"app_id"`
+	// 事件发生的时间, unix 时间戳, 单位 s
+	CreatedAt int64 `json:"created_at"`
+	// 事件的数据, 结构由事件类型决定
+	Data interface{} `json:"data"`
+}
+
+// DeliveryEventData is the data of delivery.succeeded and delivery.failed events
+type DeliveryEventData struct {
+	ActionId   string `json:"action_id"`
+	UserId     string `json:"user_id"`
+	Token      string `json:"token"`
+	Category   string `json:"category,omitempty"`
+	Variant    string `json:"variant,omitempty"`
+	TrackingId string `json:"tracking_id,omitempty"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// TokenInvalidatedData is the data of token.invalidated event
+type TokenInvalidatedData struct {
+	UserId string `json:"user_id"`
+	Token  string `json:"token"`
+	Reason string `json:"reason"`
+}
+
+// ActionCompletedData is the data of action.completed event
+type ActionCompletedData struct {
+	ActionId string           `json:"action_id"`
+	Stats    map[string]int64 `json:"stats"`
+}
+
+type DeliveryStatus = string
+
+const (
+	StatusPending DeliveryStatus = "pending"
+	// 投递失败, 将在稍后重试
+	StatusRetrying  DeliveryStatus = "retrying"
+	StatusSucceeded DeliveryStatus = "succeeded"
+	StatusFailed    DeliveryStatus = "failed"
+)
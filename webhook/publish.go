@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/shitamachi/push-service/action"
+	"github.com/shitamachi/push-service/cache"
+	"github.com/shitamachi/push-service/db"
+	"github.com/shitamachi/push-service/ent"
+	"github.com/shitamachi/push-service/ent/webhooksubscription"
+	"github.com/shitamachi/push-service/log"
+	"github.com/shitamachi/push-service/mq"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// events are published for every push message, so the subscriptions are cached for a while
+// instead of being queried each time. The changes of subscriptions take effect after the cache expires.
+const subscriptionCacheTTL = 30 * time.Second
+
+type cachedSubscriptions struct {
+	subscriptions []*ent.WebhookSubscription
+	expiresAt     time.Time
+}
+
+var subscriptionCache = struct {
+	sync.Mutex
+	items map[string]cachedSubscriptions
+}{items: make(map[string]cachedSubscriptions)}
+
+// InvalidateSubscriptions removes the cached subscriptions of app, it is called when the subscriptions are changed
+func InvalidateSubscriptions(appId string) {
+	subscriptionCache.Lock()
+	delete(subscriptionCache.items, appId)
+	subscriptionCache.Unlock()
+}
+
+func getSubscriptions(ctx context.Context, client *ent.Client, appId string) ([]*ent.WebhookSubscription, error) {
+	now := time.Now()
+	subscriptionCache.Lock()
+	cached, ok := subscriptionCache.items[appId]
+	subscriptionCache.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.subscriptions, nil
+	}
+
+	subscriptions, err := client.WebhookSubscription.Query().
+		Where(
+			webhooksubscription.AppID(appId),
+			webhooksubscription.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionCache.Lock()
+	subscriptionCache.items[appId] = cachedSubscriptions{
+		subscriptions: subscriptions,
+		expiresAt:     now.Add(subscriptionCacheTTL),
+	}
+	subscriptionCache.Unlock()
+	return subscriptions, nil
+}
+
+func isSubscribed(subscription *ent.WebhookSubscription, eventType EventType) bool {
+	for _, t := range subscription.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish creates a delivery for each subscription of the app which subscribes the event type
+// and adds the deliveries to the webhook delivery stream
+func Publish(ctx context.Context, appId string, eventType EventType, data interface{}) error {
+	client, redisClient := db.GetFromContext(ctx), cache.GetFromContext(ctx)
+	if client == nil || redisClient == nil {
+		return nil
+	}
+
+	subscriptions, err := getSubscriptions(ctx, client, appId)
+	if err != nil {
+		return err
+	}
+
+	var payload []byte
+	event := Event{
+		Id:        uuid.NewString(),
+		Type:      eventType,
+		AppId:     appId,
+		CreatedAt: time.Now().Unix(),
+		Data:      data,
+	}
+	for _, subscription := range subscriptions {
+		if !isSubscribed(subscription, eventType) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return err
+			}
+		}
+
+		delivery, err := client.WebhookDelivery.Create().
+			SetSubscriptionID(subscription.ID).
+			SetEventID(event.Id).
+			SetEventType(eventType).
+			SetPayload(string(payload)).
+			SetStatus(StatusPending).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		err = mq.Add(ctx, redisClient, mq.WebhookDeliveryStreamKey, map[string]interface{}{
+			"delivery_id": delivery.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotifyActionCompleted publishes the action.completed event to the apps of the action if all its messages are processed
+func NotifyActionCompleted(ctx context.Context, actionId string) {
+	redisClient := cache.GetFromContext(ctx)
+	if len(actionId) <= 0 || redisClient == nil {
+		return
+	}
+
+	completed, err := action.MarkCompleted(ctx, redisClient, actionId)
+	if err != nil {
+		log.WithCtx(ctx).Warn("NotifyActionCompleted: failed to mark action as completed", zap.String("action_id", actionId), zap.Error(err))
+		return
+	}
+	if !completed {
+		return
+	}
+
+	stats, err := action.GetStats(ctx, redisClient, actionId)
+	if err != nil {
+		log.WithCtx(ctx).Warn("NotifyActionCompleted: failed to get action stats", zap.String("action_id", actionId), zap.Error(err))
+		return
+	}
+	stats, _ = action.SplitVariantStats(stats)
+	appIds, err := action.GetApps(ctx, redisClient, actionId)
+	if err != nil {
+		log.WithCtx(ctx).Warn("NotifyActionCompleted: failed to get apps of action", zap.String("action_id", actionId), zap.Error(err))
+		return
+	}
+
+	for _, appId := range appIds {
+		err = Publish(ctx, appId, EventActionCompleted, ActionCompletedData{
+			ActionId: actionId,
+			Stats:    stats,
+		})
+		if err != nil {
+			log.WithCtx(ctx).Warn("NotifyActionCompleted: failed to publish event",
+				zap.String("action_id", actionId),
+				zap.String("app_id", appId),
+				zap.Error(err),
+			)
+		}
+	}
+}
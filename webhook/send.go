@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"github.com/shitamachi/push-service/ent"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// the response body of webhook is discarded, only read a limited size so the connection can be reused
+const maxResponseBodySize = 64 * 1024
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// Send posts the payload of delivery to the url of subscription with signature, the http status code is returned
+func Send(ctx context.Context, subscription *ent.WebhookSubscription, delivery *ent.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(EventIdHeader, delivery.EventID)
+	req.Header.Set(DeliveryIdHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, payload))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// 请求体的 HMAC-SHA256 签名, 格式为 v1=<hex>, 签名内容为 <timestamp>.<body>
+	SignatureHeader = "X-Push-Signature"
+	// 签名时使用的 unix 时间戳, 单位 s; 接收方应拒绝时间相差过大的请求以防止重放
+	TimestampHeader  = "X-Push-Timestamp"
+	EventTypeHeader  = "X-Push-Event"
+	EventIdHeader    = "X-Push-Event-Id"
+	DeliveryIdHeader = "X-Push-Delivery"
+
+	signatureVersion = "v1"
+	secretLength     = 32
+)
+
+// Sign returns the signature of payload which is sent in the SignatureHeader
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateSecret generates a random secret for the webhook subscription
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
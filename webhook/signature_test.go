@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1600000000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t,
+		"v1=3831eb7dbf183fdbdf6145e3aa0b7029f210195f352de3815ebec7b67268edbc",
+		Sign("secret", 1600000000, []byte(`{"id":"1"}`)),
+	)
+	assert.NotEqual(t, Sign("secret", 1600000000, []byte("a")), Sign("secret", 1600000001, []byte("a")))
+	assert.NotEqual(t, Sign("secret", 1600000000, []byte("a")), Sign("other", 1600000000, []byte("a")))
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	assert.NoError(t, err)
+	b, err := GenerateSecret()
+	assert.NoError(t, err)
+	assert.Len(t, a, 2*secretLength)
+	assert.NotEqual(t, a, b)
+}